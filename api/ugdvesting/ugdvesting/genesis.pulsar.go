@@ -13,15 +13,233 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_GenesisState_2_list)(nil)
+
+type _GenesisState_2_list struct {
+	list *[]*VestingData
+}
+
+func (x *_GenesisState_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VestingData)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VestingData)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_2_list) AppendMutable() protoreflect.Value {
+	v := new(VestingData)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_2_list) NewElement() protoreflect.Value {
+	v := new(VestingData)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_3_list)(nil)
+
+type _GenesisState_3_list struct {
+	list *[]*VestingData
+}
+
+func (x *_GenesisState_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VestingData)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VestingData)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_3_list) AppendMutable() protoreflect.Value {
+	v := new(VestingData)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_3_list) NewElement() protoreflect.Value {
+	v := new(VestingData)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*VestingAmendment
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VestingAmendment)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VestingAmendment)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(VestingAmendment)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(VestingAmendment)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*MintRecord
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MintRecord)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MintRecord)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(MintRecord)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(MintRecord)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState        protoreflect.MessageDescriptor
-	fd_GenesisState_params protoreflect.FieldDescriptor
+	md_GenesisState                     protoreflect.MessageDescriptor
+	fd_GenesisState_params              protoreflect.FieldDescriptor
+	fd_GenesisState_pending_vestings    protoreflect.FieldDescriptor
+	fd_GenesisState_processed_vestings  protoreflect.FieldDescriptor
+	fd_GenesisState_snapshot_state      protoreflect.FieldDescriptor
+	fd_GenesisState_amendments          protoreflect.FieldDescriptor
+	fd_GenesisState_mints               protoreflect.FieldDescriptor
+	fd_GenesisState_mint_snapshot_state protoreflect.FieldDescriptor
+	fd_GenesisState_last_block_time     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_ugdvesting_ugdvesting_genesis_proto_init()
 	md_GenesisState = File_ugdvesting_ugdvesting_genesis_proto.Messages().ByName("GenesisState")
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_pending_vestings = md_GenesisState.Fields().ByName("pending_vestings")
+	fd_GenesisState_processed_vestings = md_GenesisState.Fields().ByName("processed_vestings")
+	fd_GenesisState_snapshot_state = md_GenesisState.Fields().ByName("snapshot_state")
+	fd_GenesisState_amendments = md_GenesisState.Fields().ByName("amendments")
+	fd_GenesisState_mints = md_GenesisState.Fields().ByName("mints")
+	fd_GenesisState_mint_snapshot_state = md_GenesisState.Fields().ByName("mint_snapshot_state")
+	fd_GenesisState_last_block_time = md_GenesisState.Fields().ByName("last_block_time")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -95,6 +313,48 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.PendingVestings) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.PendingVestings})
+		if !f(fd_GenesisState_pending_vestings, value) {
+			return
+		}
+	}
+	if len(x.ProcessedVestings) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.ProcessedVestings})
+		if !f(fd_GenesisState_processed_vestings, value) {
+			return
+		}
+	}
+	if x.SnapshotState != nil {
+		value := protoreflect.ValueOfMessage(x.SnapshotState.ProtoReflect())
+		if !f(fd_GenesisState_snapshot_state, value) {
+			return
+		}
+	}
+	if len(x.Amendments) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.Amendments})
+		if !f(fd_GenesisState_amendments, value) {
+			return
+		}
+	}
+	if len(x.Mints) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.Mints})
+		if !f(fd_GenesisState_mints, value) {
+			return
+		}
+	}
+	if x.MintSnapshotState != nil {
+		value := protoreflect.ValueOfMessage(x.MintSnapshotState.ProtoReflect())
+		if !f(fd_GenesisState_mint_snapshot_state, value) {
+			return
+		}
+	}
+	if x.LastBlockTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastBlockTime)
+		if !f(fd_GenesisState_last_block_time, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -112,6 +372,20 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 	switch fd.FullName() {
 	case "ugdvesting.ugdvesting.GenesisState.params":
 		return x.Params != nil
+	case "ugdvesting.ugdvesting.GenesisState.pending_vestings":
+		return len(x.PendingVestings) != 0
+	case "ugdvesting.ugdvesting.GenesisState.processed_vestings":
+		return len(x.ProcessedVestings) != 0
+	case "ugdvesting.ugdvesting.GenesisState.snapshot_state":
+		return x.SnapshotState != nil
+	case "ugdvesting.ugdvesting.GenesisState.amendments":
+		return len(x.Amendments) != 0
+	case "ugdvesting.ugdvesting.GenesisState.mints":
+		return len(x.Mints) != 0
+	case "ugdvesting.ugdvesting.GenesisState.mint_snapshot_state":
+		return x.MintSnapshotState != nil
+	case "ugdvesting.ugdvesting.GenesisState.last_block_time":
+		return x.LastBlockTime != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ugdvesting.ugdvesting.GenesisState"))
@@ -130,6 +404,20 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "ugdvesting.ugdvesting.GenesisState.params":
 		x.Params = nil
+	case "ugdvesting.ugdvesting.GenesisState.pending_vestings":
+		x.PendingVestings = nil
+	case "ugdvesting.ugdvesting.GenesisState.processed_vestings":
+		x.ProcessedVestings = nil
+	case "ugdvesting.ugdvesting.GenesisState.snapshot_state":
+		x.SnapshotState = nil
+	case "ugdvesting.ugdvesting.GenesisState.amendments":
+		x.Amendments = nil
+	case "ugdvesting.ugdvesting.GenesisState.mints":
+		x.Mints = nil
+	case "ugdvesting.ugdvesting.GenesisState.mint_snapshot_state":
+		x.MintSnapshotState = nil
+	case "ugdvesting.ugdvesting.GenesisState.last_block_time":
+		x.LastBlockTime = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ugdvesting.ugdvesting.GenesisState"))
@@ -149,6 +437,39 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "ugdvesting.ugdvesting.GenesisState.params":
 		value := x.Params
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ugdvesting.ugdvesting.GenesisState.pending_vestings":
+		if len(x.PendingVestings) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_2_list{})
+		}
+		listValue := &_GenesisState_2_list{list: &x.PendingVestings}
+		return protoreflect.ValueOfList(listValue)
+	case "ugdvesting.ugdvesting.GenesisState.processed_vestings":
+		if len(x.ProcessedVestings) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_3_list{})
+		}
+		listValue := &_GenesisState_3_list{list: &x.ProcessedVestings}
+		return protoreflect.ValueOfList(listValue)
+	case "ugdvesting.ugdvesting.GenesisState.snapshot_state":
+		value := x.SnapshotState
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ugdvesting.ugdvesting.GenesisState.amendments":
+		if len(x.Amendments) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.Amendments}
+		return protoreflect.ValueOfList(listValue)
+	case "ugdvesting.ugdvesting.GenesisState.mints":
+		if len(x.Mints) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.Mints}
+		return protoreflect.ValueOfList(listValue)
+	case "ugdvesting.ugdvesting.GenesisState.mint_snapshot_state":
+		value := x.MintSnapshotState
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ugdvesting.ugdvesting.GenesisState.last_block_time":
+		value := x.LastBlockTime
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ugdvesting.ugdvesting.GenesisState"))
@@ -171,6 +492,28 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 	switch fd.FullName() {
 	case "ugdvesting.ugdvesting.GenesisState.params":
 		x.Params = value.Message().Interface().(*Params)
+	case "ugdvesting.ugdvesting.GenesisState.pending_vestings":
+		lv := value.List()
+		clv := lv.(*_GenesisState_2_list)
+		x.PendingVestings = *clv.list
+	case "ugdvesting.ugdvesting.GenesisState.processed_vestings":
+		lv := value.List()
+		clv := lv.(*_GenesisState_3_list)
+		x.ProcessedVestings = *clv.list
+	case "ugdvesting.ugdvesting.GenesisState.snapshot_state":
+		x.SnapshotState = value.Message().Interface().(*SnapshotState)
+	case "ugdvesting.ugdvesting.GenesisState.amendments":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.Amendments = *clv.list
+	case "ugdvesting.ugdvesting.GenesisState.mints":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.Mints = *clv.list
+	case "ugdvesting.ugdvesting.GenesisState.mint_snapshot_state":
+		x.MintSnapshotState = value.Message().Interface().(*SnapshotState)
+	case "ugdvesting.ugdvesting.GenesisState.last_block_time":
+		x.LastBlockTime = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ugdvesting.ugdvesting.GenesisState"))
@@ -196,6 +539,42 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.Params = new(Params)
 		}
 		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "ugdvesting.ugdvesting.GenesisState.pending_vestings":
+		if x.PendingVestings == nil {
+			x.PendingVestings = []*VestingData{}
+		}
+		value := &_GenesisState_2_list{list: &x.PendingVestings}
+		return protoreflect.ValueOfList(value)
+	case "ugdvesting.ugdvesting.GenesisState.processed_vestings":
+		if x.ProcessedVestings == nil {
+			x.ProcessedVestings = []*VestingData{}
+		}
+		value := &_GenesisState_3_list{list: &x.ProcessedVestings}
+		return protoreflect.ValueOfList(value)
+	case "ugdvesting.ugdvesting.GenesisState.snapshot_state":
+		if x.SnapshotState == nil {
+			x.SnapshotState = new(SnapshotState)
+		}
+		return protoreflect.ValueOfMessage(x.SnapshotState.ProtoReflect())
+	case "ugdvesting.ugdvesting.GenesisState.amendments":
+		if x.Amendments == nil {
+			x.Amendments = []*VestingAmendment{}
+		}
+		value := &_GenesisState_5_list{list: &x.Amendments}
+		return protoreflect.ValueOfList(value)
+	case "ugdvesting.ugdvesting.GenesisState.mints":
+		if x.Mints == nil {
+			x.Mints = []*MintRecord{}
+		}
+		value := &_GenesisState_6_list{list: &x.Mints}
+		return protoreflect.ValueOfList(value)
+	case "ugdvesting.ugdvesting.GenesisState.mint_snapshot_state":
+		if x.MintSnapshotState == nil {
+			x.MintSnapshotState = new(SnapshotState)
+		}
+		return protoreflect.ValueOfMessage(x.MintSnapshotState.ProtoReflect())
+	case "ugdvesting.ugdvesting.GenesisState.last_block_time":
+		panic(fmt.Errorf("field last_block_time of message ugdvesting.ugdvesting.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ugdvesting.ugdvesting.GenesisState"))
@@ -212,6 +591,26 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "ugdvesting.ugdvesting.GenesisState.params":
 		m := new(Params)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ugdvesting.ugdvesting.GenesisState.pending_vestings":
+		list := []*VestingData{}
+		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+	case "ugdvesting.ugdvesting.GenesisState.processed_vestings":
+		list := []*VestingData{}
+		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "ugdvesting.ugdvesting.GenesisState.snapshot_state":
+		m := new(SnapshotState)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ugdvesting.ugdvesting.GenesisState.amendments":
+		list := []*VestingAmendment{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "ugdvesting.ugdvesting.GenesisState.mints":
+		list := []*MintRecord{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "ugdvesting.ugdvesting.GenesisState.mint_snapshot_state":
+		m := new(SnapshotState)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ugdvesting.ugdvesting.GenesisState.last_block_time":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ugdvesting.ugdvesting.GenesisState"))
@@ -285,6 +684,41 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Params)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.PendingVestings) > 0 {
+			for _, e := range x.PendingVestings {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ProcessedVestings) > 0 {
+			for _, e := range x.ProcessedVestings {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.SnapshotState != nil {
+			l = options.Size(x.SnapshotState)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Amendments) > 0 {
+			for _, e := range x.Amendments {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Mints) > 0 {
+			for _, e := range x.Mints {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MintSnapshotState != nil {
+			l = options.Size(x.MintSnapshotState)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LastBlockTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastBlockTime))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -314,6 +748,103 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.LastBlockTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastBlockTime))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.MintSnapshotState != nil {
+			encoded, err := options.Marshal(x.MintSnapshotState)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Mints) > 0 {
+			for iNdEx := len(x.Mints) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Mints[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.Amendments) > 0 {
+			for iNdEx := len(x.Amendments) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amendments[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.SnapshotState != nil {
+			encoded, err := options.Marshal(x.SnapshotState)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ProcessedVestings) > 0 {
+			for iNdEx := len(x.ProcessedVestings) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ProcessedVestings[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.PendingVestings) > 0 {
+			for iNdEx := len(x.PendingVestings) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PendingVestings[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
 		if x.Params != nil {
 			encoded, err := options.Marshal(x.Params)
 			if err != nil {
@@ -413,6 +944,233 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingVestings", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PendingVestings = append(x.PendingVestings, &VestingData{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingVestings[len(x.PendingVestings)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProcessedVestings", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProcessedVestings = append(x.ProcessedVestings, &VestingData{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ProcessedVestings[len(x.ProcessedVestings)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SnapshotState", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SnapshotState == nil {
+					x.SnapshotState = &SnapshotState{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SnapshotState); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amendments", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amendments = append(x.Amendments, &VestingAmendment{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amendments[len(x.Amendments)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Mints", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Mints = append(x.Mints, &MintRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Mints[len(x.Mints)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintSnapshotState", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MintSnapshotState == nil {
+					x.MintSnapshotState = &SnapshotState{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MintSnapshotState); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastBlockTime", wireType)
+				}
+				x.LastBlockTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastBlockTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -468,6 +1226,26 @@ type GenesisState struct {
 	unknownFields protoimpl.UnknownFields
 
 	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// pending_vestings are the vesting records waiting for their block,
+	// including failed ones waiting for their retry.
+	PendingVestings []*VestingData `protobuf:"bytes,2,rep,name=pending_vestings,json=pendingVestings,proto3" json:"pending_vestings,omitempty"`
+	// processed_vestings are the vesting records that were converted, their
+	// addresses are never vested again.
+	ProcessedVestings []*VestingData `protobuf:"bytes,3,rep,name=processed_vestings,json=processedVestings,proto3" json:"processed_vestings,omitempty"`
+	// snapshot_state is the last vesting snapshot accepted, unset when none
+	// was accepted yet.
+	SnapshotState *SnapshotState `protobuf:"bytes,4,opt,name=snapshot_state,json=snapshotState,proto3" json:"snapshot_state,omitempty"`
+	// amendments is the amendment history of the vesting records.
+	Amendments []*VestingAmendment `protobuf:"bytes,5,rep,name=amendments,proto3" json:"amendments,omitempty"`
+	// mints are the hedgehog mints the module executed.
+	Mints []*MintRecord `protobuf:"bytes,6,rep,name=mints,proto3" json:"mints,omitempty"`
+	// mint_snapshot_state is the last mint snapshot accepted, unset when none
+	// was accepted yet.
+	MintSnapshotState *SnapshotState `protobuf:"bytes,7,opt,name=mint_snapshot_state,json=mintSnapshotState,proto3" json:"mint_snapshot_state,omitempty"`
+	// last_block_time is the time of the last block, in Unix nanoseconds,
+	// recorded while hedgehog is polled on a time interval. Zero when it was
+	// not recorded.
+	LastBlockTime int64 `protobuf:"varint,8,opt,name=last_block_time,json=lastBlockTime,proto3" json:"last_block_time,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -497,6 +1275,55 @@ func (x *GenesisState) GetParams() *Params {
 	return nil
 }
 
+func (x *GenesisState) GetPendingVestings() []*VestingData {
+	if x != nil {
+		return x.PendingVestings
+	}
+	return nil
+}
+
+func (x *GenesisState) GetProcessedVestings() []*VestingData {
+	if x != nil {
+		return x.ProcessedVestings
+	}
+	return nil
+}
+
+func (x *GenesisState) GetSnapshotState() *SnapshotState {
+	if x != nil {
+		return x.SnapshotState
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAmendments() []*VestingAmendment {
+	if x != nil {
+		return x.Amendments
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMints() []*MintRecord {
+	if x != nil {
+		return x.Mints
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMintSnapshotState() *SnapshotState {
+	if x != nil {
+		return x.MintSnapshotState
+	}
+	return nil
+}
+
+func (x *GenesisState) GetLastBlockTime() int64 {
+	if x != nil {
+		return x.LastBlockTime
+	}
+	return 0
+}
+
 var File_ugdvesting_ugdvesting_genesis_proto protoreflect.FileDescriptor
 
 var file_ugdvesting_ugdvesting_genesis_proto_rawDesc = []byte{
@@ -505,27 +1332,64 @@ var file_ugdvesting_ugdvesting_genesis_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e,
 	0x67, 0x2e, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x1a, 0x14, 0x67, 0x6f,
 	0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x22, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x75,
-	0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4b, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
-	0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74,
-	0x69, 0x6e, 0x67, 0x2e, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x42, 0xc6, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x75, 0x67, 0x64, 0x76,
-	0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x67, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x75,
-	0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0xa2, 0x02, 0x03, 0x55, 0x55, 0x58, 0xaa,
-	0x02, 0x15, 0x55, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x67, 0x64,
-	0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0xca, 0x02, 0x15, 0x55, 0x67, 0x64, 0x76, 0x65, 0x73,
-	0x74, 0x69, 0x6e, 0x67, 0x5c, 0x55, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0xe2,
-	0x02, 0x21, 0x55, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5c, 0x55, 0x67, 0x64,
-	0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x55, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
-	0x3a, 0x3a, 0x55, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x6f, 0x1a, 0x20, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x75,
+	0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x22, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x2f, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x2f, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f,
+	0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd2, 0x04,
+	0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3b,
+	0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x75, 0x67, 0x64, 0x76,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x53, 0x0a, 0x10, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x57, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x76, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x75,
+	0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x4b, 0x0a, 0x0e, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x75,
+	0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0d, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x61, 0x6d, 0x65, 0x6e, 0x64, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x75, 0x67, 0x64,
+	0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d,
+	0x65, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x61, 0x6d, 0x65, 0x6e, 0x64,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x69, 0x6e,
+	0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x6d,
+	0x69, 0x6e, 0x74, 0x73, 0x12, 0x54, 0x0a, 0x13, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x75,
+	0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x74, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69,
+	0x6d, 0x65, 0x42, 0xc6, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x75, 0x67, 0x64, 0x76, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x75, 0x67,
+	0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0xa2, 0x02, 0x03, 0x55, 0x55, 0x58, 0xaa, 0x02,
+	0x15, 0x55, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x67, 0x64, 0x76,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0xca, 0x02, 0x15, 0x55, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x5c, 0x55, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0xe2, 0x02,
+	0x21, 0x55, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5c, 0x55, 0x67, 0x64, 0x76,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x16, 0x55, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x3a,
+	0x3a, 0x55, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -542,16 +1406,26 @@ func file_ugdvesting_ugdvesting_genesis_proto_rawDescGZIP() []byte {
 
 var file_ugdvesting_ugdvesting_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_ugdvesting_ugdvesting_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: ugdvesting.ugdvesting.GenesisState
-	(*Params)(nil),       // 1: ugdvesting.ugdvesting.Params
+	(*GenesisState)(nil),     // 0: ugdvesting.ugdvesting.GenesisState
+	(*Params)(nil),           // 1: ugdvesting.ugdvesting.Params
+	(*VestingData)(nil),      // 2: ugdvesting.ugdvesting.VestingData
+	(*SnapshotState)(nil),    // 3: ugdvesting.ugdvesting.SnapshotState
+	(*VestingAmendment)(nil), // 4: ugdvesting.ugdvesting.VestingAmendment
+	(*MintRecord)(nil),       // 5: ugdvesting.ugdvesting.MintRecord
 }
 var file_ugdvesting_ugdvesting_genesis_proto_depIdxs = []int32{
 	1, // 0: ugdvesting.ugdvesting.GenesisState.params:type_name -> ugdvesting.ugdvesting.Params
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 1: ugdvesting.ugdvesting.GenesisState.pending_vestings:type_name -> ugdvesting.ugdvesting.VestingData
+	2, // 2: ugdvesting.ugdvesting.GenesisState.processed_vestings:type_name -> ugdvesting.ugdvesting.VestingData
+	3, // 3: ugdvesting.ugdvesting.GenesisState.snapshot_state:type_name -> ugdvesting.ugdvesting.SnapshotState
+	4, // 4: ugdvesting.ugdvesting.GenesisState.amendments:type_name -> ugdvesting.ugdvesting.VestingAmendment
+	5, // 5: ugdvesting.ugdvesting.GenesisState.mints:type_name -> ugdvesting.ugdvesting.MintRecord
+	3, // 6: ugdvesting.ugdvesting.GenesisState.mint_snapshot_state:type_name -> ugdvesting.ugdvesting.SnapshotState
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_ugdvesting_ugdvesting_genesis_proto_init() }
@@ -559,7 +1433,9 @@ func file_ugdvesting_ugdvesting_genesis_proto_init() {
 	if File_ugdvesting_ugdvesting_genesis_proto != nil {
 		return
 	}
+	file_ugdvesting_ugdvesting_mint_proto_init()
 	file_ugdvesting_ugdvesting_params_proto_init()
+	file_ugdvesting_ugdvesting_vesting_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_ugdvesting_ugdvesting_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
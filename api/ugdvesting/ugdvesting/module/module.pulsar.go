@@ -14,14 +14,16 @@ import (
 )
 
 var (
-	md_Module           protoreflect.MessageDescriptor
-	fd_Module_authority protoreflect.FieldDescriptor
+	md_Module              protoreflect.MessageDescriptor
+	fd_Module_authority    protoreflect.FieldDescriptor
+	fd_Module_vesting_file protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_ugdvesting_ugdvesting_module_module_proto_init()
 	md_Module = File_ugdvesting_ugdvesting_module_module_proto.Messages().ByName("Module")
 	fd_Module_authority = md_Module.Fields().ByName("authority")
+	fd_Module_vesting_file = md_Module.Fields().ByName("vesting_file")
 }
 
 var _ protoreflect.Message = (*fastReflection_Module)(nil)
@@ -95,6 +97,12 @@ func (x *fastReflection_Module) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.VestingFile != "" {
+		value := protoreflect.ValueOfString(x.VestingFile)
+		if !f(fd_Module_vesting_file, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -112,6 +120,8 @@ func (x *fastReflection_Module) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	case "ugdvesting.ugdvesting.module.Module.authority":
 		return x.Authority != ""
+	case "ugdvesting.ugdvesting.module.Module.vesting_file":
+		return x.VestingFile != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ugdvesting.ugdvesting.module.Module"))
@@ -130,6 +140,8 @@ func (x *fastReflection_Module) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "ugdvesting.ugdvesting.module.Module.authority":
 		x.Authority = ""
+	case "ugdvesting.ugdvesting.module.Module.vesting_file":
+		x.VestingFile = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ugdvesting.ugdvesting.module.Module"))
@@ -149,6 +161,9 @@ func (x *fastReflection_Module) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "ugdvesting.ugdvesting.module.Module.authority":
 		value := x.Authority
 		return protoreflect.ValueOfString(value)
+	case "ugdvesting.ugdvesting.module.Module.vesting_file":
+		value := x.VestingFile
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ugdvesting.ugdvesting.module.Module"))
@@ -171,6 +186,8 @@ func (x *fastReflection_Module) Set(fd protoreflect.FieldDescriptor, value proto
 	switch fd.FullName() {
 	case "ugdvesting.ugdvesting.module.Module.authority":
 		x.Authority = value.Interface().(string)
+	case "ugdvesting.ugdvesting.module.Module.vesting_file":
+		x.VestingFile = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ugdvesting.ugdvesting.module.Module"))
@@ -193,6 +210,8 @@ func (x *fastReflection_Module) Mutable(fd protoreflect.FieldDescriptor) protore
 	switch fd.FullName() {
 	case "ugdvesting.ugdvesting.module.Module.authority":
 		panic(fmt.Errorf("field authority of message ugdvesting.ugdvesting.module.Module is not mutable"))
+	case "ugdvesting.ugdvesting.module.Module.vesting_file":
+		panic(fmt.Errorf("field vesting_file of message ugdvesting.ugdvesting.module.Module is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ugdvesting.ugdvesting.module.Module"))
@@ -208,6 +227,8 @@ func (x *fastReflection_Module) NewField(fd protoreflect.FieldDescriptor) protor
 	switch fd.FullName() {
 	case "ugdvesting.ugdvesting.module.Module.authority":
 		return protoreflect.ValueOfString("")
+	case "ugdvesting.ugdvesting.module.Module.vesting_file":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ugdvesting.ugdvesting.module.Module"))
@@ -281,6 +302,10 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.VestingFile)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -310,6 +335,13 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.VestingFile) > 0 {
+			i -= len(x.VestingFile)
+			copy(dAtA[i:], x.VestingFile)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VestingFile)))
+			i--
+			dAtA[i] = 0x12
+		}
 		if len(x.Authority) > 0 {
 			i -= len(x.Authority)
 			copy(dAtA[i:], x.Authority)
@@ -398,6 +430,38 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 				}
 				x.Authority = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VestingFile", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VestingFile = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -454,6 +518,10 @@ type Module struct {
 
 	// authority defines the custom module authority. If not set, defaults to the governance module.
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// vesting_file is the path of a JSON vesting-storage document validators
+	// read instead of querying hedgehog, meant for devnets. If not set, the
+	// snapshot is fetched from the configured hedgehog node.
+	VestingFile string `protobuf:"bytes,2,opt,name=vesting_file,json=vestingFile,proto3" json:"vesting_file,omitempty"`
 }
 
 func (x *Module) Reset() {
@@ -483,6 +551,13 @@ func (x *Module) GetAuthority() string {
 	return ""
 }
 
+func (x *Module) GetVestingFile() string {
+	if x != nil {
+		return x.VestingFile
+	}
+	return ""
+}
+
 var File_ugdvesting_ugdvesting_module_module_proto protoreflect.FileDescriptor
 
 var file_ugdvesting_ugdvesting_module_module_proto_rawDesc = []byte{
@@ -492,13 +567,15 @@ var file_ugdvesting_ugdvesting_module_module_proto_rawDesc = []byte{
 	0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69,
 	0x6e, 0x67, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x1a, 0x20, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x7b, 0x0a, 0x06, 0x4d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x74, 0x79, 0x3a, 0x53, 0xba, 0xc0, 0x96, 0xda, 0x01, 0x4d, 0x0a, 0x4b, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x75, 0x6e, 0x69, 0x67, 0x72, 0x69, 0x64, 0x2d,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
-	0x64, 0x6b, 0x2d, 0x75, 0x6e, 0x69, 0x67, 0x72, 0x69, 0x64, 0x2d, 0x68, 0x65, 0x64, 0x67, 0x65,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9a, 0x01, 0x0a, 0x06,
+	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x3a, 0x4f, 0xba, 0xc0, 0x96, 0xda, 0x01, 0x49, 0x0a,
+	0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x75, 0x6e, 0x69, 0x67,
+	0x72, 0x69, 0x64, 0x2d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2d, 0x75, 0x6e, 0x69, 0x67, 0x72, 0x69, 0x64, 0x2d, 0x68, 0x65, 0x64, 0x67, 0x65,
 	0x68, 0x6f, 0x67, 0x2d, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x78, 0x2f, 0x75, 0x67,
 	0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x42, 0xf0, 0x01, 0x0a, 0x20, 0x63, 0x6f, 0x6d,
 	0x2e, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x75, 0x67, 0x64, 0x76,
@@ -4,22 +4,233 @@ package ugdvesting
 import (
 	_ "cosmossdk.io/api/amino"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_5_list)(nil)
+
+type _Params_5_list struct {
+	list *[]string
+}
+
+func (x *_Params_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field Relayers as it is not of Message kind"))
+}
+
+func (x *_Params_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_6_list)(nil)
+
+type _Params_6_list struct {
+	list *[]*HedgehogKey
+}
+
+func (x *_Params_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*HedgehogKey)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*HedgehogKey)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_6_list) AppendMutable() protoreflect.Value {
+	v := new(HedgehogKey)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_6_list) NewElement() protoreflect.Value {
+	v := new(HedgehogKey)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_8_list)(nil)
+
+type _Params_8_list struct {
+	list *[]string
+}
+
+func (x *_Params_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_8_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field AdditionalDenoms as it is not of Message kind"))
+}
+
+func (x *_Params_8_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_8_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_14_list)(nil)
+
+type _Params_14_list struct {
+	list *[]string
+}
+
+func (x *_Params_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_14_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field AmendmentOperators as it is not of Message kind"))
+}
+
+func (x *_Params_14_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_14_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_14_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                protoreflect.MessageDescriptor
-	fd_Params_coinPower      protoreflect.FieldDescriptor
-	fd_Params_coinPowerValue protoreflect.FieldDescriptor
-	fd_Params_precision      protoreflect.FieldDescriptor
-	fd_Params_denom          protoreflect.FieldDescriptor
+	md_Params                       protoreflect.MessageDescriptor
+	fd_Params_coinPower             protoreflect.FieldDescriptor
+	fd_Params_coinPowerValue        protoreflect.FieldDescriptor
+	fd_Params_precision             protoreflect.FieldDescriptor
+	fd_Params_denom                 protoreflect.FieldDescriptor
+	fd_Params_relayers              protoreflect.FieldDescriptor
+	fd_Params_hedgehog_keys         protoreflect.FieldDescriptor
+	fd_Params_signature_threshold   protoreflect.FieldDescriptor
+	fd_Params_additional_denoms     protoreflect.FieldDescriptor
+	fd_Params_enabled               protoreflect.FieldDescriptor
+	fd_Params_activation_height     protoreflect.FieldDescriptor
+	fd_Params_poll_interval_blocks  protoreflect.FieldDescriptor
+	fd_Params_poll_interval_time    protoreflect.FieldDescriptor
+	fd_Params_endpoint_path         protoreflect.FieldDescriptor
+	fd_Params_amendment_operators   protoreflect.FieldDescriptor
+	fd_Params_mint_enabled          protoreflect.FieldDescriptor
+	fd_Params_mint_endpoint_path    protoreflect.FieldDescriptor
+	fd_Params_max_mint_amount       protoreflect.FieldDescriptor
+	fd_Params_max_mint_per_block    protoreflect.FieldDescriptor
+	fd_Params_max_total_minted      protoreflect.FieldDescriptor
+	fd_Params_lock_pending_funds    protoreflect.FieldDescriptor
+	fd_Params_max_snapshot_bytes    protoreflect.FieldDescriptor
+	fd_Params_max_parts             protoreflect.FieldDescriptor
+	fd_Params_max_cliff             protoreflect.FieldDescriptor
+	fd_Params_retry_interval_blocks protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -29,6 +240,26 @@ func init() {
 	fd_Params_coinPowerValue = md_Params.Fields().ByName("coinPowerValue")
 	fd_Params_precision = md_Params.Fields().ByName("precision")
 	fd_Params_denom = md_Params.Fields().ByName("denom")
+	fd_Params_relayers = md_Params.Fields().ByName("relayers")
+	fd_Params_hedgehog_keys = md_Params.Fields().ByName("hedgehog_keys")
+	fd_Params_signature_threshold = md_Params.Fields().ByName("signature_threshold")
+	fd_Params_additional_denoms = md_Params.Fields().ByName("additional_denoms")
+	fd_Params_enabled = md_Params.Fields().ByName("enabled")
+	fd_Params_activation_height = md_Params.Fields().ByName("activation_height")
+	fd_Params_poll_interval_blocks = md_Params.Fields().ByName("poll_interval_blocks")
+	fd_Params_poll_interval_time = md_Params.Fields().ByName("poll_interval_time")
+	fd_Params_endpoint_path = md_Params.Fields().ByName("endpoint_path")
+	fd_Params_amendment_operators = md_Params.Fields().ByName("amendment_operators")
+	fd_Params_mint_enabled = md_Params.Fields().ByName("mint_enabled")
+	fd_Params_mint_endpoint_path = md_Params.Fields().ByName("mint_endpoint_path")
+	fd_Params_max_mint_amount = md_Params.Fields().ByName("max_mint_amount")
+	fd_Params_max_mint_per_block = md_Params.Fields().ByName("max_mint_per_block")
+	fd_Params_max_total_minted = md_Params.Fields().ByName("max_total_minted")
+	fd_Params_lock_pending_funds = md_Params.Fields().ByName("lock_pending_funds")
+	fd_Params_max_snapshot_bytes = md_Params.Fields().ByName("max_snapshot_bytes")
+	fd_Params_max_parts = md_Params.Fields().ByName("max_parts")
+	fd_Params_max_cliff = md_Params.Fields().ByName("max_cliff")
+	fd_Params_retry_interval_blocks = md_Params.Fields().ByName("retry_interval_blocks")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -120,6 +351,126 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.Relayers) != 0 {
+		value := protoreflect.ValueOfList(&_Params_5_list{list: &x.Relayers})
+		if !f(fd_Params_relayers, value) {
+			return
+		}
+	}
+	if len(x.HedgehogKeys) != 0 {
+		value := protoreflect.ValueOfList(&_Params_6_list{list: &x.HedgehogKeys})
+		if !f(fd_Params_hedgehog_keys, value) {
+			return
+		}
+	}
+	if x.SignatureThreshold != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.SignatureThreshold)
+		if !f(fd_Params_signature_threshold, value) {
+			return
+		}
+	}
+	if len(x.AdditionalDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_Params_8_list{list: &x.AdditionalDenoms})
+		if !f(fd_Params_additional_denoms, value) {
+			return
+		}
+	}
+	if x.Enabled != false {
+		value := protoreflect.ValueOfBool(x.Enabled)
+		if !f(fd_Params_enabled, value) {
+			return
+		}
+	}
+	if x.ActivationHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ActivationHeight)
+		if !f(fd_Params_activation_height, value) {
+			return
+		}
+	}
+	if x.PollIntervalBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PollIntervalBlocks)
+		if !f(fd_Params_poll_interval_blocks, value) {
+			return
+		}
+	}
+	if x.PollIntervalTime != nil {
+		value := protoreflect.ValueOfMessage(x.PollIntervalTime.ProtoReflect())
+		if !f(fd_Params_poll_interval_time, value) {
+			return
+		}
+	}
+	if x.EndpointPath != "" {
+		value := protoreflect.ValueOfString(x.EndpointPath)
+		if !f(fd_Params_endpoint_path, value) {
+			return
+		}
+	}
+	if len(x.AmendmentOperators) != 0 {
+		value := protoreflect.ValueOfList(&_Params_14_list{list: &x.AmendmentOperators})
+		if !f(fd_Params_amendment_operators, value) {
+			return
+		}
+	}
+	if x.MintEnabled != false {
+		value := protoreflect.ValueOfBool(x.MintEnabled)
+		if !f(fd_Params_mint_enabled, value) {
+			return
+		}
+	}
+	if x.MintEndpointPath != "" {
+		value := protoreflect.ValueOfString(x.MintEndpointPath)
+		if !f(fd_Params_mint_endpoint_path, value) {
+			return
+		}
+	}
+	if x.MaxMintAmount != "" {
+		value := protoreflect.ValueOfString(x.MaxMintAmount)
+		if !f(fd_Params_max_mint_amount, value) {
+			return
+		}
+	}
+	if x.MaxMintPerBlock != "" {
+		value := protoreflect.ValueOfString(x.MaxMintPerBlock)
+		if !f(fd_Params_max_mint_per_block, value) {
+			return
+		}
+	}
+	if x.MaxTotalMinted != "" {
+		value := protoreflect.ValueOfString(x.MaxTotalMinted)
+		if !f(fd_Params_max_total_minted, value) {
+			return
+		}
+	}
+	if x.LockPendingFunds != false {
+		value := protoreflect.ValueOfBool(x.LockPendingFunds)
+		if !f(fd_Params_lock_pending_funds, value) {
+			return
+		}
+	}
+	if x.MaxSnapshotBytes != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxSnapshotBytes)
+		if !f(fd_Params_max_snapshot_bytes, value) {
+			return
+		}
+	}
+	if x.MaxParts != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxParts)
+		if !f(fd_Params_max_parts, value) {
+			return
+		}
+	}
+	if x.MaxCliff != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxCliff)
+		if !f(fd_Params_max_cliff, value) {
+			return
+		}
+	}
+	if x.RetryIntervalBlocks != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.RetryIntervalBlocks)
+		if !f(fd_Params_retry_interval_blocks, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -143,6 +494,46 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Precision != uint32(0)
 	case "ugdvesting.ugdvesting.Params.denom":
 		return x.Denom != ""
+	case "ugdvesting.ugdvesting.Params.relayers":
+		return len(x.Relayers) != 0
+	case "ugdvesting.ugdvesting.Params.hedgehog_keys":
+		return len(x.HedgehogKeys) != 0
+	case "ugdvesting.ugdvesting.Params.signature_threshold":
+		return x.SignatureThreshold != uint32(0)
+	case "ugdvesting.ugdvesting.Params.additional_denoms":
+		return len(x.AdditionalDenoms) != 0
+	case "ugdvesting.ugdvesting.Params.enabled":
+		return x.Enabled != false
+	case "ugdvesting.ugdvesting.Params.activation_height":
+		return x.ActivationHeight != int64(0)
+	case "ugdvesting.ugdvesting.Params.poll_interval_blocks":
+		return x.PollIntervalBlocks != uint64(0)
+	case "ugdvesting.ugdvesting.Params.poll_interval_time":
+		return x.PollIntervalTime != nil
+	case "ugdvesting.ugdvesting.Params.endpoint_path":
+		return x.EndpointPath != ""
+	case "ugdvesting.ugdvesting.Params.amendment_operators":
+		return len(x.AmendmentOperators) != 0
+	case "ugdvesting.ugdvesting.Params.mint_enabled":
+		return x.MintEnabled != false
+	case "ugdvesting.ugdvesting.Params.mint_endpoint_path":
+		return x.MintEndpointPath != ""
+	case "ugdvesting.ugdvesting.Params.max_mint_amount":
+		return x.MaxMintAmount != ""
+	case "ugdvesting.ugdvesting.Params.max_mint_per_block":
+		return x.MaxMintPerBlock != ""
+	case "ugdvesting.ugdvesting.Params.max_total_minted":
+		return x.MaxTotalMinted != ""
+	case "ugdvesting.ugdvesting.Params.lock_pending_funds":
+		return x.LockPendingFunds != false
+	case "ugdvesting.ugdvesting.Params.max_snapshot_bytes":
+		return x.MaxSnapshotBytes != uint64(0)
+	case "ugdvesting.ugdvesting.Params.max_parts":
+		return x.MaxParts != uint32(0)
+	case "ugdvesting.ugdvesting.Params.max_cliff":
+		return x.MaxCliff != uint32(0)
+	case "ugdvesting.ugdvesting.Params.retry_interval_blocks":
+		return x.RetryIntervalBlocks != uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ugdvesting.ugdvesting.Params"))
@@ -167,6 +558,46 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.Precision = uint32(0)
 	case "ugdvesting.ugdvesting.Params.denom":
 		x.Denom = ""
+	case "ugdvesting.ugdvesting.Params.relayers":
+		x.Relayers = nil
+	case "ugdvesting.ugdvesting.Params.hedgehog_keys":
+		x.HedgehogKeys = nil
+	case "ugdvesting.ugdvesting.Params.signature_threshold":
+		x.SignatureThreshold = uint32(0)
+	case "ugdvesting.ugdvesting.Params.additional_denoms":
+		x.AdditionalDenoms = nil
+	case "ugdvesting.ugdvesting.Params.enabled":
+		x.Enabled = false
+	case "ugdvesting.ugdvesting.Params.activation_height":
+		x.ActivationHeight = int64(0)
+	case "ugdvesting.ugdvesting.Params.poll_interval_blocks":
+		x.PollIntervalBlocks = uint64(0)
+	case "ugdvesting.ugdvesting.Params.poll_interval_time":
+		x.PollIntervalTime = nil
+	case "ugdvesting.ugdvesting.Params.endpoint_path":
+		x.EndpointPath = ""
+	case "ugdvesting.ugdvesting.Params.amendment_operators":
+		x.AmendmentOperators = nil
+	case "ugdvesting.ugdvesting.Params.mint_enabled":
+		x.MintEnabled = false
+	case "ugdvesting.ugdvesting.Params.mint_endpoint_path":
+		x.MintEndpointPath = ""
+	case "ugdvesting.ugdvesting.Params.max_mint_amount":
+		x.MaxMintAmount = ""
+	case "ugdvesting.ugdvesting.Params.max_mint_per_block":
+		x.MaxMintPerBlock = ""
+	case "ugdvesting.ugdvesting.Params.max_total_minted":
+		x.MaxTotalMinted = ""
+	case "ugdvesting.ugdvesting.Params.lock_pending_funds":
+		x.LockPendingFunds = false
+	case "ugdvesting.ugdvesting.Params.max_snapshot_bytes":
+		x.MaxSnapshotBytes = uint64(0)
+	case "ugdvesting.ugdvesting.Params.max_parts":
+		x.MaxParts = uint32(0)
+	case "ugdvesting.ugdvesting.Params.max_cliff":
+		x.MaxCliff = uint32(0)
+	case "ugdvesting.ugdvesting.Params.retry_interval_blocks":
+		x.RetryIntervalBlocks = uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ugdvesting.ugdvesting.Params"))
@@ -195,6 +626,78 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "ugdvesting.ugdvesting.Params.denom":
 		value := x.Denom
 		return protoreflect.ValueOfString(value)
+	case "ugdvesting.ugdvesting.Params.relayers":
+		if len(x.Relayers) == 0 {
+			return protoreflect.ValueOfList(&_Params_5_list{})
+		}
+		listValue := &_Params_5_list{list: &x.Relayers}
+		return protoreflect.ValueOfList(listValue)
+	case "ugdvesting.ugdvesting.Params.hedgehog_keys":
+		if len(x.HedgehogKeys) == 0 {
+			return protoreflect.ValueOfList(&_Params_6_list{})
+		}
+		listValue := &_Params_6_list{list: &x.HedgehogKeys}
+		return protoreflect.ValueOfList(listValue)
+	case "ugdvesting.ugdvesting.Params.signature_threshold":
+		value := x.SignatureThreshold
+		return protoreflect.ValueOfUint32(value)
+	case "ugdvesting.ugdvesting.Params.additional_denoms":
+		if len(x.AdditionalDenoms) == 0 {
+			return protoreflect.ValueOfList(&_Params_8_list{})
+		}
+		listValue := &_Params_8_list{list: &x.AdditionalDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "ugdvesting.ugdvesting.Params.enabled":
+		value := x.Enabled
+		return protoreflect.ValueOfBool(value)
+	case "ugdvesting.ugdvesting.Params.activation_height":
+		value := x.ActivationHeight
+		return protoreflect.ValueOfInt64(value)
+	case "ugdvesting.ugdvesting.Params.poll_interval_blocks":
+		value := x.PollIntervalBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "ugdvesting.ugdvesting.Params.poll_interval_time":
+		value := x.PollIntervalTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ugdvesting.ugdvesting.Params.endpoint_path":
+		value := x.EndpointPath
+		return protoreflect.ValueOfString(value)
+	case "ugdvesting.ugdvesting.Params.amendment_operators":
+		if len(x.AmendmentOperators) == 0 {
+			return protoreflect.ValueOfList(&_Params_14_list{})
+		}
+		listValue := &_Params_14_list{list: &x.AmendmentOperators}
+		return protoreflect.ValueOfList(listValue)
+	case "ugdvesting.ugdvesting.Params.mint_enabled":
+		value := x.MintEnabled
+		return protoreflect.ValueOfBool(value)
+	case "ugdvesting.ugdvesting.Params.mint_endpoint_path":
+		value := x.MintEndpointPath
+		return protoreflect.ValueOfString(value)
+	case "ugdvesting.ugdvesting.Params.max_mint_amount":
+		value := x.MaxMintAmount
+		return protoreflect.ValueOfString(value)
+	case "ugdvesting.ugdvesting.Params.max_mint_per_block":
+		value := x.MaxMintPerBlock
+		return protoreflect.ValueOfString(value)
+	case "ugdvesting.ugdvesting.Params.max_total_minted":
+		value := x.MaxTotalMinted
+		return protoreflect.ValueOfString(value)
+	case "ugdvesting.ugdvesting.Params.lock_pending_funds":
+		value := x.LockPendingFunds
+		return protoreflect.ValueOfBool(value)
+	case "ugdvesting.ugdvesting.Params.max_snapshot_bytes":
+		value := x.MaxSnapshotBytes
+		return protoreflect.ValueOfUint64(value)
+	case "ugdvesting.ugdvesting.Params.max_parts":
+		value := x.MaxParts
+		return protoreflect.ValueOfUint32(value)
+	case "ugdvesting.ugdvesting.Params.max_cliff":
+		value := x.MaxCliff
+		return protoreflect.ValueOfUint32(value)
+	case "ugdvesting.ugdvesting.Params.retry_interval_blocks":
+		value := x.RetryIntervalBlocks
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ugdvesting.ugdvesting.Params"))
@@ -223,6 +726,54 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.Precision = uint32(value.Uint())
 	case "ugdvesting.ugdvesting.Params.denom":
 		x.Denom = value.Interface().(string)
+	case "ugdvesting.ugdvesting.Params.relayers":
+		lv := value.List()
+		clv := lv.(*_Params_5_list)
+		x.Relayers = *clv.list
+	case "ugdvesting.ugdvesting.Params.hedgehog_keys":
+		lv := value.List()
+		clv := lv.(*_Params_6_list)
+		x.HedgehogKeys = *clv.list
+	case "ugdvesting.ugdvesting.Params.signature_threshold":
+		x.SignatureThreshold = uint32(value.Uint())
+	case "ugdvesting.ugdvesting.Params.additional_denoms":
+		lv := value.List()
+		clv := lv.(*_Params_8_list)
+		x.AdditionalDenoms = *clv.list
+	case "ugdvesting.ugdvesting.Params.enabled":
+		x.Enabled = value.Bool()
+	case "ugdvesting.ugdvesting.Params.activation_height":
+		x.ActivationHeight = value.Int()
+	case "ugdvesting.ugdvesting.Params.poll_interval_blocks":
+		x.PollIntervalBlocks = value.Uint()
+	case "ugdvesting.ugdvesting.Params.poll_interval_time":
+		x.PollIntervalTime = value.Message().Interface().(*durationpb.Duration)
+	case "ugdvesting.ugdvesting.Params.endpoint_path":
+		x.EndpointPath = value.Interface().(string)
+	case "ugdvesting.ugdvesting.Params.amendment_operators":
+		lv := value.List()
+		clv := lv.(*_Params_14_list)
+		x.AmendmentOperators = *clv.list
+	case "ugdvesting.ugdvesting.Params.mint_enabled":
+		x.MintEnabled = value.Bool()
+	case "ugdvesting.ugdvesting.Params.mint_endpoint_path":
+		x.MintEndpointPath = value.Interface().(string)
+	case "ugdvesting.ugdvesting.Params.max_mint_amount":
+		x.MaxMintAmount = value.Interface().(string)
+	case "ugdvesting.ugdvesting.Params.max_mint_per_block":
+		x.MaxMintPerBlock = value.Interface().(string)
+	case "ugdvesting.ugdvesting.Params.max_total_minted":
+		x.MaxTotalMinted = value.Interface().(string)
+	case "ugdvesting.ugdvesting.Params.lock_pending_funds":
+		x.LockPendingFunds = value.Bool()
+	case "ugdvesting.ugdvesting.Params.max_snapshot_bytes":
+		x.MaxSnapshotBytes = value.Uint()
+	case "ugdvesting.ugdvesting.Params.max_parts":
+		x.MaxParts = uint32(value.Uint())
+	case "ugdvesting.ugdvesting.Params.max_cliff":
+		x.MaxCliff = uint32(value.Uint())
+	case "ugdvesting.ugdvesting.Params.retry_interval_blocks":
+		x.RetryIntervalBlocks = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ugdvesting.ugdvesting.Params"))
@@ -243,6 +794,35 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "ugdvesting.ugdvesting.Params.relayers":
+		if x.Relayers == nil {
+			x.Relayers = []string{}
+		}
+		value := &_Params_5_list{list: &x.Relayers}
+		return protoreflect.ValueOfList(value)
+	case "ugdvesting.ugdvesting.Params.hedgehog_keys":
+		if x.HedgehogKeys == nil {
+			x.HedgehogKeys = []*HedgehogKey{}
+		}
+		value := &_Params_6_list{list: &x.HedgehogKeys}
+		return protoreflect.ValueOfList(value)
+	case "ugdvesting.ugdvesting.Params.additional_denoms":
+		if x.AdditionalDenoms == nil {
+			x.AdditionalDenoms = []string{}
+		}
+		value := &_Params_8_list{list: &x.AdditionalDenoms}
+		return protoreflect.ValueOfList(value)
+	case "ugdvesting.ugdvesting.Params.poll_interval_time":
+		if x.PollIntervalTime == nil {
+			x.PollIntervalTime = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.PollIntervalTime.ProtoReflect())
+	case "ugdvesting.ugdvesting.Params.amendment_operators":
+		if x.AmendmentOperators == nil {
+			x.AmendmentOperators = []string{}
+		}
+		value := &_Params_14_list{list: &x.AmendmentOperators}
+		return protoreflect.ValueOfList(value)
 	case "ugdvesting.ugdvesting.Params.coinPower":
 		panic(fmt.Errorf("field coinPower of message ugdvesting.ugdvesting.Params is not mutable"))
 	case "ugdvesting.ugdvesting.Params.coinPowerValue":
@@ -251,6 +831,36 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field precision of message ugdvesting.ugdvesting.Params is not mutable"))
 	case "ugdvesting.ugdvesting.Params.denom":
 		panic(fmt.Errorf("field denom of message ugdvesting.ugdvesting.Params is not mutable"))
+	case "ugdvesting.ugdvesting.Params.signature_threshold":
+		panic(fmt.Errorf("field signature_threshold of message ugdvesting.ugdvesting.Params is not mutable"))
+	case "ugdvesting.ugdvesting.Params.enabled":
+		panic(fmt.Errorf("field enabled of message ugdvesting.ugdvesting.Params is not mutable"))
+	case "ugdvesting.ugdvesting.Params.activation_height":
+		panic(fmt.Errorf("field activation_height of message ugdvesting.ugdvesting.Params is not mutable"))
+	case "ugdvesting.ugdvesting.Params.poll_interval_blocks":
+		panic(fmt.Errorf("field poll_interval_blocks of message ugdvesting.ugdvesting.Params is not mutable"))
+	case "ugdvesting.ugdvesting.Params.endpoint_path":
+		panic(fmt.Errorf("field endpoint_path of message ugdvesting.ugdvesting.Params is not mutable"))
+	case "ugdvesting.ugdvesting.Params.mint_enabled":
+		panic(fmt.Errorf("field mint_enabled of message ugdvesting.ugdvesting.Params is not mutable"))
+	case "ugdvesting.ugdvesting.Params.mint_endpoint_path":
+		panic(fmt.Errorf("field mint_endpoint_path of message ugdvesting.ugdvesting.Params is not mutable"))
+	case "ugdvesting.ugdvesting.Params.max_mint_amount":
+		panic(fmt.Errorf("field max_mint_amount of message ugdvesting.ugdvesting.Params is not mutable"))
+	case "ugdvesting.ugdvesting.Params.max_mint_per_block":
+		panic(fmt.Errorf("field max_mint_per_block of message ugdvesting.ugdvesting.Params is not mutable"))
+	case "ugdvesting.ugdvesting.Params.max_total_minted":
+		panic(fmt.Errorf("field max_total_minted of message ugdvesting.ugdvesting.Params is not mutable"))
+	case "ugdvesting.ugdvesting.Params.lock_pending_funds":
+		panic(fmt.Errorf("field lock_pending_funds of message ugdvesting.ugdvesting.Params is not mutable"))
+	case "ugdvesting.ugdvesting.Params.max_snapshot_bytes":
+		panic(fmt.Errorf("field max_snapshot_bytes of message ugdvesting.ugdvesting.Params is not mutable"))
+	case "ugdvesting.ugdvesting.Params.max_parts":
+		panic(fmt.Errorf("field max_parts of message ugdvesting.ugdvesting.Params is not mutable"))
+	case "ugdvesting.ugdvesting.Params.max_cliff":
+		panic(fmt.Errorf("field max_cliff of message ugdvesting.ugdvesting.Params is not mutable"))
+	case "ugdvesting.ugdvesting.Params.retry_interval_blocks":
+		panic(fmt.Errorf("field retry_interval_blocks of message ugdvesting.ugdvesting.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ugdvesting.ugdvesting.Params"))
@@ -272,6 +882,51 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfUint32(uint32(0))
 	case "ugdvesting.ugdvesting.Params.denom":
 		return protoreflect.ValueOfString("")
+	case "ugdvesting.ugdvesting.Params.relayers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_5_list{list: &list})
+	case "ugdvesting.ugdvesting.Params.hedgehog_keys":
+		list := []*HedgehogKey{}
+		return protoreflect.ValueOfList(&_Params_6_list{list: &list})
+	case "ugdvesting.ugdvesting.Params.signature_threshold":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "ugdvesting.ugdvesting.Params.additional_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_8_list{list: &list})
+	case "ugdvesting.ugdvesting.Params.enabled":
+		return protoreflect.ValueOfBool(false)
+	case "ugdvesting.ugdvesting.Params.activation_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "ugdvesting.ugdvesting.Params.poll_interval_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ugdvesting.ugdvesting.Params.poll_interval_time":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ugdvesting.ugdvesting.Params.endpoint_path":
+		return protoreflect.ValueOfString("")
+	case "ugdvesting.ugdvesting.Params.amendment_operators":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_14_list{list: &list})
+	case "ugdvesting.ugdvesting.Params.mint_enabled":
+		return protoreflect.ValueOfBool(false)
+	case "ugdvesting.ugdvesting.Params.mint_endpoint_path":
+		return protoreflect.ValueOfString("")
+	case "ugdvesting.ugdvesting.Params.max_mint_amount":
+		return protoreflect.ValueOfString("")
+	case "ugdvesting.ugdvesting.Params.max_mint_per_block":
+		return protoreflect.ValueOfString("")
+	case "ugdvesting.ugdvesting.Params.max_total_minted":
+		return protoreflect.ValueOfString("")
+	case "ugdvesting.ugdvesting.Params.lock_pending_funds":
+		return protoreflect.ValueOfBool(false)
+	case "ugdvesting.ugdvesting.Params.max_snapshot_bytes":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ugdvesting.ugdvesting.Params.max_parts":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "ugdvesting.ugdvesting.Params.max_cliff":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "ugdvesting.ugdvesting.Params.retry_interval_blocks":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ugdvesting.ugdvesting.Params"))
@@ -354,6 +1009,84 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Relayers) > 0 {
+			for _, s := range x.Relayers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.HedgehogKeys) > 0 {
+			for _, e := range x.HedgehogKeys {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.SignatureThreshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.SignatureThreshold))
+		}
+		if len(x.AdditionalDenoms) > 0 {
+			for _, s := range x.AdditionalDenoms {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Enabled {
+			n += 2
+		}
+		if x.ActivationHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ActivationHeight))
+		}
+		if x.PollIntervalBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.PollIntervalBlocks))
+		}
+		if x.PollIntervalTime != nil {
+			l = options.Size(x.PollIntervalTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EndpointPath)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.AmendmentOperators) > 0 {
+			for _, s := range x.AmendmentOperators {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MintEnabled {
+			n += 2
+		}
+		l = len(x.MintEndpointPath)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxMintAmount)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxMintPerBlock)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxTotalMinted)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.LockPendingFunds {
+			n += 3
+		}
+		if x.MaxSnapshotBytes != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxSnapshotBytes))
+		}
+		if x.MaxParts != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxParts))
+		}
+		if x.MaxCliff != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxCliff))
+		}
+		if x.RetryIntervalBlocks != 0 {
+			n += 2 + runtime.Sov(uint64(x.RetryIntervalBlocks))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -383,59 +1116,1294 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Denom) > 0 {
-			i -= len(x.Denom)
-			copy(dAtA[i:], x.Denom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+		if x.RetryIntervalBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RetryIntervalBlocks))
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc0
 		}
-		if x.Precision != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Precision))
+		if x.MaxCliff != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxCliff))
 			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb8
 		}
-		if x.CoinPowerValue != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.CoinPowerValue))
+		if x.MaxParts != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxParts))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb0
 		}
-		if x.CoinPower != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.CoinPower))
+		if x.MaxSnapshotBytes != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxSnapshotBytes))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa8
 		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
+		if x.LockPendingFunds {
+			i--
+			if x.LockPendingFunds {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa0
 		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
+		if len(x.MaxTotalMinted) > 0 {
+			i -= len(x.MaxTotalMinted)
+			copy(dAtA[i:], x.MaxTotalMinted)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxTotalMinted)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
 		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
+		if len(x.MaxMintPerBlock) > 0 {
+			i -= len(x.MaxMintPerBlock)
+			copy(dAtA[i:], x.MaxMintPerBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxMintPerBlock)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+		if len(x.MaxMintAmount) > 0 {
+			i -= len(x.MaxMintAmount)
+			copy(dAtA[i:], x.MaxMintAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxMintAmount)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.MintEndpointPath) > 0 {
+			i -= len(x.MintEndpointPath)
+			copy(dAtA[i:], x.MintEndpointPath)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MintEndpointPath)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if x.MintEnabled {
+			i--
+			if x.MintEnabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x78
+		}
+		if len(x.AmendmentOperators) > 0 {
+			for iNdEx := len(x.AmendmentOperators) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AmendmentOperators[iNdEx])
+				copy(dAtA[i:], x.AmendmentOperators[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AmendmentOperators[iNdEx])))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if len(x.EndpointPath) > 0 {
+			i -= len(x.EndpointPath)
+			copy(dAtA[i:], x.EndpointPath)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EndpointPath)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if x.PollIntervalTime != nil {
+			encoded, err := options.Marshal(x.PollIntervalTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.PollIntervalBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PollIntervalBlocks))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.ActivationHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ActivationHeight))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.Enabled {
+			i--
+			if x.Enabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.AdditionalDenoms) > 0 {
+			for iNdEx := len(x.AdditionalDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AdditionalDenoms[iNdEx])
+				copy(dAtA[i:], x.AdditionalDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AdditionalDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if x.SignatureThreshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SignatureThreshold))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.HedgehogKeys) > 0 {
+			for iNdEx := len(x.HedgehogKeys) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.HedgehogKeys[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.Relayers) > 0 {
+			for iNdEx := len(x.Relayers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Relayers[iNdEx])
+				copy(dAtA[i:], x.Relayers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Relayers[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Precision != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Precision))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.CoinPowerValue != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CoinPowerValue))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.CoinPower != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CoinPower))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CoinPower", wireType)
+				}
+				x.CoinPower = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CoinPower |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CoinPowerValue", wireType)
+				}
+				x.CoinPowerValue = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CoinPowerValue |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
+				}
+				x.Precision = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Precision |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Relayers = append(x.Relayers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HedgehogKeys", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HedgehogKeys = append(x.HedgehogKeys, &HedgehogKey{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.HedgehogKeys[len(x.HedgehogKeys)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignatureThreshold", wireType)
+				}
+				x.SignatureThreshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SignatureThreshold |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AdditionalDenoms", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AdditionalDenoms = append(x.AdditionalDenoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Enabled = bool(v != 0)
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+				}
+				x.ActivationHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ActivationHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PollIntervalBlocks", wireType)
+				}
+				x.PollIntervalBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PollIntervalBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PollIntervalTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PollIntervalTime == nil {
+					x.PollIntervalTime = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PollIntervalTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndpointPath", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EndpointPath = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AmendmentOperators", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AmendmentOperators = append(x.AmendmentOperators, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintEnabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.MintEnabled = bool(v != 0)
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintEndpointPath", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MintEndpointPath = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxMintAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxMintAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxMintPerBlock", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxMintPerBlock = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTotalMinted", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxTotalMinted = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 20:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockPendingFunds", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.LockPendingFunds = bool(v != 0)
+			case 21:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSnapshotBytes", wireType)
+				}
+				x.MaxSnapshotBytes = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxSnapshotBytes |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 22:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxParts", wireType)
+				}
+				x.MaxParts = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxParts |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 23:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxCliff", wireType)
+				}
+				x.MaxCliff = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxCliff |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 24:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetryIntervalBlocks", wireType)
+				}
+				x.RetryIntervalBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RetryIntervalBlocks |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_HedgehogKey           protoreflect.MessageDescriptor
+	fd_HedgehogKey_id        protoreflect.FieldDescriptor
+	fd_HedgehogKey_algorithm protoreflect.FieldDescriptor
+	fd_HedgehogKey_pub_key   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ugdvesting_ugdvesting_params_proto_init()
+	md_HedgehogKey = File_ugdvesting_ugdvesting_params_proto.Messages().ByName("HedgehogKey")
+	fd_HedgehogKey_id = md_HedgehogKey.Fields().ByName("id")
+	fd_HedgehogKey_algorithm = md_HedgehogKey.Fields().ByName("algorithm")
+	fd_HedgehogKey_pub_key = md_HedgehogKey.Fields().ByName("pub_key")
+}
+
+var _ protoreflect.Message = (*fastReflection_HedgehogKey)(nil)
+
+type fastReflection_HedgehogKey HedgehogKey
+
+func (x *HedgehogKey) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_HedgehogKey)(x)
+}
+
+func (x *HedgehogKey) slowProtoReflect() protoreflect.Message {
+	mi := &file_ugdvesting_ugdvesting_params_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_HedgehogKey_messageType fastReflection_HedgehogKey_messageType
+var _ protoreflect.MessageType = fastReflection_HedgehogKey_messageType{}
+
+type fastReflection_HedgehogKey_messageType struct{}
+
+func (x fastReflection_HedgehogKey_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_HedgehogKey)(nil)
+}
+func (x fastReflection_HedgehogKey_messageType) New() protoreflect.Message {
+	return new(fastReflection_HedgehogKey)
+}
+func (x fastReflection_HedgehogKey_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_HedgehogKey
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_HedgehogKey) Descriptor() protoreflect.MessageDescriptor {
+	return md_HedgehogKey
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_HedgehogKey) Type() protoreflect.MessageType {
+	return _fastReflection_HedgehogKey_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_HedgehogKey) New() protoreflect.Message {
+	return new(fastReflection_HedgehogKey)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_HedgehogKey) Interface() protoreflect.ProtoMessage {
+	return (*HedgehogKey)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_HedgehogKey) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != "" {
+		value := protoreflect.ValueOfString(x.Id)
+		if !f(fd_HedgehogKey_id, value) {
+			return
+		}
+	}
+	if x.Algorithm != "" {
+		value := protoreflect.ValueOfString(x.Algorithm)
+		if !f(fd_HedgehogKey_algorithm, value) {
+			return
+		}
+	}
+	if len(x.PubKey) != 0 {
+		value := protoreflect.ValueOfBytes(x.PubKey)
+		if !f(fd_HedgehogKey_pub_key, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_HedgehogKey) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ugdvesting.ugdvesting.HedgehogKey.id":
+		return x.Id != ""
+	case "ugdvesting.ugdvesting.HedgehogKey.algorithm":
+		return x.Algorithm != ""
+	case "ugdvesting.ugdvesting.HedgehogKey.pub_key":
+		return len(x.PubKey) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ugdvesting.ugdvesting.HedgehogKey"))
+		}
+		panic(fmt.Errorf("message ugdvesting.ugdvesting.HedgehogKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_HedgehogKey) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ugdvesting.ugdvesting.HedgehogKey.id":
+		x.Id = ""
+	case "ugdvesting.ugdvesting.HedgehogKey.algorithm":
+		x.Algorithm = ""
+	case "ugdvesting.ugdvesting.HedgehogKey.pub_key":
+		x.PubKey = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ugdvesting.ugdvesting.HedgehogKey"))
+		}
+		panic(fmt.Errorf("message ugdvesting.ugdvesting.HedgehogKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_HedgehogKey) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ugdvesting.ugdvesting.HedgehogKey.id":
+		value := x.Id
+		return protoreflect.ValueOfString(value)
+	case "ugdvesting.ugdvesting.HedgehogKey.algorithm":
+		value := x.Algorithm
+		return protoreflect.ValueOfString(value)
+	case "ugdvesting.ugdvesting.HedgehogKey.pub_key":
+		value := x.PubKey
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ugdvesting.ugdvesting.HedgehogKey"))
+		}
+		panic(fmt.Errorf("message ugdvesting.ugdvesting.HedgehogKey does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_HedgehogKey) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ugdvesting.ugdvesting.HedgehogKey.id":
+		x.Id = value.Interface().(string)
+	case "ugdvesting.ugdvesting.HedgehogKey.algorithm":
+		x.Algorithm = value.Interface().(string)
+	case "ugdvesting.ugdvesting.HedgehogKey.pub_key":
+		x.PubKey = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ugdvesting.ugdvesting.HedgehogKey"))
+		}
+		panic(fmt.Errorf("message ugdvesting.ugdvesting.HedgehogKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_HedgehogKey) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ugdvesting.ugdvesting.HedgehogKey.id":
+		panic(fmt.Errorf("field id of message ugdvesting.ugdvesting.HedgehogKey is not mutable"))
+	case "ugdvesting.ugdvesting.HedgehogKey.algorithm":
+		panic(fmt.Errorf("field algorithm of message ugdvesting.ugdvesting.HedgehogKey is not mutable"))
+	case "ugdvesting.ugdvesting.HedgehogKey.pub_key":
+		panic(fmt.Errorf("field pub_key of message ugdvesting.ugdvesting.HedgehogKey is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ugdvesting.ugdvesting.HedgehogKey"))
+		}
+		panic(fmt.Errorf("message ugdvesting.ugdvesting.HedgehogKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_HedgehogKey) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ugdvesting.ugdvesting.HedgehogKey.id":
+		return protoreflect.ValueOfString("")
+	case "ugdvesting.ugdvesting.HedgehogKey.algorithm":
+		return protoreflect.ValueOfString("")
+	case "ugdvesting.ugdvesting.HedgehogKey.pub_key":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ugdvesting.ugdvesting.HedgehogKey"))
+		}
+		panic(fmt.Errorf("message ugdvesting.ugdvesting.HedgehogKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_HedgehogKey) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ugdvesting.ugdvesting.HedgehogKey", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_HedgehogKey) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_HedgehogKey) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_HedgehogKey) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_HedgehogKey) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*HedgehogKey)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Id)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Algorithm)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PubKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*HedgehogKey)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.PubKey) > 0 {
+			i -= len(x.PubKey)
+			copy(dAtA[i:], x.PubKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PubKey)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Algorithm) > 0 {
+			i -= len(x.Algorithm)
+			copy(dAtA[i:], x.Algorithm)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Algorithm)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Id) > 0 {
+			i -= len(x.Id)
+			copy(dAtA[i:], x.Id)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*HedgehogKey)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
@@ -448,17 +2416,17 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: HedgehogKey: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: HedgehogKey: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CoinPower", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 				}
-				x.CoinPower = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -468,16 +2436,29 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.CoinPower |= uint32(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Id = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CoinPowerValue", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
 				}
-				x.CoinPowerValue = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -487,35 +2468,29 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.CoinPowerValue |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				x.Precision = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Precision |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-			case 4:
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Algorithm = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 				}
-				var stringLen uint64
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -525,23 +2500,25 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Denom = string(dAtA[iNdEx:postIndex])
+				x.PubKey = append(x.PubKey[:0], dAtA[iNdEx:postIndex]...)
+				if x.PubKey == nil {
+					x.PubKey = []byte{}
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -597,10 +2574,75 @@ type Params struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// coinPower is the exponent of the display unit of denom, coinPowerValue
+	// must equal 10^coinPower. Both zero leave them unset.
 	CoinPower      uint32 `protobuf:"varint,1,opt,name=coinPower,proto3" json:"coinPower,omitempty"`
 	CoinPowerValue uint64 `protobuf:"varint,2,opt,name=coinPowerValue,proto3" json:"coinPowerValue,omitempty"`
-	Precision      uint32 `protobuf:"varint,3,opt,name=precision,proto3" json:"precision,omitempty"`
-	Denom          string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	// precision is the number of decimals hedgehog amounts may carry, at most
+	// coinPower. Zero allows coinPower decimals.
+	Precision uint32 `protobuf:"varint,3,opt,name=precision,proto3" json:"precision,omitempty"`
+	Denom     string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	// relayers are the addresses allowed to submit vesting batches through
+	// MsgSubmitVestingBatch.
+	Relayers []string `protobuf:"bytes,5,rep,name=relayers,proto3" json:"relayers,omitempty"`
+	// hedgehog_keys are the keys hedgehog signs vesting snapshots with.
+	HedgehogKeys []*HedgehogKey `protobuf:"bytes,6,rep,name=hedgehog_keys,json=hedgehogKeys,proto3" json:"hedgehog_keys,omitempty"`
+	// signature_threshold is the number of distinct hedgehog_keys that must
+	// sign a vesting snapshot before its entries are accepted. Zero disables
+	// signature verification.
+	SignatureThreshold uint32 `protobuf:"varint,7,opt,name=signature_threshold,json=signatureThreshold,proto3" json:"signature_threshold,omitempty"`
+	// additional_denoms are the denoms besides denom a vesting record may vest
+	// through its additional_amounts.
+	AdditionalDenoms []string `protobuf:"bytes,8,rep,name=additional_denoms,json=additionalDenoms,proto3" json:"additional_denoms,omitempty"`
+	// enabled switches hedgehog ingestion on and off. Pending vesting records
+	// are activated either way.
+	Enabled bool `protobuf:"varint,9,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// activation_height is the first height at which hedgehog is polled and
+	// pending vesting records are activated.
+	ActivationHeight int64 `protobuf:"varint,10,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// poll_interval_blocks is the number of blocks between two hedgehog polls.
+	PollIntervalBlocks uint64 `protobuf:"varint,11,opt,name=poll_interval_blocks,json=pollIntervalBlocks,proto3" json:"poll_interval_blocks,omitempty"`
+	// poll_interval_time is the block time between two hedgehog polls. Exactly
+	// one of poll_interval_blocks and poll_interval_time is set while enabled.
+	PollIntervalTime *durationpb.Duration `protobuf:"bytes,12,opt,name=poll_interval_time,json=pollIntervalTime,proto3" json:"poll_interval_time,omitempty"`
+	// endpoint_path is the path of the hedgehog endpoint serving the vesting
+	// snapshot.
+	EndpointPath string `protobuf:"bytes,13,opt,name=endpoint_path,json=endpointPath,proto3" json:"endpoint_path,omitempty"`
+	// amendment_operators are the addresses allowed, besides the authority, to
+	// amend pending vesting records through MsgAmendPendingVesting.
+	AmendmentOperators []string `protobuf:"bytes,14,rep,name=amendment_operators,json=amendmentOperators,proto3" json:"amendment_operators,omitempty"`
+	// mint_enabled switches the ingestion of hedgehog mint snapshots on and
+	// off. Mints are only ingested while enabled is set as well.
+	MintEnabled bool `protobuf:"varint,15,opt,name=mint_enabled,json=mintEnabled,proto3" json:"mint_enabled,omitempty"`
+	// mint_endpoint_path is the path of the hedgehog endpoint serving the mint
+	// snapshot.
+	MintEndpointPath string `protobuf:"bytes,16,opt,name=mint_endpoint_path,json=mintEndpointPath,proto3" json:"mint_endpoint_path,omitempty"`
+	// max_mint_amount is the largest amount of denom a single mint may mint.
+	// Zero sets no limit.
+	MaxMintAmount string `protobuf:"bytes,17,opt,name=max_mint_amount,json=maxMintAmount,proto3" json:"max_mint_amount,omitempty"`
+	// max_mint_per_block is the largest amount of denom minted in a block.
+	// Zero sets no limit.
+	MaxMintPerBlock string `protobuf:"bytes,18,opt,name=max_mint_per_block,json=maxMintPerBlock,proto3" json:"max_mint_per_block,omitempty"`
+	// max_total_minted is the largest amount of denom the module mints over
+	// its lifetime. Zero sets no limit.
+	MaxTotalMinted string `protobuf:"bytes,19,opt,name=max_total_minted,json=maxTotalMinted,proto3" json:"max_total_minted,omitempty"`
+	// lock_pending_funds blocks sends of the coins a pending vesting record
+	// schedules from its address until the record is processed. Coins above
+	// the scheduled amounts stay spendable.
+	LockPendingFunds bool `protobuf:"varint,20,opt,name=lock_pending_funds,json=lockPendingFunds,proto3" json:"lock_pending_funds,omitempty"`
+	// max_snapshot_bytes is the size of the largest vesting or mint snapshot
+	// validators vote on. Zero applies the default of 1 MiB.
+	MaxSnapshotBytes uint64 `protobuf:"varint,21,opt,name=max_snapshot_bytes,json=maxSnapshotBytes,proto3" json:"max_snapshot_bytes,omitempty"`
+	// max_parts is the largest number of parts of a vesting schedule that is
+	// ingested. Zero applies the default of 1000.
+	MaxParts uint32 `protobuf:"varint,22,opt,name=max_parts,json=maxParts,proto3" json:"max_parts,omitempty"`
+	// max_cliff is the largest cliff, in periods, of a vesting schedule that is
+	// ingested. Zero applies the default of 1000.
+	MaxCliff uint32 `protobuf:"varint,23,opt,name=max_cliff,json=maxCliff,proto3" json:"max_cliff,omitempty"`
+	// retry_interval_blocks is the number of blocks after which the conversion
+	// of a vesting record that failed is attempted again. Zero applies the
+	// default of 100.
+	RetryIntervalBlocks uint32 `protobuf:"varint,24,opt,name=retry_interval_blocks,json=retryIntervalBlocks,proto3" json:"retry_interval_blocks,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -651,6 +2693,202 @@ func (x *Params) GetDenom() string {
 	return ""
 }
 
+func (x *Params) GetRelayers() []string {
+	if x != nil {
+		return x.Relayers
+	}
+	return nil
+}
+
+func (x *Params) GetHedgehogKeys() []*HedgehogKey {
+	if x != nil {
+		return x.HedgehogKeys
+	}
+	return nil
+}
+
+func (x *Params) GetSignatureThreshold() uint32 {
+	if x != nil {
+		return x.SignatureThreshold
+	}
+	return 0
+}
+
+func (x *Params) GetAdditionalDenoms() []string {
+	if x != nil {
+		return x.AdditionalDenoms
+	}
+	return nil
+}
+
+func (x *Params) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Params) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *Params) GetPollIntervalBlocks() uint64 {
+	if x != nil {
+		return x.PollIntervalBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetPollIntervalTime() *durationpb.Duration {
+	if x != nil {
+		return x.PollIntervalTime
+	}
+	return nil
+}
+
+func (x *Params) GetEndpointPath() string {
+	if x != nil {
+		return x.EndpointPath
+	}
+	return ""
+}
+
+func (x *Params) GetAmendmentOperators() []string {
+	if x != nil {
+		return x.AmendmentOperators
+	}
+	return nil
+}
+
+func (x *Params) GetMintEnabled() bool {
+	if x != nil {
+		return x.MintEnabled
+	}
+	return false
+}
+
+func (x *Params) GetMintEndpointPath() string {
+	if x != nil {
+		return x.MintEndpointPath
+	}
+	return ""
+}
+
+func (x *Params) GetMaxMintAmount() string {
+	if x != nil {
+		return x.MaxMintAmount
+	}
+	return ""
+}
+
+func (x *Params) GetMaxMintPerBlock() string {
+	if x != nil {
+		return x.MaxMintPerBlock
+	}
+	return ""
+}
+
+func (x *Params) GetMaxTotalMinted() string {
+	if x != nil {
+		return x.MaxTotalMinted
+	}
+	return ""
+}
+
+func (x *Params) GetLockPendingFunds() bool {
+	if x != nil {
+		return x.LockPendingFunds
+	}
+	return false
+}
+
+func (x *Params) GetMaxSnapshotBytes() uint64 {
+	if x != nil {
+		return x.MaxSnapshotBytes
+	}
+	return 0
+}
+
+func (x *Params) GetMaxParts() uint32 {
+	if x != nil {
+		return x.MaxParts
+	}
+	return 0
+}
+
+func (x *Params) GetMaxCliff() uint32 {
+	if x != nil {
+		return x.MaxCliff
+	}
+	return 0
+}
+
+func (x *Params) GetRetryIntervalBlocks() uint32 {
+	if x != nil {
+		return x.RetryIntervalBlocks
+	}
+	return 0
+}
+
+// HedgehogKey is a public key hedgehog signs vesting snapshots with.
+type HedgehogKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id names the key in the signatures of a snapshot.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// algorithm is the key type, either "ed25519" or "secp256k1".
+	Algorithm string `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	// pub_key is the raw public key, 32 bytes for ed25519 and 33 bytes
+	// (compressed) for secp256k1.
+	PubKey []byte `protobuf:"bytes,3,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+}
+
+func (x *HedgehogKey) Reset() {
+	*x = HedgehogKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ugdvesting_ugdvesting_params_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HedgehogKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HedgehogKey) ProtoMessage() {}
+
+// Deprecated: Use HedgehogKey.ProtoReflect.Descriptor instead.
+func (*HedgehogKey) Descriptor() ([]byte, []int) {
+	return file_ugdvesting_ugdvesting_params_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *HedgehogKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HedgehogKey) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *HedgehogKey) GetPubKey() []byte {
+	if x != nil {
+		return x.PubKey
+	}
+	return nil
+}
+
 var File_ugdvesting_ugdvesting_params_proto protoreflect.FileDescriptor
 
 var file_ugdvesting_ugdvesting_params_proto_rawDesc = []byte{
@@ -658,20 +2896,99 @@ var file_ugdvesting_ugdvesting_params_proto_rawDesc = []byte{
 	0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
 	0x2e, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x1a, 0x11, 0x61, 0x6d, 0x69,
-	0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14,
-	0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xab, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
-	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x69, 0x6e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x09, 0x63, 0x6f, 0x69, 0x6e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x26, 0x0a,
-	0x0e, 0x63, 0x6f, 0x69, 0x6e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x63, 0x6f, 0x69, 0x6e, 0x50, 0x6f, 0x77, 0x65, 0x72,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x3a, 0x27, 0xe8, 0xa0, 0x1f, 0x01, 0x8a,
-	0xe7, 0xb0, 0x2a, 0x1e, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x78,
-	0x2f, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x42, 0xc5, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x75, 0x67, 0x64, 0x76, 0x65,
+	0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x84, 0x0a, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
+	0x69, 0x6e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63,
+	0x6f, 0x69, 0x6e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x69, 0x6e,
+	0x50, 0x6f, 0x77, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x63, 0x6f, 0x69, 0x6e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x08, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x52, 0x0a, 0x0d, 0x68, 0x65,
+	0x64, 0x67, 0x65, 0x68, 0x6f, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x75,
+	0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x48, 0x65, 0x64, 0x67, 0x65, 0x68,
+	0x6f, 0x67, 0x4b, 0x65, 0x79, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0c, 0x68, 0x65, 0x64, 0x67, 0x65, 0x68, 0x6f, 0x67, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x2f,
+	0x0a, 0x13, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12,
+	0x2b, 0x0a, 0x11, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x64, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x6f, 0x6c, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x12, 0x70, 0x6f, 0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x56, 0x0a, 0x12, 0x70, 0x6f, 0x6c, 0x6c, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xc8, 0xde,
+	0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x70, 0x6f, 0x6c,
+	0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x49, 0x0a, 0x13, 0x61, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x12, 0x61, 0x6d, 0x65, 0x6e, 0x64,
+	0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6d, 0x69,
+	0x6e, 0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x58,
+	0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x4d, 0x69,
+	0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x5d, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f,
+	0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e,
+	0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x4d, 0x69, 0x6e, 0x74, 0x50,
+	0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x5a, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x69, 0x6e,
+	0x74, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x75, 0x6e, 0x64,
+	0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d,
+	0x61, 0x78, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x73, 0x18, 0x16, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x72, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6c, 0x69, 0x66, 0x66, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x6d, 0x61, 0x78, 0x43, 0x6c, 0x69, 0x66, 0x66, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x74,
+	0x72, 0x79, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x72, 0x65, 0x74, 0x72, 0x79, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x3a, 0x27, 0xe8,
+	0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x1e, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x2f, 0x78, 0x2f, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x5a, 0x0a, 0x0b, 0x48, 0x65, 0x64, 0x67, 0x65, 0x68,
+	0x6f, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
+	0x68, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x3a, 0x04, 0xe8, 0xa0,
+	0x1f, 0x01, 0x42, 0xc5, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x75, 0x67, 0x64, 0x76, 0x65,
 	0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x75, 0x67, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
 	0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
 	0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
@@ -699,16 +3016,20 @@ func file_ugdvesting_ugdvesting_params_proto_rawDescGZIP() []byte {
 	return file_ugdvesting_ugdvesting_params_proto_rawDescData
 }
 
-var file_ugdvesting_ugdvesting_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_ugdvesting_ugdvesting_params_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_ugdvesting_ugdvesting_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: ugdvesting.ugdvesting.Params
+	(*Params)(nil),              // 0: ugdvesting.ugdvesting.Params
+	(*HedgehogKey)(nil),         // 1: ugdvesting.ugdvesting.HedgehogKey
+	(*durationpb.Duration)(nil), // 2: google.protobuf.Duration
 }
 var file_ugdvesting_ugdvesting_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: ugdvesting.ugdvesting.Params.hedgehog_keys:type_name -> ugdvesting.ugdvesting.HedgehogKey
+	2, // 1: ugdvesting.ugdvesting.Params.poll_interval_time:type_name -> google.protobuf.Duration
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_ugdvesting_ugdvesting_params_proto_init() }
@@ -729,6 +3050,18 @@ func file_ugdvesting_ugdvesting_params_proto_init() {
 				return nil
 			}
 		}
+		file_ugdvesting_ugdvesting_params_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HedgehogKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -736,7 +3069,7 @@ func file_ugdvesting_ugdvesting_params_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ugdvesting_ugdvesting_params_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
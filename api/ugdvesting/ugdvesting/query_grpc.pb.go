@@ -19,7 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName = "/ugdvesting.ugdvesting.Query/Params"
+	Query_Params_FullMethodName            = "/ugdvesting.ugdvesting.Query/Params"
+	Query_VestingRecord_FullMethodName     = "/ugdvesting.ugdvesting.Query/VestingRecord"
+	Query_PendingVestings_FullMethodName   = "/ugdvesting.ugdvesting.Query/PendingVestings"
+	Query_ProcessedVestings_FullMethodName = "/ugdvesting.ugdvesting.Query/ProcessedVestings"
+	Query_VestingBalance_FullMethodName    = "/ugdvesting.ugdvesting.Query/VestingBalance"
+	Query_VestingAmendments_FullMethodName = "/ugdvesting.ugdvesting.Query/VestingAmendments"
+	Query_Mint_FullMethodName              = "/ugdvesting.ugdvesting.Query/Mint"
+	Query_Mints_FullMethodName             = "/ugdvesting.ugdvesting.Query/Mints"
+	Query_LastSnapshot_FullMethodName      = "/ugdvesting.ugdvesting.Query/LastSnapshot"
 )
 
 // QueryClient is the client API for Query service.
@@ -28,6 +36,28 @@ const (
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// VestingRecord queries the hedgehog vesting record stored for an address.
+	VestingRecord(ctx context.Context, in *QueryVestingRecordRequest, opts ...grpc.CallOption) (*QueryVestingRecordResponse, error)
+	// PendingVestings queries the vesting records waiting for their block,
+	// including failed ones waiting for their retry, in block order, optionally
+	// restricted to a range of activation heights.
+	PendingVestings(ctx context.Context, in *QueryPendingVestingsRequest, opts ...grpc.CallOption) (*QueryPendingVestingsResponse, error)
+	// ProcessedVestings queries the vesting records that were converted.
+	ProcessedVestings(ctx context.Context, in *QueryProcessedVestingsRequest, opts ...grpc.CallOption) (*QueryProcessedVestingsResponse, error)
+	// VestingBalance queries the vested, unvested and spendable coins of an
+	// account and its next unlock at a given time.
+	VestingBalance(ctx context.Context, in *QueryVestingBalanceRequest, opts ...grpc.CallOption) (*QueryVestingBalanceResponse, error)
+	// VestingAmendments queries the amendment history of the vesting record of
+	// an address, oldest first.
+	VestingAmendments(ctx context.Context, in *QueryVestingAmendmentsRequest, opts ...grpc.CallOption) (*QueryVestingAmendmentsResponse, error)
+	// Mint queries the record of an executed hedgehog mint by its key.
+	Mint(ctx context.Context, in *QueryMintRequest, opts ...grpc.CallOption) (*QueryMintResponse, error)
+	// Mints queries the records of the executed hedgehog mints, optionally
+	// restricted to an address, and the total minted.
+	Mints(ctx context.Context, in *QueryMintsRequest, opts ...grpc.CallOption) (*QueryMintsResponse, error)
+	// LastSnapshot queries the last accepted hedgehog snapshot of a kind, the
+	// one the next snapshot has to chain to.
+	LastSnapshot(ctx context.Context, in *QueryLastSnapshotRequest, opts ...grpc.CallOption) (*QueryLastSnapshotResponse, error)
 }
 
 type queryClient struct {
@@ -47,12 +77,106 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) VestingRecord(ctx context.Context, in *QueryVestingRecordRequest, opts ...grpc.CallOption) (*QueryVestingRecordResponse, error) {
+	out := new(QueryVestingRecordResponse)
+	err := c.cc.Invoke(ctx, Query_VestingRecord_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingVestings(ctx context.Context, in *QueryPendingVestingsRequest, opts ...grpc.CallOption) (*QueryPendingVestingsResponse, error) {
+	out := new(QueryPendingVestingsResponse)
+	err := c.cc.Invoke(ctx, Query_PendingVestings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProcessedVestings(ctx context.Context, in *QueryProcessedVestingsRequest, opts ...grpc.CallOption) (*QueryProcessedVestingsResponse, error) {
+	out := new(QueryProcessedVestingsResponse)
+	err := c.cc.Invoke(ctx, Query_ProcessedVestings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestingBalance(ctx context.Context, in *QueryVestingBalanceRequest, opts ...grpc.CallOption) (*QueryVestingBalanceResponse, error) {
+	out := new(QueryVestingBalanceResponse)
+	err := c.cc.Invoke(ctx, Query_VestingBalance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestingAmendments(ctx context.Context, in *QueryVestingAmendmentsRequest, opts ...grpc.CallOption) (*QueryVestingAmendmentsResponse, error) {
+	out := new(QueryVestingAmendmentsResponse)
+	err := c.cc.Invoke(ctx, Query_VestingAmendments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Mint(ctx context.Context, in *QueryMintRequest, opts ...grpc.CallOption) (*QueryMintResponse, error) {
+	out := new(QueryMintResponse)
+	err := c.cc.Invoke(ctx, Query_Mint_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Mints(ctx context.Context, in *QueryMintsRequest, opts ...grpc.CallOption) (*QueryMintsResponse, error) {
+	out := new(QueryMintsResponse)
+	err := c.cc.Invoke(ctx, Query_Mints_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LastSnapshot(ctx context.Context, in *QueryLastSnapshotRequest, opts ...grpc.CallOption) (*QueryLastSnapshotResponse, error) {
+	out := new(QueryLastSnapshotResponse)
+	err := c.cc.Invoke(ctx, Query_LastSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// VestingRecord queries the hedgehog vesting record stored for an address.
+	VestingRecord(context.Context, *QueryVestingRecordRequest) (*QueryVestingRecordResponse, error)
+	// PendingVestings queries the vesting records waiting for their block,
+	// including failed ones waiting for their retry, in block order, optionally
+	// restricted to a range of activation heights.
+	PendingVestings(context.Context, *QueryPendingVestingsRequest) (*QueryPendingVestingsResponse, error)
+	// ProcessedVestings queries the vesting records that were converted.
+	ProcessedVestings(context.Context, *QueryProcessedVestingsRequest) (*QueryProcessedVestingsResponse, error)
+	// VestingBalance queries the vested, unvested and spendable coins of an
+	// account and its next unlock at a given time.
+	VestingBalance(context.Context, *QueryVestingBalanceRequest) (*QueryVestingBalanceResponse, error)
+	// VestingAmendments queries the amendment history of the vesting record of
+	// an address, oldest first.
+	VestingAmendments(context.Context, *QueryVestingAmendmentsRequest) (*QueryVestingAmendmentsResponse, error)
+	// Mint queries the record of an executed hedgehog mint by its key.
+	Mint(context.Context, *QueryMintRequest) (*QueryMintResponse, error)
+	// Mints queries the records of the executed hedgehog mints, optionally
+	// restricted to an address, and the total minted.
+	Mints(context.Context, *QueryMintsRequest) (*QueryMintsResponse, error)
+	// LastSnapshot queries the last accepted hedgehog snapshot of a kind, the
+	// one the next snapshot has to chain to.
+	LastSnapshot(context.Context, *QueryLastSnapshotRequest) (*QueryLastSnapshotResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -63,6 +187,30 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) VestingRecord(context.Context, *QueryVestingRecordRequest) (*QueryVestingRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingRecord not implemented")
+}
+func (UnimplementedQueryServer) PendingVestings(context.Context, *QueryPendingVestingsRequest) (*QueryPendingVestingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingVestings not implemented")
+}
+func (UnimplementedQueryServer) ProcessedVestings(context.Context, *QueryProcessedVestingsRequest) (*QueryProcessedVestingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessedVestings not implemented")
+}
+func (UnimplementedQueryServer) VestingBalance(context.Context, *QueryVestingBalanceRequest) (*QueryVestingBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingBalance not implemented")
+}
+func (UnimplementedQueryServer) VestingAmendments(context.Context, *QueryVestingAmendmentsRequest) (*QueryVestingAmendmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingAmendments not implemented")
+}
+func (UnimplementedQueryServer) Mint(context.Context, *QueryMintRequest) (*QueryMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mint not implemented")
+}
+func (UnimplementedQueryServer) Mints(context.Context, *QueryMintsRequest) (*QueryMintsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mints not implemented")
+}
+func (UnimplementedQueryServer) LastSnapshot(context.Context, *QueryLastSnapshotRequest) (*QueryLastSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastSnapshot not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -94,6 +242,150 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VestingRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VestingRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingRecord(ctx, req.(*QueryVestingRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingVestings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingVestingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingVestings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingVestings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingVestings(ctx, req.(*QueryPendingVestingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProcessedVestings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProcessedVestingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProcessedVestings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProcessedVestings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProcessedVestings(ctx, req.(*QueryProcessedVestingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VestingBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingBalance(ctx, req.(*QueryVestingBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingAmendments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingAmendmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingAmendments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VestingAmendments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingAmendments(ctx, req.(*QueryVestingAmendmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Mint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Mint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Mint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Mint(ctx, req.(*QueryMintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Mints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Mints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Mints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Mints(ctx, req.(*QueryMintsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LastSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LastSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastSnapshot(ctx, req.(*QueryLastSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -105,6 +397,38 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "VestingRecord",
+			Handler:    _Query_VestingRecord_Handler,
+		},
+		{
+			MethodName: "PendingVestings",
+			Handler:    _Query_PendingVestings_Handler,
+		},
+		{
+			MethodName: "ProcessedVestings",
+			Handler:    _Query_ProcessedVestings_Handler,
+		},
+		{
+			MethodName: "VestingBalance",
+			Handler:    _Query_VestingBalance_Handler,
+		},
+		{
+			MethodName: "VestingAmendments",
+			Handler:    _Query_VestingAmendments_Handler,
+		},
+		{
+			MethodName: "Mint",
+			Handler:    _Query_Mint_Handler,
+		},
+		{
+			MethodName: "Mints",
+			Handler:    _Query_Mints_Handler,
+		},
+		{
+			MethodName: "LastSnapshot",
+			Handler:    _Query_LastSnapshot_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ugdvesting/ugdvesting/query.proto",
@@ -19,7 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName = "/ugdvesting.ugdvesting.Msg/UpdateParams"
+	Msg_UpdateParams_FullMethodName          = "/ugdvesting.ugdvesting.Msg/UpdateParams"
+	Msg_SubmitVestingBatch_FullMethodName    = "/ugdvesting.ugdvesting.Msg/SubmitVestingBatch"
+	Msg_AddHedgehogKey_FullMethodName        = "/ugdvesting.ugdvesting.Msg/AddHedgehogKey"
+	Msg_RotateHedgehogKey_FullMethodName     = "/ugdvesting.ugdvesting.Msg/RotateHedgehogKey"
+	Msg_RetireHedgehogKey_FullMethodName     = "/ugdvesting.ugdvesting.Msg/RetireHedgehogKey"
+	Msg_CreateVestingSchedule_FullMethodName = "/ugdvesting.ugdvesting.Msg/CreateVestingSchedule"
+	Msg_ClawbackVesting_FullMethodName       = "/ugdvesting.ugdvesting.Msg/ClawbackVesting"
+	Msg_AmendPendingVesting_FullMethodName   = "/ugdvesting.ugdvesting.Msg/AmendPendingVesting"
 )
 
 // MsgClient is the client API for Msg service.
@@ -29,6 +36,27 @@ type MsgClient interface {
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SubmitVestingBatch defines an operation for an authorized relayer to
+	// submit a signed hedgehog vesting snapshot.
+	SubmitVestingBatch(ctx context.Context, in *MsgSubmitVestingBatch, opts ...grpc.CallOption) (*MsgSubmitVestingBatchResponse, error)
+	// AddHedgehogKey defines a (governance) operation for adding a key to the
+	// hedgehog signer set.
+	AddHedgehogKey(ctx context.Context, in *MsgAddHedgehogKey, opts ...grpc.CallOption) (*MsgAddHedgehogKeyResponse, error)
+	// RotateHedgehogKey defines a (governance) operation for replacing the
+	// public key of a hedgehog signer.
+	RotateHedgehogKey(ctx context.Context, in *MsgRotateHedgehogKey, opts ...grpc.CallOption) (*MsgRotateHedgehogKeyResponse, error)
+	// RetireHedgehogKey defines a (governance) operation for removing a key
+	// from the hedgehog signer set.
+	RetireHedgehogKey(ctx context.Context, in *MsgRetireHedgehogKey, opts ...grpc.CallOption) (*MsgRetireHedgehogKeyResponse, error)
+	// CreateVestingSchedule defines a (governance) operation for registering a
+	// vesting schedule without hedgehog.
+	CreateVestingSchedule(ctx context.Context, in *MsgCreateVestingSchedule, opts ...grpc.CallOption) (*MsgCreateVestingScheduleResponse, error)
+	// ClawbackVesting defines a (governance) operation for ending the vesting
+	// schedule of an account and taking back its unvested coins.
+	ClawbackVesting(ctx context.Context, in *MsgClawbackVesting, opts ...grpc.CallOption) (*MsgClawbackVestingResponse, error)
+	// AmendPendingVesting defines an operation for the authority or an
+	// amendment operator to change the terms of a pending vesting record.
+	AmendPendingVesting(ctx context.Context, in *MsgAmendPendingVesting, opts ...grpc.CallOption) (*MsgAmendPendingVestingResponse, error)
 }
 
 type msgClient struct {
@@ -48,6 +76,69 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SubmitVestingBatch(ctx context.Context, in *MsgSubmitVestingBatch, opts ...grpc.CallOption) (*MsgSubmitVestingBatchResponse, error) {
+	out := new(MsgSubmitVestingBatchResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitVestingBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddHedgehogKey(ctx context.Context, in *MsgAddHedgehogKey, opts ...grpc.CallOption) (*MsgAddHedgehogKeyResponse, error) {
+	out := new(MsgAddHedgehogKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_AddHedgehogKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RotateHedgehogKey(ctx context.Context, in *MsgRotateHedgehogKey, opts ...grpc.CallOption) (*MsgRotateHedgehogKeyResponse, error) {
+	out := new(MsgRotateHedgehogKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_RotateHedgehogKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RetireHedgehogKey(ctx context.Context, in *MsgRetireHedgehogKey, opts ...grpc.CallOption) (*MsgRetireHedgehogKeyResponse, error) {
+	out := new(MsgRetireHedgehogKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_RetireHedgehogKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateVestingSchedule(ctx context.Context, in *MsgCreateVestingSchedule, opts ...grpc.CallOption) (*MsgCreateVestingScheduleResponse, error) {
+	out := new(MsgCreateVestingScheduleResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateVestingSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClawbackVesting(ctx context.Context, in *MsgClawbackVesting, opts ...grpc.CallOption) (*MsgClawbackVestingResponse, error) {
+	out := new(MsgClawbackVestingResponse)
+	err := c.cc.Invoke(ctx, Msg_ClawbackVesting_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AmendPendingVesting(ctx context.Context, in *MsgAmendPendingVesting, opts ...grpc.CallOption) (*MsgAmendPendingVestingResponse, error) {
+	out := new(MsgAmendPendingVestingResponse)
+	err := c.cc.Invoke(ctx, Msg_AmendPendingVesting_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -55,6 +146,27 @@ type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SubmitVestingBatch defines an operation for an authorized relayer to
+	// submit a signed hedgehog vesting snapshot.
+	SubmitVestingBatch(context.Context, *MsgSubmitVestingBatch) (*MsgSubmitVestingBatchResponse, error)
+	// AddHedgehogKey defines a (governance) operation for adding a key to the
+	// hedgehog signer set.
+	AddHedgehogKey(context.Context, *MsgAddHedgehogKey) (*MsgAddHedgehogKeyResponse, error)
+	// RotateHedgehogKey defines a (governance) operation for replacing the
+	// public key of a hedgehog signer.
+	RotateHedgehogKey(context.Context, *MsgRotateHedgehogKey) (*MsgRotateHedgehogKeyResponse, error)
+	// RetireHedgehogKey defines a (governance) operation for removing a key
+	// from the hedgehog signer set.
+	RetireHedgehogKey(context.Context, *MsgRetireHedgehogKey) (*MsgRetireHedgehogKeyResponse, error)
+	// CreateVestingSchedule defines a (governance) operation for registering a
+	// vesting schedule without hedgehog.
+	CreateVestingSchedule(context.Context, *MsgCreateVestingSchedule) (*MsgCreateVestingScheduleResponse, error)
+	// ClawbackVesting defines a (governance) operation for ending the vesting
+	// schedule of an account and taking back its unvested coins.
+	ClawbackVesting(context.Context, *MsgClawbackVesting) (*MsgClawbackVestingResponse, error)
+	// AmendPendingVesting defines an operation for the authority or an
+	// amendment operator to change the terms of a pending vesting record.
+	AmendPendingVesting(context.Context, *MsgAmendPendingVesting) (*MsgAmendPendingVestingResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -65,6 +177,27 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) SubmitVestingBatch(context.Context, *MsgSubmitVestingBatch) (*MsgSubmitVestingBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitVestingBatch not implemented")
+}
+func (UnimplementedMsgServer) AddHedgehogKey(context.Context, *MsgAddHedgehogKey) (*MsgAddHedgehogKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddHedgehogKey not implemented")
+}
+func (UnimplementedMsgServer) RotateHedgehogKey(context.Context, *MsgRotateHedgehogKey) (*MsgRotateHedgehogKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateHedgehogKey not implemented")
+}
+func (UnimplementedMsgServer) RetireHedgehogKey(context.Context, *MsgRetireHedgehogKey) (*MsgRetireHedgehogKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetireHedgehogKey not implemented")
+}
+func (UnimplementedMsgServer) CreateVestingSchedule(context.Context, *MsgCreateVestingSchedule) (*MsgCreateVestingScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVestingSchedule not implemented")
+}
+func (UnimplementedMsgServer) ClawbackVesting(context.Context, *MsgClawbackVesting) (*MsgClawbackVestingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClawbackVesting not implemented")
+}
+func (UnimplementedMsgServer) AmendPendingVesting(context.Context, *MsgAmendPendingVesting) (*MsgAmendPendingVestingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendPendingVesting not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -96,6 +229,132 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitVestingBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitVestingBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitVestingBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitVestingBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitVestingBatch(ctx, req.(*MsgSubmitVestingBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddHedgehogKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddHedgehogKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddHedgehogKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddHedgehogKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddHedgehogKey(ctx, req.(*MsgAddHedgehogKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotateHedgehogKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateHedgehogKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateHedgehogKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RotateHedgehogKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateHedgehogKey(ctx, req.(*MsgRotateHedgehogKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RetireHedgehogKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetireHedgehogKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetireHedgehogKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RetireHedgehogKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetireHedgehogKey(ctx, req.(*MsgRetireHedgehogKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateVestingSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateVestingSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateVestingSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateVestingSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateVestingSchedule(ctx, req.(*MsgCreateVestingSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClawbackVesting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClawbackVesting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClawbackVesting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClawbackVesting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClawbackVesting(ctx, req.(*MsgClawbackVesting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AmendPendingVesting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAmendPendingVesting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AmendPendingVesting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AmendPendingVesting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AmendPendingVesting(ctx, req.(*MsgAmendPendingVesting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -107,6 +366,34 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SubmitVestingBatch",
+			Handler:    _Msg_SubmitVestingBatch_Handler,
+		},
+		{
+			MethodName: "AddHedgehogKey",
+			Handler:    _Msg_AddHedgehogKey_Handler,
+		},
+		{
+			MethodName: "RotateHedgehogKey",
+			Handler:    _Msg_RotateHedgehogKey_Handler,
+		},
+		{
+			MethodName: "RetireHedgehogKey",
+			Handler:    _Msg_RetireHedgehogKey_Handler,
+		},
+		{
+			MethodName: "CreateVestingSchedule",
+			Handler:    _Msg_CreateVestingSchedule_Handler,
+		},
+		{
+			MethodName: "ClawbackVesting",
+			Handler:    _Msg_ClawbackVesting_Handler,
+		},
+		{
+			MethodName: "AmendPendingVesting",
+			Handler:    _Msg_AmendPendingVesting_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ugdvesting/ugdvesting/tx.proto",
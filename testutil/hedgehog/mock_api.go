@@ -0,0 +1,93 @@
+package hedgehog
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+
+	types "github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+// MockAPI is a mock of API interface.
+type MockAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIMockRecorder
+}
+
+// MockAPIMockRecorder is the mock recorder for MockAPI.
+type MockAPIMockRecorder struct {
+	mock *MockAPI
+}
+
+// NewMockAPI creates a new mock instance.
+func NewMockAPI(ctrl *gomock.Controller) *MockAPI {
+	mock := &MockAPI{ctrl: ctrl}
+	mock.recorder = &MockAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPI) EXPECT() *MockAPIMockRecorder {
+	return m.recorder
+}
+
+// FetchMintStorage mocks base method.
+func (m *MockAPI) FetchMintStorage(ctx context.Context) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchMintStorage", ctx)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchMintStorage indicates an expected call of FetchMintStorage.
+func (mr *MockAPIMockRecorder) FetchMintStorage(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchMintStorage", reflect.TypeOf((*MockAPI)(nil).FetchMintStorage), ctx)
+}
+
+// FetchVestingStorage mocks base method.
+func (m *MockAPI) FetchVestingStorage(ctx context.Context) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchVestingStorage", ctx)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchVestingStorage indicates an expected call of FetchVestingStorage.
+func (mr *MockAPIMockRecorder) FetchVestingStorage(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchVestingStorage", reflect.TypeOf((*MockAPI)(nil).FetchVestingStorage), ctx)
+}
+
+// InMintingList mocks base method.
+func (m *MockAPI) InMintingList(ctx context.Context, address string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InMintingList", ctx, address)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InMintingList indicates an expected call of InMintingList.
+func (mr *MockAPIMockRecorder) InMintingList(ctx, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InMintingList", reflect.TypeOf((*MockAPI)(nil).InMintingList), ctx, address)
+}
+
+// VestingByAddress mocks base method.
+func (m *MockAPI) VestingByAddress(ctx context.Context, address string) (types.HedgehogVesting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VestingByAddress", ctx, address)
+	ret0, _ := ret[0].(types.HedgehogVesting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VestingByAddress indicates an expected call of VestingByAddress.
+func (mr *MockAPIMockRecorder) VestingByAddress(ctx, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VestingByAddress", reflect.TypeOf((*MockAPI)(nil).VestingByAddress), ctx, address)
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+// hedgehogKey is the key the hedgehog snapshots of tests are signed with.
+var hedgehogKey = ed25519.GenPrivKeyFromSecret([]byte("hedgehog"))
+
+// HedgehogKey is the signer set entry of the key SignSnapshot signs with.
+var HedgehogKey = types.HedgehogKey{
+	Id:        "hedgehog",
+	Algorithm: types.KeyAlgorithmEd25519,
+	PubKey:    hedgehogKey.PubKey().Bytes(),
+}
+
+// Params returns the default params with ingestion enabled and HedgehogKey as
+// the signer set. Test keepers start with them.
+func Params() types.Params {
+	params := types.DefaultParams()
+	params.Enabled = true
+	params.HedgehogKeys = []types.HedgehogKey{HedgehogKey}
+	params.SignatureThreshold = 1
+	return params
+}
+
+// SignSnapshot returns the hedgehog snapshot document doc signed with the
+// key of HedgehogKey.
+func SignSnapshot(doc []byte) []byte {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(doc, &fields); err != nil {
+		panic(err)
+	}
+
+	var data bytes.Buffer
+	if raw, ok := fields["data"]; ok {
+		if err := json.Compact(&data, raw); err != nil {
+			panic(err)
+		}
+	}
+	sig, err := hedgehogKey.Sign(data.Bytes())
+	if err != nil {
+		panic(err)
+	}
+
+	fields["signature"], err = json.Marshal(HedgehogKey.Id + ":" + base64.StdEncoding.EncodeToString(sig))
+	if err != nil {
+		panic(err)
+	}
+	signed, err := json.Marshal(fields)
+	if err != nil {
+		panic(err)
+	}
+	return signed
+}
@@ -18,12 +18,52 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/keeper"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/source"
 	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
 )
 
 func UgdvestingKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
-	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	k, ctx, _, _ := UgdvestingKeeperWithMocks(t)
+	return k, ctx
+}
+
+// UgdvestingKeeperWithSource returns a keeper reading vesting snapshots from
+// the given source.
+func UgdvestingKeeperWithSource(t testing.TB, vestingSource types.VestingSource) (keeper.Keeper, sdk.Context) {
+	k, ctx, _, _ := ugdvestingKeeper(t, vestingSource)
+	return k, ctx
+}
+
+// UgdvestingKeeperWithMocks returns the keeper together with the mocked account
+// and bank keepers it was built with, so tests can set expectations on them.
+// The keeper has no staking keeper.
+func UgdvestingKeeperWithMocks(t testing.TB) (keeper.Keeper, sdk.Context, *MockAccountKeeper, *MockBankKeeper) {
+	return ugdvestingKeeper(t, source.NewMemorySource(nil))
+}
+
+// UgdvestingKeeperWithStakingMocks also returns the mocked distribution and
+// staking keepers, for tests of clawbacks.
+func UgdvestingKeeperWithStakingMocks(t testing.TB) (keeper.Keeper, sdk.Context, *MockAccountKeeper, *MockBankKeeper, *MockDistributionKeeper, *MockStakingKeeper) {
+	ctrl := gomock.NewController(t)
+	mockDistrKeeper := NewMockDistributionKeeper(ctrl)
+	mockStakingKeeper := NewMockStakingKeeper(ctrl)
+	k, ctx, ak, bk := newUgdvestingKeeper(t, ctrl, source.NewMemorySource(nil), mockDistrKeeper, mockStakingKeeper)
+	return k, ctx, ak, bk, mockDistrKeeper, mockStakingKeeper
+}
+
+func ugdvestingKeeper(t testing.TB, vestingSource types.VestingSource) (keeper.Keeper, sdk.Context, *MockAccountKeeper, *MockBankKeeper) {
 	ctrl := gomock.NewController(t)
+	return newUgdvestingKeeper(t, ctrl, vestingSource, NewMockDistributionKeeper(ctrl), nil)
+}
+
+func newUgdvestingKeeper(
+	t testing.TB,
+	ctrl *gomock.Controller,
+	vestingSource types.VestingSource,
+	dk types.DistributionKeeper,
+	sk types.StakingKeeper,
+) (keeper.Keeper, sdk.Context, *MockAccountKeeper, *MockBankKeeper) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 	db := dbm.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
@@ -42,12 +82,15 @@ func UgdvestingKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		authority.String(),
 		mockBankKeeper,
 		mockAccountKeeper,
+		dk,
+		sk,
+		vestingSource,
 	)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
 
 	// Initialize params
-	k.SetParams(ctx, types.DefaultParams())
+	k.SetParams(ctx, Params())
 
-	return k, ctx
+	return k, ctx, mockAccountKeeper, mockBankKeeper
 }
@@ -30,6 +30,8 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
+	cmd.AddCommand(CmdRelay())
+	cmd.AddCommand(CmdAmendPendingVesting())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+const (
+	flagAmount   = "amount"
+	flagStart    = "start"
+	flagDuration = "duration"
+	flagParts    = "parts"
+	flagPercent  = "percent"
+	flagCliff    = "cliff"
+	flagBlock    = "block"
+	flagReason   = "reason"
+)
+
+// CmdAmendPendingVesting amends the terms of a pending vesting record. The
+// terms not given as flags keep their current value, the amendment fails if
+// the record changes before it is executed.
+func CmdAmendPendingVesting() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "amend-pending-vesting [address]",
+		Short: "Amend the terms of a pending vesting record",
+		Long: `Change the terms of the pending vesting record of an address before its
+activation block. Only the given terms change, the others keep their current
+value. The amendment fails if the record changed between the query of its
+current terms and the execution of the transaction. The --from address must
+be the module authority or listed in the amendment_operators module parameter.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).VestingRecord(cmd.Context(), &types.QueryVestingRecordRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+			record := res.Record
+
+			msg := &types.MsgAmendPendingVesting{
+				Signer:   clientCtx.GetFromAddress().String(),
+				Address:  record.Address,
+				Amount:   record.Amount,
+				Start:    record.Start,
+				Duration: record.Duration,
+				Parts:    record.Parts,
+				Percent:  record.Percent,
+				Cliff:    record.Cliff,
+				Block:    record.Block,
+				// a change of the record after this read fails the amendment
+				ExpectedHash: record.Hash(),
+			}
+
+			fs := cmd.Flags()
+			if fs.Changed(flagAmount) {
+				amount, err := fs.GetString(flagAmount)
+				if err != nil {
+					return err
+				}
+				var ok bool
+				if msg.Amount, ok = math.NewIntFromString(amount); !ok {
+					return fmt.Errorf("invalid amount %q", amount)
+				}
+			}
+			for name, value := range map[string]*int64{flagStart: &msg.Start, flagDuration: &msg.Duration, flagBlock: &msg.Block} {
+				if fs.Changed(name) {
+					if *value, err = fs.GetInt64(name); err != nil {
+						return err
+					}
+				}
+			}
+			for name, value := range map[string]*int32{flagParts: &msg.Parts, flagPercent: &msg.Percent, flagCliff: &msg.Cliff} {
+				if fs.Changed(name) {
+					if *value, err = fs.GetInt32(name); err != nil {
+						return err
+					}
+				}
+			}
+			if msg.Reason, err = fs.GetString(flagReason); err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, fs, msg)
+		},
+	}
+
+	cmd.Flags().String(flagAmount, "", "Amount to vest, in base units")
+	cmd.Flags().Int64(flagStart, 0, "Start of vesting, in Unix seconds")
+	cmd.Flags().Int64(flagDuration, 0, "Length of a vesting period, in seconds")
+	cmd.Flags().Int32(flagParts, 0, "Number of vesting periods")
+	cmd.Flags().Int32(flagPercent, 0, "Percent released at the start")
+	cmd.Flags().Int32(flagCliff, 0, "Number of periods before the first release")
+	cmd.Flags().Int64(flagBlock, 0, "Activation height")
+	cmd.Flags().String(flagReason, "", "Reason recorded in the amendment history")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,166 @@
+package cli
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/hedgehog"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+const (
+	flagHedgehogURL  = "hedgehog-url"
+	flagPollInterval = "poll-interval"
+	flagOnce         = "once"
+)
+
+// CmdRelay polls the hedgehog vesting-storage endpoint and submits every new
+// snapshot through MsgSubmitVestingBatch.
+func CmdRelay() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relay",
+		Short: "Relay hedgehog vesting entries to the chain",
+		Long: `Poll the hedgehog vesting-storage endpoint and broadcast every new signed
+snapshot in a MsgSubmitVestingBatch. The chain verifies its hedgehog signatures
+and its ordering before storing any entry. The --from address must be listed
+in the relayers module parameter.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			hedgehogURL, err := cmd.Flags().GetString(flagHedgehogURL)
+			if err != nil {
+				return err
+			}
+			interval, err := cmd.Flags().GetDuration(flagPollInterval)
+			if err != nil {
+				return err
+			}
+			if err := hedgehog.ValidatePollInterval(interval); err != nil {
+				return err
+			}
+			once, err := cmd.Flags().GetBool(flagOnce)
+			if err != nil {
+				return err
+			}
+
+			relayer := &vestingRelayer{hedgehogURL: hedgehogURL}
+			for {
+				err := relayer.relay(cmd, clientCtx)
+				if once {
+					return err
+				}
+				if err != nil {
+					cmd.PrintErrln("relay failed:", err)
+				}
+
+				select {
+				case <-cmd.Context().Done():
+					return nil
+				case <-time.After(interval):
+				}
+			}
+		},
+	}
+
+	cmd.Flags().String(flagHedgehogURL, "https://127.0.0.1:39886", "Base URL of the hedgehog node")
+	cmd.Flags().Duration(flagPollInterval, time.Minute, "Time between two polls of hedgehog")
+	cmd.Flags().Bool(flagOnce, false, "Relay a single time and exit")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// vestingRelayer keeps the hedgehog client between two relays, so unchanged
+// snapshots are revalidated instead of downloaded again, and the hash of the
+// last snapshot it broadcast or found accepted on chain, so it is not relayed
+// again.
+type vestingRelayer struct {
+	hedgehogURL string
+	path        string
+	client      hedgehog.API
+	last        [sha256.Size]byte
+}
+
+// relay fetches the current snapshot from the endpoint configured in the
+// module params and broadcasts it when it is signed and follows the last
+// snapshot the chain accepted, so snapshots the chain would reject cost no
+// fees.
+func (r *vestingRelayer) relay(cmd *cobra.Command, clientCtx client.Context) error {
+	queryClient := types.NewQueryClient(clientCtx)
+
+	params, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+	if err != nil {
+		return err
+	}
+	if !params.Params.Enabled {
+		cmd.Println("vesting ingestion is disabled")
+		return nil
+	}
+
+	if r.client == nil || r.path != params.Params.EndpointPath {
+		config := hedgehog.DefaultConfig()
+		config.Path = params.Params.EndpointPath
+		r.path = params.Params.EndpointPath
+		r.client = hedgehog.NewClientWithConfig(r.hedgehogURL, config)
+	}
+
+	body, err := r.client.FetchVestingStorage(cmd.Context())
+	if err != nil {
+		return err
+	}
+	if len(body) == 0 {
+		return nil
+	}
+	if hash := sha256.Sum256(body); hash == r.last {
+		cmd.Println("no new vesting snapshot")
+		return nil
+	}
+
+	snapshot, err := types.ParseVestingSnapshot(body)
+	if err != nil {
+		return fmt.Errorf("invalid vesting snapshot: %w", err)
+	}
+
+	// the chain refuses unsigned and out of order snapshots, checking first
+	// saves the fees
+	if err := params.Params.VerifySnapshotSignature(snapshot.SnapshotHeader); err != nil {
+		return err
+	}
+	last, err := queryClient.LastSnapshot(cmd.Context(), &types.QueryLastSnapshotRequest{Kind: types.SnapshotKindVesting})
+	if err != nil {
+		return err
+	}
+	hash := sha256.Sum256(body)
+	if reason, err := types.CheckSnapshotOrder(last.State, snapshot.SnapshotHeader, hash[:]); err != nil {
+		if reason == types.SnapshotRejectedReplayed {
+			r.last = hash
+			cmd.Println("vesting snapshot already accepted")
+			return nil
+		}
+		return fmt.Errorf("vesting snapshot not relayed, %s: %w", reason, err)
+	}
+
+	msg := &types.MsgSubmitVestingBatch{
+		Relayer:  clientCtx.GetFromAddress().String(),
+		Snapshot: body,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg); err != nil {
+		return err
+	}
+	r.last = hash
+	return nil
+}
@@ -0,0 +1,362 @@
+// Package hedgehog implements the HTTP client validators and relayers use to
+// read documents served by a hedgehog node.
+package hedgehog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/unigrid-project/cosmos-common/common/httpclient"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+var (
+	// ErrNotFound is returned when hedgehog has nothing to serve for the
+	// request.
+	ErrNotFound = errors.New("not found on hedgehog")
+
+	// ErrUnauthorized is returned when hedgehog refuses the request.
+	ErrUnauthorized = errors.New("unauthorized by hedgehog")
+
+	// ErrBadPayload is returned when hedgehog serves a document that cannot
+	// be decoded.
+	ErrBadPayload = errors.New("invalid hedgehog payload")
+
+	// ErrUnavailable is returned when hedgehog cannot be reached or fails to
+	// serve the request. The request may succeed later.
+	ErrUnavailable = errors.New("hedgehog unavailable")
+
+	// ErrCircuitOpen is returned while the client stops calling a failing
+	// node.
+	ErrCircuitOpen = fmt.Errorf("hedgehog circuit breaker is open: %w", ErrUnavailable)
+)
+
+// StatusError is returned when hedgehog answers with an unexpected status. It
+// unwraps to ErrNotFound, ErrUnauthorized or ErrUnavailable depending on the
+// status.
+type StatusError struct {
+	Code   int
+	Status string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("hedgehog responded with status %s", e.Status)
+}
+
+func (e *StatusError) Unwrap() error {
+	switch {
+	case e.Code == http.StatusNotFound:
+		return ErrNotFound
+	case e.Code == http.StatusUnauthorized || e.Code == http.StatusForbidden:
+		return ErrUnauthorized
+	case e.Code >= 500 || e.Code == http.StatusTooManyRequests:
+		return ErrUnavailable
+	default:
+		return nil
+	}
+}
+
+// API is the hedgehog API a Client serves. Callers depend on it, so tests can
+// replace the hedgehog node with a mock.
+type API interface {
+	FetchVestingStorage(ctx context.Context) ([]byte, error)
+	FetchMintStorage(ctx context.Context) ([]byte, error)
+	VestingByAddress(ctx context.Context, address string) (types.HedgehogVesting, error)
+	InMintingList(ctx context.Context, address string) (bool, error)
+}
+
+var _ API = (*Client)(nil)
+
+// Config tunes the retry and circuit breaker behaviour of a Client.
+type Config struct {
+	// RequestTimeout bounds every single attempt.
+	RequestTimeout time.Duration
+	// MaxRetries is the number of attempts made after the first one failed.
+	MaxRetries int
+	// InitialBackoff is the wait before the first retry, it doubles with
+	// every retry up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// FailureThreshold is the number of consecutive failed fetches that opens
+	// the circuit breaker, OpenDuration how long it then stays open.
+	FailureThreshold int
+	OpenDuration     time.Duration
+	// Path is the path of the vesting-storage endpoint.
+	Path string
+	// MintPath is the path of the mint-storage endpoint.
+	MintPath string
+}
+
+// DefaultConfig returns the configuration used by NewClient.
+func DefaultConfig() Config {
+	return Config{
+		RequestTimeout:   2 * time.Second,
+		MaxRetries:       3,
+		InitialBackoff:   200 * time.Millisecond,
+		MaxBackoff:       2 * time.Second,
+		FailureThreshold: 5,
+		OpenDuration:     30 * time.Second,
+		Path:             types.VestingStoragePath,
+		MintPath:         types.MintStoragePath,
+	}
+}
+
+// Client fetches the vesting-storage and mint-storage documents of a hedgehog
+// node. Failed requests are retried with exponential backoff, a node that
+// keeps failing is left alone while its circuit breaker is open, and the
+// last document of each endpoint is revalidated with ETag and
+// If-Modified-Since instead of downloaded again.
+type Client struct {
+	baseURL    string
+	config     Config
+	httpClient *http.Client
+	now        func() time.Time
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	// probing is set while the single request let through a half-open
+	// breaker is in flight.
+	probing bool
+	cache   map[string]cachedDocument
+}
+
+// cachedDocument is the last document served at a path, with the validators
+// hedgehog sent along.
+type cachedDocument struct {
+	body         []byte
+	etag         string
+	lastModified string
+}
+
+// NewClient returns a client for the hedgehog node at baseURL using
+// DefaultConfig.
+func NewClient(baseURL string) *Client {
+	return NewClientWithConfig(baseURL, DefaultConfig())
+}
+
+// NewClientWithConfig returns a client for the hedgehog node at baseURL.
+func NewClientWithConfig(baseURL string, config Config) *Client {
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		config:     config,
+		httpClient: httpclient.Client,
+		now:        time.Now,
+		cache:      make(map[string]cachedDocument),
+	}
+}
+
+// FetchVestingStorage returns the current vesting-storage document, nil when
+// hedgehog has nothing to serve. An unchanged document is served from the
+// cache after hedgehog confirmed it with 304 Not Modified.
+func (c *Client) FetchVestingStorage(ctx context.Context) ([]byte, error) {
+	return c.get(ctx, c.config.Path, true)
+}
+
+// FetchMintStorage returns the current mint-storage document, nil when
+// hedgehog has nothing to serve. It is cached like the vesting-storage
+// document.
+func (c *Client) FetchMintStorage(ctx context.Context) ([]byte, error) {
+	return c.get(ctx, c.config.MintPath, true)
+}
+
+// VestingByAddress returns the vesting-storage entry of address. It returns
+// ErrNotFound when hedgehog has no entry for the address.
+func (c *Client) VestingByAddress(ctx context.Context, address string) (types.HedgehogVesting, error) {
+	body, err := c.get(ctx, c.config.Path+"/"+url.PathEscape(address), false)
+	if err != nil {
+		return types.HedgehogVesting{}, err
+	}
+	if body == nil {
+		return types.HedgehogVesting{}, fmt.Errorf("vesting of %s: %w", address, ErrNotFound)
+	}
+
+	var vesting types.HedgehogVesting
+	if err := json.Unmarshal(body, &vesting); err != nil {
+		return types.HedgehogVesting{}, fmt.Errorf("%w: %w", ErrBadPayload, err)
+	}
+	return vesting, nil
+}
+
+// InMintingList returns whether hedgehog lists a mint for address in its
+// mint-storage document.
+func (c *Client) InMintingList(ctx context.Context, address string) (bool, error) {
+	body, err := c.get(ctx, c.config.MintPath+"/"+url.PathEscape(address), false)
+	if err != nil || body == nil {
+		return false, err
+	}
+
+	var storage types.MintSnapshot
+	if err := json.Unmarshal(body, &storage); err != nil {
+		return false, fmt.Errorf("%w: %w", ErrBadPayload, err)
+	}
+	for key := range storage.Data.Mints {
+		if strings.Contains(key, address) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// get returns the document at path, nil when hedgehog has nothing to serve.
+// Requests failing with ErrUnavailable are retried and counted by the circuit
+// breaker. Once the breaker was open for OpenDuration it is half-open: a single
+// request probes hedgehog without retries while other callers still get
+// ErrCircuitOpen, and its outcome closes or reopens the breaker. Only the
+// storage documents are cached.
+func (c *Client) get(ctx context.Context, path string, cached bool) ([]byte, error) {
+	probe, err := c.admit()
+	if err != nil {
+		return nil, err
+	}
+
+	retries := c.config.MaxRetries
+	if probe {
+		retries = 0
+	}
+
+	backoff := c.config.InitialBackoff
+	for attempt := 0; ; attempt++ {
+		body, err := c.fetch(ctx, path, cached)
+		if err == nil {
+			c.recordSuccess()
+			return body, nil
+		}
+
+		if !errors.Is(err, ErrUnavailable) {
+			// hedgehog answered, only unavailability counts as a failure
+			c.recordSuccess()
+			return nil, err
+		}
+		if attempt >= retries || ctx.Err() != nil {
+			c.recordFailure(probe)
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			c.recordFailure(probe)
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > c.config.MaxBackoff {
+			backoff = c.config.MaxBackoff
+		}
+	}
+}
+
+// admit returns ErrCircuitOpen while the breaker is open or a probe is in
+// flight, and whether the request is the probe of a half-open breaker.
+func (c *Client) admit() (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	switch {
+	case c.openUntil.IsZero():
+		return false, nil
+	case c.probing || c.now().Before(c.openUntil):
+		return false, ErrCircuitOpen
+	default:
+		c.probing = true
+		return true, nil
+	}
+}
+
+func (c *Client) fetch(ctx context.Context, path string, cached bool) ([]byte, error) {
+	if c.config.RequestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.config.RequestTimeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if cached {
+		c.mu.Lock()
+		if doc, ok := c.cache[path]; ok && doc.body != nil {
+			if doc.etag != "" {
+				req.Header.Set("If-None-Match", doc.etag)
+			}
+			if doc.lastModified != "" {
+				req.Header.Set("If-Modified-Since", doc.lastModified)
+			}
+		}
+		c.mu.Unlock()
+	}
+
+	response, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: error accessing hedgehog: %w", ErrUnavailable, err)
+	}
+	defer response.Body.Close()
+
+	if cached && response.StatusCode == http.StatusNotModified {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return c.cache[path].body, nil
+	}
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return nil, &StatusError{Code: response.StatusCode, Status: response.Status}
+	}
+
+	// chunked responses report a ContentLength of -1, only the body tells
+	// whether there is a document
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: error reading hedgehog response: %w", ErrUnavailable, err)
+	}
+	if len(body) == 0 {
+		body = nil
+	}
+
+	if cached {
+		c.mu.Lock()
+		c.cache[path] = cachedDocument{
+			body:         body,
+			etag:         response.Header.Get("ETag"),
+			lastModified: response.Header.Get("Last-Modified"),
+		}
+		c.mu.Unlock()
+	}
+
+	return body, nil
+}
+
+func (c *Client) recordSuccess() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.failures = 0
+	c.openUntil = time.Time{}
+	c.probing = false
+}
+
+// recordFailure counts a failed request, a failed probe reopens the breaker
+// right away.
+func (c *Client) recordFailure(probe bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if probe {
+		c.openUntil = c.now().Add(c.config.OpenDuration)
+		c.probing = false
+		return
+	}
+	c.failures++
+	if c.config.FailureThreshold > 0 && c.failures >= c.config.FailureThreshold {
+		c.openUntil = c.now().Add(c.config.OpenDuration)
+		c.failures = 0
+	}
+}
@@ -0,0 +1,416 @@
+package hedgehog
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	hedgehogtest "github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/hedgehog"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+const snapshot = `{"timestamp":"2024-01-01T00:00:00Z","data":{"vestingAddresses":{}}}`
+
+func testConfig() Config {
+	return Config{
+		RequestTimeout:   time.Second,
+		MaxRetries:       2,
+		InitialBackoff:   time.Millisecond,
+		MaxBackoff:       2 * time.Millisecond,
+		FailureThreshold: 2,
+		OpenDuration:     time.Minute,
+	}
+}
+
+func TestFetchChunked(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// flushing before writing the body forces a chunked response
+		w.(http.Flusher).Flush()
+		_, _ = w.Write([]byte(snapshot))
+	}))
+	defer server.Close()
+
+	body, err := NewClientWithConfig(server.URL, testConfig()).FetchVestingStorage(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, snapshot, string(body))
+}
+
+func TestFetchEmpty(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	body, err := NewClientWithConfig(server.URL, testConfig()).FetchVestingStorage(context.Background())
+	require.NoError(t, err)
+	require.Nil(t, body)
+}
+
+func TestFetchNotModified(t *testing.T) {
+	var downloads atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` && r.Header.Get("If-Modified-Since") != "" {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		downloads.Add(1)
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Last-Modified", "Mon, 01 Jan 2024 00:00:00 GMT")
+		_, _ = w.Write([]byte(snapshot))
+	}))
+	defer server.Close()
+
+	client := NewClientWithConfig(server.URL, testConfig())
+	for i := 0; i < 3; i++ {
+		body, err := client.FetchVestingStorage(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, snapshot, string(body))
+	}
+	require.EqualValues(t, 1, downloads.Load())
+}
+
+func TestFetchMintStorageCachedSeparately(t *testing.T) {
+	const mints = `{"timestamp":"2024-01-01T00:00:00Z","data":{"mints":{}}}`
+	var downloads atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		etag, body := `"vesting"`, snapshot
+		if r.URL.Path == "/mint" {
+			etag, body = `"mint"`, mints
+		}
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		downloads.Add(1)
+		w.Header().Set("ETag", etag)
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	config := testConfig()
+	config.Path = "/vesting"
+	config.MintPath = "/mint"
+	client := NewClientWithConfig(server.URL, config)
+	for i := 0; i < 3; i++ {
+		body, err := client.FetchVestingStorage(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, snapshot, string(body))
+
+		body, err = client.FetchMintStorage(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, mints, string(body))
+	}
+	require.EqualValues(t, 2, downloads.Load())
+}
+
+func TestFetchRetries(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(snapshot))
+	}))
+	defer server.Close()
+
+	body, err := NewClientWithConfig(server.URL, testConfig()).FetchVestingStorage(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, snapshot, string(body))
+	require.EqualValues(t, 3, calls.Load())
+}
+
+func TestFetchDoesNotRetryClientErrors(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	_, err := NewClientWithConfig(server.URL, testConfig()).FetchVestingStorage(context.Background())
+	var statusErr *StatusError
+	require.ErrorAs(t, err, &statusErr)
+	require.Equal(t, http.StatusNotFound, statusErr.Code)
+	require.ErrorIs(t, err, ErrNotFound)
+	require.EqualValues(t, 1, calls.Load())
+}
+
+func TestFetchTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer server.Close()
+
+	config := testConfig()
+	config.RequestTimeout = 10 * time.Millisecond
+	config.MaxRetries = 0
+
+	start := time.Now()
+	_, err := NewClientWithConfig(server.URL, config).FetchVestingStorage(context.Background())
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), 500*time.Millisecond)
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	var calls atomic.Int32
+	failing := atomic.Bool{}
+	failing.Store(true)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if failing.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte(snapshot))
+	}))
+	defer server.Close()
+
+	config := testConfig()
+	config.MaxRetries = 0
+	client := NewClientWithConfig(server.URL, config)
+	now := time.Now()
+	client.now = func() time.Time { return now }
+
+	for i := 0; i < config.FailureThreshold; i++ {
+		_, err := client.FetchVestingStorage(context.Background())
+		require.Error(t, err)
+		require.NotErrorIs(t, err, ErrCircuitOpen)
+	}
+
+	_, err := client.FetchVestingStorage(context.Background())
+	require.ErrorIs(t, err, ErrCircuitOpen)
+	require.ErrorIs(t, err, ErrUnavailable)
+	require.EqualValues(t, config.FailureThreshold, calls.Load())
+
+	// once open for OpenDuration a failed probe reopens the breaker
+	now = now.Add(config.OpenDuration)
+	_, err = client.FetchVestingStorage(context.Background())
+	require.NotErrorIs(t, err, ErrCircuitOpen)
+	_, err = client.FetchVestingStorage(context.Background())
+	require.ErrorIs(t, err, ErrCircuitOpen)
+
+	now = now.Add(config.OpenDuration)
+	failing.Store(false)
+	body, err := client.FetchVestingStorage(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, snapshot, string(body))
+}
+
+func TestCircuitBreakerSingleProbe(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		<-release
+		_, _ = w.Write([]byte(snapshot))
+	}))
+	defer server.Close()
+
+	config := testConfig()
+	config.MaxRetries = 0
+	config.FailureThreshold = 1
+	client := NewClientWithConfig(server.URL, config)
+	var mu sync.Mutex
+	now := time.Now()
+	client.now = func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now
+	}
+
+	_, err := client.FetchVestingStorage(context.Background())
+	require.NotErrorIs(t, err, ErrCircuitOpen)
+	mu.Lock()
+	now = now.Add(config.OpenDuration)
+	mu.Unlock()
+
+	// the half-open breaker lets a single probe through
+	probed := make(chan error, 1)
+	go func() {
+		_, err := client.FetchVestingStorage(context.Background())
+		probed <- err
+	}()
+	require.Eventually(t, func() bool { return calls.Load() == 2 }, time.Second, time.Millisecond)
+	for i := 0; i < 3; i++ {
+		_, err = client.FetchVestingStorage(context.Background())
+		require.ErrorIs(t, err, ErrCircuitOpen)
+	}
+	require.EqualValues(t, 2, calls.Load())
+
+	// its success closes the breaker
+	close(release)
+	require.NoError(t, <-probed)
+	body, err := client.FetchVestingStorage(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, snapshot, string(body))
+}
+
+func TestPoller(t *testing.T) {
+	var fail atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail.Load() {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(snapshot))
+	}))
+	defer server.Close()
+
+	poller, err := NewPoller(NewClientWithConfig(server.URL, testConfig()), time.Millisecond)
+	require.NoError(t, err)
+	body, err := poller.Latest()
+	require.NoError(t, err)
+	require.Nil(t, body)
+
+	poller.Start()
+	defer poller.Stop()
+
+	require.Eventually(t, func() bool {
+		body, err := poller.Latest()
+		return err == nil && string(body) == snapshot
+	}, time.Second, time.Millisecond)
+
+	// the last document stays available while hedgehog fails
+	fail.Store(true)
+	time.Sleep(10 * time.Millisecond)
+	body, err = poller.Latest()
+	require.NoError(t, err)
+	require.Equal(t, snapshot, string(body))
+}
+
+func TestVestingByAddress(t *testing.T) {
+	responses := map[string]func(w http.ResponseWriter){
+		"/vesting/found": func(w http.ResponseWriter) {
+			_, _ = w.Write([]byte(`{"amount":"1.5","start":"2024-01-01T00:00:00Z","duration":"PT1H","parts":4}`))
+		},
+		"/vesting/empty":      func(w http.ResponseWriter) {},
+		"/vesting/missing":    func(w http.ResponseWriter) { w.WriteHeader(http.StatusNotFound) },
+		"/vesting/forbidden":  func(w http.ResponseWriter) { w.WriteHeader(http.StatusForbidden) },
+		"/vesting/invalid":    func(w http.ResponseWriter) { _, _ = w.Write([]byte("not json")) },
+		"/vesting/overloaded": func(w http.ResponseWriter) { w.WriteHeader(http.StatusServiceUnavailable) },
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		responses[r.URL.Path](w)
+	}))
+	defer server.Close()
+
+	config := testConfig()
+	config.Path = "/vesting"
+	config.FailureThreshold = 0
+	client := NewClientWithConfig(server.URL, config)
+
+	vesting, err := client.VestingByAddress(context.Background(), "found")
+	require.NoError(t, err)
+	require.Equal(t, types.NewDecimalAmount("1.5"), vesting.Amount)
+	require.Equal(t, 4, vesting.Parts)
+
+	tests := []struct {
+		address string
+		err     error
+	}{
+		{address: "empty", err: ErrNotFound},
+		{address: "missing", err: ErrNotFound},
+		{address: "forbidden", err: ErrUnauthorized},
+		{address: "invalid", err: ErrBadPayload},
+		{address: "overloaded", err: ErrUnavailable},
+	}
+	for _, tc := range tests {
+		t.Run(tc.address, func(t *testing.T) {
+			_, err := client.VestingByAddress(context.Background(), tc.address)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+
+	_, err = NewClientWithConfig("http://127.0.0.1:0", config).VestingByAddress(context.Background(), "found")
+	require.ErrorIs(t, err, ErrUnavailable)
+}
+
+func TestNotFoundKeepsCircuitClosed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewClientWithConfig(server.URL, testConfig())
+	for i := 0; i < 2*testConfig().FailureThreshold; i++ {
+		_, err := client.VestingByAddress(context.Background(), "missing")
+		require.ErrorIs(t, err, ErrNotFound)
+		require.NotErrorIs(t, err, ErrCircuitOpen)
+	}
+}
+
+func TestInMintingList(t *testing.T) {
+	const address = "ugd1mint"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/mint/" + address:
+			_, _ = w.Write([]byte(`{"data":{"mints":{"Address(wif=` + address + `)":100}},"previousData":{"mints":{}}}`))
+		case "/mint/other":
+			_, _ = w.Write([]byte(`{"data":{"mints":{}}}`))
+		case "/mint/empty":
+		default:
+			_, _ = w.Write([]byte("not json"))
+		}
+	}))
+	defer server.Close()
+
+	config := testConfig()
+	config.MintPath = "/mint"
+	client := NewClientWithConfig(server.URL, config)
+
+	listed, err := client.InMintingList(context.Background(), address)
+	require.NoError(t, err)
+	require.True(t, listed)
+
+	for _, address := range []string{"other", "empty"} {
+		listed, err = client.InMintingList(context.Background(), address)
+		require.NoError(t, err)
+		require.False(t, listed)
+	}
+
+	_, err = client.InMintingList(context.Background(), "invalid")
+	require.ErrorIs(t, err, ErrBadPayload)
+}
+
+func TestPollerKeepsSnapshotOnError(t *testing.T) {
+	api := hedgehogtest.NewMockAPI(gomock.NewController(t))
+	first := api.EXPECT().FetchVestingStorage(gomock.Any()).Return([]byte(snapshot), nil)
+	api.EXPECT().FetchVestingStorage(gomock.Any()).Return(nil, ErrCircuitOpen).After(first).AnyTimes()
+
+	poller, err := NewPoller(api, time.Millisecond)
+	require.NoError(t, err)
+	poller.Start()
+	defer poller.Stop()
+
+	require.Eventually(t, func() bool {
+		body, _ := poller.Latest()
+		return string(body) == snapshot
+	}, time.Second, time.Millisecond)
+
+	time.Sleep(10 * time.Millisecond)
+	body, err := poller.Latest()
+	require.NoError(t, err)
+	require.Equal(t, snapshot, string(body))
+}
+
+func TestPollerRejectsNonPositiveInterval(t *testing.T) {
+	api := hedgehogtest.NewMockAPI(gomock.NewController(t))
+	for _, interval := range []time.Duration{0, -time.Second} {
+		_, err := NewPoller(api, interval)
+		require.ErrorContains(t, err, "poll interval must be positive")
+		_, err = NewMintPoller(api, interval)
+		require.ErrorContains(t, err, "poll interval must be positive")
+	}
+}
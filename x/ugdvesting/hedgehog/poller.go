@@ -0,0 +1,112 @@
+package hedgehog
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Poller fetches a hedgehog document on a background goroutine, so callers on
+// the block production path never wait for hedgehog.
+type Poller struct {
+	fetch    func(context.Context) ([]byte, error)
+	interval time.Duration
+
+	mu       sync.Mutex
+	snapshot []byte
+	err      error
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	cancel    context.CancelFunc
+	done      chan struct{}
+}
+
+// NewPoller returns a poller fetching the vesting-storage document from
+// client every interval once started. The interval must be positive.
+func NewPoller(client API, interval time.Duration) (*Poller, error) {
+	return newPoller(client.FetchVestingStorage, interval)
+}
+
+// NewMintPoller returns a poller fetching the mint-storage document from
+// client every interval once started. The interval must be positive.
+func NewMintPoller(client API, interval time.Duration) (*Poller, error) {
+	return newPoller(client.FetchMintStorage, interval)
+}
+
+func newPoller(fetch func(context.Context) ([]byte, error), interval time.Duration) (*Poller, error) {
+	if err := ValidatePollInterval(interval); err != nil {
+		return nil, err
+	}
+	return &Poller{
+		fetch:    fetch,
+		interval: interval,
+		done:     make(chan struct{}),
+	}, nil
+}
+
+// ValidatePollInterval returns an error when interval is not positive.
+func ValidatePollInterval(interval time.Duration) error {
+	if interval <= 0 {
+		return fmt.Errorf("poll interval must be positive, got %s", interval)
+	}
+	return nil
+}
+
+// Start launches the background goroutine, later calls have no effect.
+func (p *Poller) Start() {
+	p.startOnce.Do(func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		p.cancel = cancel
+		go p.run(ctx)
+	})
+}
+
+// Stop ends the background goroutine and waits for it to return.
+func (p *Poller) Stop() {
+	p.stopOnce.Do(func() {
+		if p.cancel == nil {
+			return
+		}
+		p.cancel()
+		<-p.done
+	})
+}
+
+// Latest returns the last document fetched. Once a document was fetched it
+// stays available while hedgehog fails; the error of the last attempt is only
+// returned as long as no document was fetched.
+func (p *Poller) Latest() ([]byte, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.snapshot != nil {
+		return bytes.Clone(p.snapshot), nil
+	}
+	return nil, p.err
+}
+
+func (p *Poller) run(ctx context.Context) {
+	defer close(p.done)
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		snapshot, err := p.fetch(ctx)
+
+		p.mu.Lock()
+		if err == nil {
+			p.snapshot = snapshot
+		}
+		p.err = err
+		p.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"bytes"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+// AmendVestingData applies the terms of req to the pending record of its
+// address and appends the change to the amendment history of the address.
+// When req carries an expected hash, the record must not have changed since
+// the terms were read. Like an ingested record, an amended record whose block
+// has passed is scheduled for the next block. The signer must have been
+// authorized.
+func (k *Keeper) AmendVestingData(ctx sdk.Context, req *types.MsgAmendPendingVesting) (types.VestingAmendment, error) {
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return types.VestingAmendment{}, errorsmod.Wrapf(types.ErrInvalidVestingData, "invalid address %q: %s", req.Address, err)
+	}
+
+	previous, found := k.GetVestingData(ctx, addr)
+	if !found {
+		return types.VestingAmendment{}, errorsmod.Wrapf(types.ErrNotPending, "no vesting record for %s", req.Address)
+	}
+	if previous.Processed {
+		return types.VestingAmendment{}, errorsmod.Wrapf(types.ErrNotPending, "vesting of %s was already processed", req.Address)
+	}
+	if len(req.ExpectedHash) > 0 && !bytes.Equal(req.ExpectedHash, previous.Hash()) {
+		return types.VestingAmendment{}, errorsmod.Wrapf(types.ErrRecordChanged, "record of %s has hash %X", req.Address, previous.Hash())
+	}
+
+	amended := req.Amend(previous)
+	if err := amended.Validate(); err != nil {
+		return types.VestingAmendment{}, err
+	}
+	if err := k.GetParams(ctx).ValidateScheduleLimits(amended); err != nil {
+		return types.VestingAmendment{}, err
+	}
+	requestedBlock := amended.Block
+	if currentHeight := ctx.BlockHeight(); amended.Block < currentHeight {
+		amended.Block = currentHeight + 1
+	}
+
+	version, err := k.lastAmendmentVersion(ctx, addr)
+	if err != nil {
+		return types.VestingAmendment{}, err
+	}
+	amendment := types.VestingAmendment{
+		Address:  amended.Address,
+		Version:  version + 1,
+		Signer:   req.Signer,
+		Height:   ctx.BlockHeight(),
+		Previous: previous,
+		Amended:  amended,
+		Reason:   req.Reason,
+	}
+
+	if err := k.SetVestingData(ctx, amended); err != nil {
+		return types.VestingAmendment{}, err
+	}
+	if err := k.Amendments.Set(ctx, collections.Join(addr, amendment.Version), amendment); err != nil {
+		return types.VestingAmendment{}, err
+	}
+
+	k.emitEvent(ctx, &types.EventVestingAmended{Amendment: amendment})
+	if amended.Block != previous.Block {
+		k.emitEvent(ctx, &types.EventVestingScheduled{
+			Address:        amended.Address,
+			Block:          amended.Block,
+			RequestedBlock: requestedBlock,
+		})
+	}
+	return amendment, nil
+}
+
+// lastAmendmentVersion returns the version of the last amendment of addr,
+// zero when it was never amended.
+func (k *Keeper) lastAmendmentVersion(ctx sdk.Context, addr sdk.AccAddress) (uint64, error) {
+	iter, err := k.Amendments.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, uint64](addr).Descending())
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, nil
+	}
+	key, err := iter.Key()
+	if err != nil {
+		return 0, err
+	}
+	return key.K2(), nil
+}
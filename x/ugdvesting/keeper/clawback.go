@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+// Clawback sends the coins still unvested at the block time that an account
+// the module converted holds to the destination. Without delegated unvested
+// coins the schedule ends and the account becomes a BaseAccount keeping its
+// vested coins.
+//
+// Unvested coins the account delegated, as x/auth/vesting tracks them, are not
+// clawed back: they are not unbonded early and stay on the schedule, which
+// keeps them locked until they vest. The clawed back coins are taken from its
+// last periods. A record can be clawed back again once such coins are
+// undelegated. It returns the clawed back coins and the unvested coins left
+// delegated.
+func (k *Keeper) Clawback(ctx sdk.Context, addr sdk.AccAddress, destination types.ClawbackDestination) (sdk.Coins, sdk.Coins, error) {
+	data, found := k.GetVestingData(ctx, addr)
+	if !found {
+		return nil, nil, errorsmod.Wrapf(types.ErrClawbackFailed, "no vesting record for %s", addr)
+	}
+	switch status := data.Status(); status {
+	case types.VestingStatus_VESTING_STATUS_CONVERTED, types.VestingStatus_VESTING_STATUS_CLAWED_BACK:
+	default:
+		return nil, nil, errorsmod.Wrapf(types.ErrClawbackFailed, "vesting record of %s is %s", addr, status)
+	}
+
+	var recipient sdk.AccAddress
+	switch destination {
+	case types.ClawbackDestination_CLAWBACK_DESTINATION_COMMUNITY_POOL:
+		if k.distrKeeper == nil {
+			return nil, nil, errorsmod.Wrap(types.ErrClawbackFailed, "distribution keeper is not set")
+		}
+	case types.ClawbackDestination_CLAWBACK_DESTINATION_FUNDER:
+		if data.Funder == "" {
+			return nil, nil, errorsmod.Wrapf(types.ErrClawbackFailed, "no funder recorded for %s", addr)
+		}
+		recipient = sdk.MustAccAddressFromBech32(data.Funder)
+	default:
+		return nil, nil, errorsmod.Wrapf(types.ErrClawbackFailed, "unknown destination %s", destination)
+	}
+
+	acc, ok := k.GetAccount(ctx, addr).(*vestingtypes.PeriodicVestingAccount)
+	if !ok {
+		return nil, nil, errorsmod.Wrapf(types.ErrClawbackFailed, "%s is not a periodic vesting account", addr)
+	}
+
+	unvested := acc.GetVestingCoins(ctx.BlockTime())
+	if unvested.IsZero() {
+		return nil, nil, errorsmod.Wrapf(types.ErrClawbackFailed, "all coins of %s already vested", addr)
+	}
+
+	// delegations count as vesting first, the unvested coins that are not
+	// delegated are held by the account
+	delegated := unvested.Sub(subFloor(unvested, acc.DelegatedVesting)...)
+	amount := unvested.Sub(delegated...)
+	if amount.IsZero() {
+		return nil, nil, errorsmod.Wrapf(types.ErrClawbackFailed, "the unvested coins of %s are delegated", addr)
+	}
+
+	// the schedule ends or shrinks first, the unvested coins are locked until
+	// then
+	if delegated.IsZero() {
+		k.SetAccount(ctx, acc.BaseAccount)
+	} else {
+		reduced, err := reduceSchedule(acc, amount)
+		if err != nil {
+			return nil, nil, err
+		}
+		k.SetAccount(ctx, reduced)
+	}
+
+	var err error
+	if recipient == nil {
+		err = k.distrKeeper.FundCommunityPool(ctx, amount, addr)
+	} else {
+		err = k.bankKeeper.SendCoins(ctx, addr, recipient, amount)
+	}
+	if err != nil {
+		return nil, nil, errorsmod.Wrapf(types.ErrClawbackFailed, "cannot send %s: %s", amount, err)
+	}
+
+	data.ClawedBack = true
+	if err := k.SetVestingData(ctx, data); err != nil {
+		return nil, nil, err
+	}
+
+	k.emitEvent(ctx, &types.EventVestingClawedBack{
+		Address:     addr.String(),
+		Destination: destination,
+		Recipient:   recipient.String(),
+		Amount:      amount,
+		Delegated:   delegated,
+	})
+	k.Logger().Info("clawed back vesting", "address", addr, "destination", destination, "amount", amount)
+
+	return amount, delegated, nil
+}
+
+// reduceSchedule returns acc without amount of its unvested coins, taken from
+// its last periods. Periods left empty at the end are dropped.
+func reduceSchedule(acc *vestingtypes.PeriodicVestingAccount, amount sdk.Coins) (*vestingtypes.PeriodicVestingAccount, error) {
+	periods := make(vestingtypes.Periods, len(acc.VestingPeriods))
+	copy(periods, acc.VestingPeriods)
+
+	remaining := amount
+	for i := len(periods) - 1; i >= 0 && !remaining.IsZero(); i-- {
+		taken := periods[i].Amount.Min(remaining)
+		periods[i].Amount = periods[i].Amount.Sub(taken...)
+		remaining = remaining.Sub(taken...)
+	}
+	for len(periods) > 0 && periods[len(periods)-1].Amount.IsZero() {
+		periods = periods[:len(periods)-1]
+	}
+
+	reduced, err := vestingtypes.NewPeriodicVestingAccount(acc.BaseAccount, acc.OriginalVesting.Sub(amount...), acc.StartTime, periods)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrClawbackFailed, "cannot reduce the schedule: %s", err)
+	}
+	reduced.DelegatedVesting = acc.DelegatedVesting
+	reduced.DelegatedFree = acc.DelegatedFree
+	return reduced, nil
+}
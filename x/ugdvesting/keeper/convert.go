@@ -0,0 +1,177 @@
+package keeper
+
+import (
+	stdmath "math"
+	"slices"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+// convertAccount returns the PeriodicVestingAccount following the hedgehog
+// schedule of data for account.
+//
+// Exactly the amount of data is vested, the rest of the balance stays
+// spendable. The amount must be covered by the balance and the delegations of
+// a BaseAccount. An existing delayed or continuous vesting account keeps the
+// coins its schedule already released free, so the amount must be covered by
+// its other coins, and it must cover the coins still vesting under the
+// previous schedule, which would otherwise become spendable. In both cases
+// delegations are split again between delegated vesting and delegated free
+// coins under the new schedule.
+func (k *Keeper) convertAccount(ctx sdk.Context, data types.VestingData, account sdk.AccountI) (*vestingtypes.PeriodicVestingAccount, error) {
+	switch account.(type) {
+	case *authtypes.BaseAccount, *vestingtypes.DelayedVestingAccount, *vestingtypes.ContinuousVestingAccount:
+	case *vestingtypes.PeriodicVestingAccount:
+		return nil, errorsmod.Wrap(types.ErrConversionFailed, "account already is a periodic vesting account")
+	default:
+		return nil, errorsmod.Wrapf(types.ErrConversionFailed, "unsupported account type %T", account)
+	}
+
+	params := k.GetParams(ctx)
+	denoms := params.VestingDenoms()
+	for _, coin := range data.AdditionalAmounts {
+		if !slices.Contains(denoms[1:], coin.Denom) {
+			return nil, errorsmod.Wrapf(types.ErrConversionFailed, "denom %s is not an additional vesting denom", coin.Denom)
+		}
+	}
+
+	vested := data.VestedCoins(denoms[0])
+	if vested.IsZero() {
+		return nil, errorsmod.Wrap(types.ErrConversionFailed, "no amount to vest")
+	}
+
+	if vestingAcc, ok := account.(vestingexported.VestingAccount); ok {
+		if unvested := vestingAcc.GetVestingCoins(ctx.BlockTime()); !vested.IsAllGTE(unvested) {
+			return nil, errorsmod.Wrapf(types.ErrConversionFailed, "%s still vest under the current schedule, more than the %s to vest", unvested, vested)
+		}
+	}
+
+	available, delegated, err := k.availableCoins(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	if !available.IsAllGTE(vested) {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientBalance, "%s available, %s to vest", available, vested)
+	}
+
+	periods, startTime, err := types.BuildPeriods(data, vested, time.Unix(data.Start, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	var pubKeyAny *codectypes.Any
+	if account.GetPubKey() != nil {
+		pubKeyAny, err = codectypes.NewAnyWithValue(account.GetPubKey())
+		if err != nil {
+			return nil, errorsmod.Wrapf(types.ErrConversionFailed, "cannot pack public key: %s", err)
+		}
+	}
+
+	baseAccount := &authtypes.BaseAccount{
+		Address:       account.GetAddress().String(),
+		PubKey:        pubKeyAny,
+		AccountNumber: account.GetAccountNumber(),
+		Sequence:      account.GetSequence(),
+	}
+
+	vestingAcc, err := vestingtypes.NewPeriodicVestingAccount(baseAccount, vested, startTime.Unix(), periods)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrConversionFailed, "cannot create periodic vesting account: %s", err)
+	}
+
+	// delegations count as vesting first, as x/auth/vesting tracks them
+	if !delegated.IsZero() {
+		vestingCoins := vestingAcc.GetVestingCoins(ctx.BlockTime())
+		vestingAcc.DelegatedVesting = delegated.Min(vestingCoins)
+		vestingAcc.DelegatedFree = delegated.Sub(vestingAcc.DelegatedVesting...)
+	}
+
+	return vestingAcc, nil
+}
+
+// availableCoins returns the coins of account that can be vested by a new
+// schedule and the coins it delegated. A BaseAccount can vest its balance and
+// its delegations. A vesting account keeps the coins its schedule already
+// released free, as far as it still holds them.
+func (k *Keeper) availableCoins(ctx sdk.Context, account sdk.AccountI) (sdk.Coins, sdk.Coins, error) {
+	balances := k.GetAllBalances(ctx, account.GetAddress())
+
+	vestingAcc, ok := account.(vestingexported.VestingAccount)
+	if !ok {
+		delegated, err := k.delegatedCoins(ctx, account.GetAddress())
+		if err != nil {
+			return nil, nil, errorsmod.Wrapf(types.ErrConversionFailed, "cannot read delegations: %s", err)
+		}
+		return balances.Add(delegated...), delegated, nil
+	}
+
+	delegated := vestingAcc.GetDelegatedVesting().Add(vestingAcc.GetDelegatedFree()...)
+	held := balances.Add(delegated...)
+	// coins vested under the previous schedule and still held stay free,
+	// unvested coins are always held as they cannot be spent
+	vestedHeld := subFloor(held, vestingAcc.GetVestingCoins(ctx.BlockTime())).Min(vestingAcc.GetVestedCoins(ctx.BlockTime()))
+	return subFloor(held, vestedHeld), delegated, nil
+}
+
+// delegatedCoins returns the coins addr has bonded or unbonding, as
+// x/auth/vesting tracks the delegations of vesting accounts. Without a staking
+// keeper nothing is delegated.
+func (k *Keeper) delegatedCoins(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coins, error) {
+	if k.stakingKeeper == nil {
+		return nil, nil
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	total := math.ZeroInt()
+	delegations, err := k.stakingKeeper.GetDelegatorDelegations(ctx, addr, stdmath.MaxUint16)
+	if err != nil {
+		return nil, err
+	}
+	for _, delegation := range delegations {
+		valAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if err != nil {
+			return nil, err
+		}
+		total = total.Add(validator.TokensFromShares(delegation.Shares).TruncateInt())
+	}
+
+	unbonding, err := k.stakingKeeper.GetUnbondingDelegations(ctx, addr, stdmath.MaxUint16)
+	if err != nil {
+		return nil, err
+	}
+	for _, ubd := range unbonding {
+		for _, entry := range ubd.Entries {
+			total = total.Add(entry.Balance)
+		}
+	}
+
+	return sdk.NewCoins(sdk.NewCoin(bondDenom, total)), nil
+}
+
+// subFloor subtracts b from a per denom, never going below zero.
+func subFloor(a, b sdk.Coins) sdk.Coins {
+	res := sdk.NewCoins()
+	for _, coin := range a {
+		if amount := coin.Amount.Sub(b.AmountOf(coin.Denom)); amount.IsPositive() {
+			res = res.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return res
+}
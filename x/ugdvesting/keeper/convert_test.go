@@ -0,0 +1,405 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/keeper"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/sample"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/keeper"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+func ugd(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin("uugd", amount))
+}
+
+func TestConvertAccounts(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	start := now.Add(24 * time.Hour).Unix()
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	base := authtypes.NewBaseAccountWithAddress(addr)
+
+	halfVested := func(delegatedVesting, delegatedFree int64) sdk.AccountI {
+		acc, err := vestingtypes.NewContinuousVestingAccount(base, ugd(1000), now.Add(-time.Hour).Unix(), now.Add(time.Hour).Unix())
+		require.NoError(t, err)
+		acc.DelegatedVesting = ugd(delegatedVesting)
+		acc.DelegatedFree = ugd(delegatedFree)
+		return acc
+	}
+
+	delayed, err := vestingtypes.NewDelayedVestingAccount(base, ugd(1000), now.Add(time.Hour).Unix())
+	require.NoError(t, err)
+	periodic, err := vestingtypes.NewPeriodicVestingAccount(base, ugd(1000), now.Unix(), vestingtypes.Periods{{Length: 10, Amount: ugd(1000)}})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		account          sdk.AccountI
+		balances         sdk.Coins
+		amount           int64
+		delegatedVesting sdk.Coins
+		delegatedFree    sdk.Coins
+		reason           string
+	}{
+		{
+			name:     "base account",
+			account:  base,
+			balances: ugd(1000),
+			amount:   1000,
+		},
+		{
+			name:     "surplus stays liquid",
+			account:  base,
+			balances: sdk.NewCoins(sdk.NewInt64Coin("uugd", 1500), sdk.NewInt64Coin("uother", 50)),
+			amount:   1000,
+		},
+		{
+			name:     "balance below amount",
+			account:  base,
+			balances: ugd(900),
+			amount:   1000,
+			reason:   "balance does not cover the vesting amount",
+		},
+		{
+			name:     "delayed vesting account",
+			account:  delayed,
+			balances: ugd(1200),
+			amount:   1000,
+		},
+		{
+			name:    "delayed vesting account with more coins still vesting",
+			account: delayed,
+			amount:  600,
+			reason:  "still vest under the current schedule",
+		},
+		{
+			name:             "continuous vesting account keeps vested coins free",
+			account:          halfVested(200, 0),
+			balances:         ugd(800),
+			amount:           500,
+			delegatedVesting: ugd(200),
+		},
+		{
+			name:             "continuous vesting account with delegations above the new schedule",
+			account:          halfVested(500, 200),
+			balances:         ugd(300),
+			amount:           500,
+			delegatedVesting: ugd(500),
+			delegatedFree:    ugd(200),
+		},
+		{
+			name:     "continuous vesting account that spent vested coins",
+			account:  halfVested(0, 0),
+			balances: ugd(700),
+			amount:   500,
+		},
+		{
+			name:     "amount would lock vested coins again",
+			account:  halfVested(0, 0),
+			balances: ugd(1000),
+			amount:   600,
+			reason:   "balance does not cover the vesting amount",
+		},
+		{
+			name:    "periodic vesting account",
+			account: periodic,
+			amount:  1000,
+			reason:  "already is a periodic vesting account",
+		},
+		{
+			name:    "module account",
+			account: authtypes.NewEmptyModuleAccount("pool"),
+			amount:  1000,
+			reason:  "unsupported account type",
+		},
+		{
+			name:    "zero amount",
+			account: base,
+			reason:  "no amount to vest",
+		},
+		{
+			name:   "missing account",
+			amount: 1000,
+			reason: "account not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx, ak, bk := keepertest.UgdvestingKeeperWithMocks(t)
+			ctx = ctx.WithBlockHeight(10).WithBlockTime(now).WithEventManager(sdk.NewEventManager())
+
+			data := types.VestingData{Address: addr.String(), Amount: math.NewInt(tc.amount), Start: start, Duration: 3600, Parts: 4, Block: 10}
+			require.NoError(t, k.SetVestingData(ctx, data))
+
+			ak.EXPECT().GetAccount(gomock.Any(), addr).Return(tc.account)
+			if tc.balances != nil {
+				bk.EXPECT().GetAllBalances(gomock.Any(), addr).Return(tc.balances)
+			}
+
+			var converted sdk.AccountI
+			if tc.reason == "" {
+				ak.EXPECT().SetAccount(gomock.Any(), gomock.Any()).Do(func(_ interface{}, acc sdk.AccountI) {
+					converted = acc
+				})
+			}
+
+			k.ProcessPendingVesting(ctx)
+
+			record, found := k.GetVestingData(ctx, addr)
+			require.True(t, found)
+			require.Equal(t, tc.reason == "", record.Processed)
+
+			if tc.reason != "" {
+				// the record stays pending and is retried later
+				require.Contains(t, record.FailureReason, tc.reason)
+				require.Equal(t, types.VestingStatus_VESTING_STATUS_FAILED, record.Status())
+				require.EqualValues(t, 10+types.DefaultRetryIntervalBlocks, record.Block)
+				require.Equal(t, []*types.EventVestingFailed{{Address: addr.String(), Reason: record.FailureReason, RetryBlock: record.Block}},
+					typedEvents[*types.EventVestingFailed](t, ctx))
+				return
+			}
+
+			require.Empty(t, record.FailureReason)
+			acc, ok := converted.(*vestingtypes.PeriodicVestingAccount)
+			require.True(t, ok)
+			require.Equal(t, ugd(tc.amount), acc.OriginalVesting)
+			require.Equal(t, start+3600, acc.StartTime)
+			require.True(t, tc.delegatedVesting.Equal(acc.DelegatedVesting), acc.DelegatedVesting)
+			require.True(t, tc.delegatedFree.Equal(acc.DelegatedFree), acc.DelegatedFree)
+			require.Equal(t, tc.account.GetAccountNumber(), acc.AccountNumber)
+			require.Equal(t, []*types.EventVestingConverted{{
+				Address:             addr.String(),
+				PreviousAccountType: proto.MessageName(tc.account),
+				OriginalVesting:     acc.OriginalVesting,
+				StartTime:           acc.StartTime,
+				Periods:             acc.VestingPeriods,
+			}}, typedEvents[*types.EventVestingConverted](t, ctx))
+		})
+	}
+}
+
+func TestRetryFailedConversion(t *testing.T) {
+	k, ctx, ak, bk := keepertest.UgdvestingKeeperWithMocks(t)
+	ms := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(10)
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	data := types.VestingData{Address: addr.String(), Amount: math.NewInt(1000), Duration: 3600, Parts: 4, Block: 10}
+	require.NoError(t, k.SetVestingData(ctx, data))
+
+	ak.EXPECT().GetAccount(gomock.Any(), addr).Return(authtypes.NewBaseAccountWithAddress(addr)).AnyTimes()
+	bk.EXPECT().GetAllBalances(gomock.Any(), addr).Return(ugd(500)).Times(2)
+	k.ProcessPendingVesting(ctx)
+
+	// the record stays pending until the retry interval passed
+	record, _ := k.GetVestingData(ctx, addr)
+	require.False(t, record.Processed)
+	require.Contains(t, record.FailureReason, "balance does not cover the vesting amount")
+	require.EqualValues(t, 10+types.DefaultRetryIntervalBlocks, record.Block)
+	k.ProcessPendingVesting(ctx.WithBlockHeight(50))
+
+	// a temporary failure is attempted again
+	retryHeight := 10 + int64(types.DefaultRetryIntervalBlocks)
+	k.ProcessPendingVesting(ctx.WithBlockHeight(retryHeight))
+	record, _ = k.GetVestingData(ctx, addr)
+	require.False(t, record.Processed)
+	require.EqualValues(t, retryHeight+types.DefaultRetryIntervalBlocks, record.Block)
+
+	// an amendment replaces the failed record
+	ctx = ctx.WithBlockHeight(retryHeight + 10)
+	_, err := k.AmendVestingData(ctx, &types.MsgAmendPendingVesting{
+		Signer: k.GetAuthority(), Address: addr.String(), Amount: math.NewInt(800), Duration: 3600, Parts: 4, Block: retryHeight + 20,
+	})
+	require.NoError(t, err)
+	record, _ = k.GetVestingData(ctx, addr)
+	require.Empty(t, record.FailureReason)
+	require.Equal(t, types.VestingStatus_VESTING_STATUS_PENDING, record.Status())
+
+	// and so does a governance schedule
+	_, err = ms.CreateVestingSchedule(ctx, &types.MsgCreateVestingSchedule{
+		Authority: k.GetAuthority(),
+		Schedule:  types.VestingData{Address: addr.String(), Amount: math.NewInt(500), Duration: 3600, Parts: 4, Block: retryHeight + 30},
+	})
+	require.NoError(t, err)
+
+	bk.EXPECT().GetAllBalances(gomock.Any(), addr).Return(ugd(500))
+	ak.EXPECT().SetAccount(gomock.Any(), gomock.Any())
+	k.ProcessPendingVesting(ctx.WithBlockHeight(retryHeight + 30))
+	record, _ = k.GetVestingData(ctx, addr)
+	require.True(t, record.Processed)
+	require.Empty(t, record.FailureReason)
+	require.Equal(t, types.VestingStatus_VESTING_STATUS_CONVERTED, record.Status())
+}
+
+func TestConvertDelegatedBaseAccount(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	valAddr := sdk.ValAddress(sdk.MustAccAddressFromBech32(sample.AccAddress()))
+
+	tests := []struct {
+		name   string
+		amount int64
+		reason string
+	}{
+		{name: "delegations cover the amount", amount: 1000},
+		{name: "delegations below the amount", amount: 1100, reason: "balance does not cover the vesting amount"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx, ak, bk, _, sk := keepertest.UgdvestingKeeperWithStakingMocks(t)
+			ctx = ctx.WithBlockHeight(10).WithBlockTime(now)
+			require.NoError(t, k.SetVestingData(ctx, types.VestingData{
+				Address:  addr.String(),
+				Amount:   math.NewInt(tc.amount),
+				Start:    now.Add(time.Hour).Unix(),
+				Duration: 3600,
+				Parts:    4,
+				Block:    10,
+			}))
+
+			// 300 liquid, 600 bonded and 100 unbonding
+			ak.EXPECT().GetAccount(gomock.Any(), addr).Return(authtypes.NewBaseAccountWithAddress(addr))
+			bk.EXPECT().GetAllBalances(gomock.Any(), addr).Return(ugd(300))
+			sk.EXPECT().BondDenom(gomock.Any()).Return("uugd", nil)
+			sk.EXPECT().GetDelegatorDelegations(gomock.Any(), addr, gomock.Any()).Return([]stakingtypes.Delegation{
+				{DelegatorAddress: addr.String(), ValidatorAddress: valAddr.String(), Shares: math.LegacyNewDec(1200)},
+			}, nil)
+			// one share is worth half a token
+			sk.EXPECT().GetValidator(gomock.Any(), valAddr).Return(stakingtypes.Validator{
+				OperatorAddress: valAddr.String(), Status: stakingtypes.Bonded,
+				Tokens: math.NewInt(1000), DelegatorShares: math.LegacyNewDec(2000),
+			}, nil)
+			sk.EXPECT().GetUnbondingDelegations(gomock.Any(), addr, gomock.Any()).Return([]stakingtypes.UnbondingDelegation{
+				{DelegatorAddress: addr.String(), ValidatorAddress: valAddr.String(), Entries: []stakingtypes.UnbondingDelegationEntry{
+					{InitialBalance: math.NewInt(100), Balance: math.NewInt(100)},
+				}},
+			}, nil)
+
+			var converted sdk.AccountI
+			if tc.reason == "" {
+				ak.EXPECT().SetAccount(gomock.Any(), gomock.Any()).Do(func(_ interface{}, acc sdk.AccountI) {
+					converted = acc
+				})
+			}
+
+			k.ProcessPendingVesting(ctx)
+
+			record, _ := k.GetVestingData(ctx, addr)
+			if tc.reason != "" {
+				require.Contains(t, record.FailureReason, tc.reason)
+				return
+			}
+			require.Empty(t, record.FailureReason)
+
+			// the delegations are tracked as delegated vesting coins
+			acc := converted.(*vestingtypes.PeriodicVestingAccount)
+			require.Equal(t, ugd(1000), acc.OriginalVesting)
+			require.Equal(t, ugd(700), acc.DelegatedVesting)
+			require.True(t, acc.DelegatedFree.IsZero())
+		})
+	}
+}
+
+func TestConvertAccountDenoms(t *testing.T) {
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	tests := []struct {
+		name       string
+		denom      string
+		additional []string
+		amounts    sdk.Coins
+		balances   sdk.Coins
+		original   sdk.Coins
+		reason     string
+	}{
+		{
+			name:     "configured denom",
+			denom:    "utest",
+			balances: sdk.NewCoins(sdk.NewInt64Coin("utest", 1200), sdk.NewInt64Coin("uother", 50)),
+			original: sdk.NewCoins(sdk.NewInt64Coin("utest", 1000)),
+		},
+		{
+			name:       "additional denoms",
+			denom:      "utest",
+			additional: []string{"uatom"},
+			amounts:    sdk.NewCoins(sdk.NewInt64Coin("uatom", 403)),
+			balances:   sdk.NewCoins(sdk.NewInt64Coin("utest", 1000), sdk.NewInt64Coin("uatom", 500), sdk.NewInt64Coin("uother", 5)),
+			original:   sdk.NewCoins(sdk.NewInt64Coin("utest", 1000), sdk.NewInt64Coin("uatom", 403)),
+		},
+		{
+			name:     "denom not allowed",
+			denom:    "utest",
+			amounts:  sdk.NewCoins(sdk.NewInt64Coin("uatom", 403)),
+			balances: sdk.NewCoins(sdk.NewInt64Coin("utest", 1000), sdk.NewInt64Coin("uatom", 500)),
+			reason:   "not an additional vesting denom",
+		},
+		{
+			name:       "additional balance below amount",
+			denom:      "utest",
+			additional: []string{"uatom"},
+			amounts:    sdk.NewCoins(sdk.NewInt64Coin("uatom", 403)),
+			balances:   sdk.NewCoins(sdk.NewInt64Coin("utest", 1000), sdk.NewInt64Coin("uatom", 400)),
+			reason:     "balance does not cover the vesting amount",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx, ak, bk := keepertest.UgdvestingKeeperWithMocks(t)
+			ctx = ctx.WithBlockHeight(10)
+
+			params := types.DefaultParams()
+			params.Denom = tc.denom
+			params.AdditionalDenoms = tc.additional
+			require.NoError(t, k.SetParams(ctx, params))
+			require.NoError(t, k.SetVestingData(ctx, types.VestingData{
+				Address:           addr.String(),
+				Amount:            math.NewInt(1000),
+				AdditionalAmounts: tc.amounts,
+				Duration:          3600,
+				Parts:             4,
+				Percent:           10,
+				Block:             10,
+			}))
+
+			var converted sdk.AccountI
+			ak.EXPECT().GetAccount(gomock.Any(), addr).Return(authtypes.NewBaseAccountWithAddress(addr))
+			bk.EXPECT().GetAllBalances(gomock.Any(), addr).Return(tc.balances).MaxTimes(1)
+			if tc.reason == "" {
+				ak.EXPECT().SetAccount(gomock.Any(), gomock.Any()).Do(func(_ interface{}, acc sdk.AccountI) {
+					converted = acc
+				})
+			}
+
+			k.ProcessPendingVesting(ctx)
+
+			record, _ := k.GetVestingData(ctx, addr)
+			if tc.reason != "" {
+				require.Contains(t, record.FailureReason, tc.reason)
+				return
+			}
+			require.Empty(t, record.FailureReason)
+
+			acc := converted.(*vestingtypes.PeriodicVestingAccount)
+			require.Equal(t, tc.original, acc.OriginalVesting)
+			require.Len(t, acc.VestingPeriods, 5)
+			total := sdk.NewCoins()
+			for _, period := range acc.VestingPeriods {
+				require.Equal(t, len(tc.original), len(period.Amount))
+				total = total.Add(period.Amount...)
+			}
+			require.Equal(t, tc.original, total)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+// DisplayCoins returns coins in the display units declared by the bank
+// metadata of their denoms. Coins of a denom without usable metadata keep
+// their base unit.
+func (k Keeper) DisplayCoins(ctx context.Context, coins sdk.Coins) []types.DisplayCoin {
+	res := make([]types.DisplayCoin, 0, len(coins))
+	for _, coin := range coins {
+		display := types.DisplayCoin{Denom: coin.Denom, Amount: coin.Amount.String()}
+		if k.bankKeeper != nil {
+			if metadata, found := k.bankKeeper.GetDenomMetaData(ctx, coin.Denom); found {
+				converted, err := types.DisplayAmount(coin.Amount, metadata)
+				if err != nil {
+					k.Logger().Error("invalid denom metadata", "denom", coin.Denom, "err", err)
+				} else {
+					display = converted
+				}
+			}
+		}
+		res = append(res, display)
+	}
+	return res
+}
+
+// recordDisplayAmount returns the coins a vesting record vests in display
+// units.
+func (k Keeper) recordDisplayAmount(ctx context.Context, data types.VestingData) []types.DisplayCoin {
+	return k.DisplayCoins(ctx, data.VestedCoins(k.GetParams(ctx).VestingDenoms()[0]))
+}
@@ -0,0 +1,17 @@
+package keeper
+
+import "cosmossdk.io/core/store"
+
+// AgreedSnapshot exports agreedSnapshot for tests.
+var AgreedSnapshot = agreedSnapshot
+
+// AgreedMintSnapshot exports agreedMintSnapshot for tests.
+var AgreedMintSnapshot = agreedMintSnapshot
+
+// SnapshotVote exports snapshotVote for tests.
+var SnapshotVote = snapshotVote
+
+// StoreService exports the store service of the keeper for tests.
+func (k Keeper) StoreService() store.KVStoreService {
+	return k.storeService
+}
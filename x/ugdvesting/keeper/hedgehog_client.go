@@ -1,444 +1,214 @@
 package keeper
 
 import (
-	"encoding/json"
-	"fmt"
-	"io"
-	"os"
-	"strconv"
-	"strings"
-	"time"
+	"context"
 
-	"cosmossdk.io/log"
-	"cosmossdk.io/math"
-	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
-	durationLib "github.com/sosodev/duration"
-	"github.com/spf13/viper"
-	"github.com/unigrid-project/cosmos-common/common/httpclient"
-	//ugdtypes "github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
-)
-
-type VestingData struct {
-	Address   string `json:"address"`
-	Amount    int64  `json:"amount"`
-	Start     string `json:"start"`
-	Duration  string `json:"duration"`
-	Parts     int    `json:"parts"`
-	Block     int64  `json:"block"`
-	Percent   int    `json:"percent"`
-	Cliff     int    `json:"cliff"`
-	Processed bool
-}
+	"github.com/cosmos/gogoproto/proto"
 
-// InMemoryVestingData holds vesting data in memory.
-type InMemoryVestingData struct {
-	VestingAccounts map[string]VestingData
-}
-
-type HedgehogData struct {
-	Timestamp         string `json:"timestamp"`
-	PreviousTimeStamp string `json:"previousTimeStamp"`
-	Flags             int    `json:"flags"`
-	Hedgehogtype      string `json:"type"`
-	Data              struct {
-		VestingAddresses map[string]VestingData `json:"vestingAddresses"`
-	} `json:"data"`
-	Signature string `json:"signature"`
-}
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
 
+// ProcessPendingVesting activates every pending record whose block is at or
+// below the current height, in block and address order. Only the records
+// queued up to the current height are read. Records whose block has passed
+// without being activated, because the module was not active yet or the chain
+// halted, are caught up and reported with an EventVestingCaughtUp.
 func (k *Keeper) ProcessPendingVesting(ctx sdk.Context) {
-	k.mu.Lock()
-	defer k.mu.Unlock()
-
 	currentHeight := ctx.BlockHeight()
 
-	for address, data := range k.InMemoryVestingData.VestingAccounts {
-		if data.Block == currentHeight && !data.Processed {
-			addr, err := sdk.AccAddressFromBech32(address)
-			if err != nil {
-				fmt.Println("Error parsing address:", err)
-				continue
-			}
-
-			account := k.GetAccount(ctx, addr)
-			if account == nil {
-				fmt.Println("Account not found:", addr)
-				continue
-			}
-
-			// Convert to PeriodicVestingAccount if it's not already one
-			if _, ok := account.(*vestingtypes.PeriodicVestingAccount); !ok {
-				if baseAcc, ok := account.(*vestingtypes.DelayedVestingAccount); ok {
-					currentBalances := k.GetAllBalances(ctx, addr)
-					if currentBalances.IsZero() {
-						fmt.Println("No balances found for address:", addr)
-						continue
-					}
-
-					startTime, err := time.Parse(time.RFC3339, data.Start)
-					if err != nil {
-						fmt.Println("Error parsing start time:", err)
-						continue
-					}
-
-					startTimeUnix := startTime.Unix()
-
-					// Calculate TGE amount based on data.Amount
-					tgeAmount := sdk.Coins{}
-					if data.Percent == 0 {
-						fmt.Println("TGE percent is zero, adjusting start time")
-						vestingDuration, err := parseISO8601Duration(data.Duration)
-						if err != nil {
-							fmt.Println("Error parsing vesting duration:", err)
-							continue
-						}
-						startTimeUnix += int64(vestingDuration)
-					} else {
-						for _, coin := range currentBalances {
-							if coin.Denom == "uugd" {
-								amount := coin.Amount.Mul(math.NewInt(int64(data.Percent))).Quo(math.NewInt(100))
-								tgeAmount = append(tgeAmount, sdk.NewCoin(coin.Denom, amount))
-							}
-						}
-					}
-
-					// Calculate remaining amount after TGE based on data.Amount
-					remainingAmount := sdk.Coins{}
-					for _, coin := range currentBalances {
-						if coin.Denom == "uugd" {
-							remaining := coin.Amount.Sub(tgeAmount.AmountOf(coin.Denom))
-							remainingAmount = append(remainingAmount, sdk.NewCoin(coin.Denom, remaining))
-						}
-					}
-
-					// Create vesting periods
-					periods := vestingtypes.Periods{}
-					vestingDuration, err := parseISO8601Duration(data.Duration)
-					if err != nil {
-						fmt.Println("Error parsing vesting duration:", err)
-						continue
-					}
-					goDurationStr := strconv.FormatInt(vestingDuration, 10) + "s"
-					periodTime, _ := time.ParseDuration(goDurationStr)
-
-					// Add TGE period
-					if data.Percent > 0 {
-						periods = append(periods, vestingtypes.Period{
-							Length: int64(periodTime.Seconds()),
-							Amount: tgeAmount,
-						})
-					}
-
-					if data.Parts == 0 {
-						fmt.Println("Parts cannot be zero")
-						continue
-					}
-
-					// Calculate the amount per part
-					amountPerPart := sdk.Coins{}
-					for _, coin := range remainingAmount {
-						amountPerPart = append(amountPerPart, sdk.NewCoin(coin.Denom, coin.Amount.Quo(math.NewInt(int64(data.Parts)))))
-					}
-
-					// If cliff is zero, add all parts directly
-					if data.Cliff == 0 {
-						totalDistributed := sdk.NewCoins()
-						for i := 0; i < int(data.Parts); i++ {
-							periods = append(periods, vestingtypes.Period{
-								Length: int64(periodTime.Seconds()),
-								Amount: amountPerPart,
-							})
-							totalDistributed = totalDistributed.Add(amountPerPart...)
-						}
-
-						// Calculate the remaining difference to match the original balance
-						difference := sdk.NewCoins()
-						for _, coin := range remainingAmount {
-							totalDistributedCoin := totalDistributed.AmountOf(coin.Denom)
-							remaining := coin.Amount.Sub(totalDistributedCoin)
-							difference = difference.Add(sdk.NewCoin(coin.Denom, remaining))
-						}
-
-						// Add the remaining difference to the final vesting period
-						if len(periods) > 0 {
-							periods[len(periods)-1].Amount = periods[len(periods)-1].Amount.Add(difference...)
-						} else {
-							periods = append(periods, vestingtypes.Period{
-								Length: int64(periodTime.Seconds()),
-								Amount: difference,
-							})
-						}
-					} else {
-						// Calculate the amount per cliff period if cliff is not zero
-						rampUpAmountPerCliffPeriod := sdk.Coins{}
-						for _, coin := range amountPerPart {
-							rampUpAmountPerCliffPeriod = append(rampUpAmountPerCliffPeriod, sdk.NewCoin(coin.Denom, coin.Amount.Quo(math.NewInt(int64(data.Cliff)))))
-						}
-
-						// Add cliff periods
-						for i := 0; i < int(data.Cliff); i++ {
-							periods = append(periods, vestingtypes.Period{
-								Length: int64(periodTime.Seconds()),
-								Amount: rampUpAmountPerCliffPeriod,
-							})
-						}
-
-						// Subtract one part from remainingAmount to account for cliff periods
-						for i, coin := range remainingAmount {
-							remainingAmount[i].Amount = coin.Amount.Sub(amountPerPart.AmountOf(coin.Denom))
-						}
-
-						// Add remaining vesting periods
-						totalDistributed := sdk.NewCoins()
-						for i := 0; i < int(data.Parts-1); i++ {
-							periods = append(periods, vestingtypes.Period{
-								Length: int64(periodTime.Seconds()),
-								Amount: amountPerPart,
-							})
-							totalDistributed = totalDistributed.Add(amountPerPart...)
-						}
-
-						// Calculate the remaining difference to match the original balance
-						difference := sdk.NewCoins()
-						for _, coin := range remainingAmount {
-							totalDistributedCoin := totalDistributed.AmountOf(coin.Denom)
-							remaining := coin.Amount.Sub(totalDistributedCoin)
-							difference = difference.Add(sdk.NewCoin(coin.Denom, remaining))
-						}
-
-						// Add the remaining difference to the final vesting period
-						if len(periods) > 0 {
-							periods[len(periods)-1].Amount = periods[len(periods)-1].Amount.Add(difference...)
-						} else {
-							periods = append(periods, vestingtypes.Period{
-								Length: int64(periodTime.Seconds()),
-								Amount: difference,
-							})
-						}
-					}
-
-					// Adjust the final period to handle any remaining small discrepancies
-					totalAmount := sdk.NewCoins()
-					for _, period := range periods {
-						totalAmount = totalAmount.Add(period.Amount...)
-					}
-
-					finalPeriodIndex := len(periods) - 1
-					for _, coin := range currentBalances {
-						if coin.Denom == "uugd" {
-							remaining := coin.Amount.Sub(totalAmount.AmountOf(coin.Denom))
-							if !remaining.IsZero() {
-								periods[finalPeriodIndex].Amount = periods[finalPeriodIndex].Amount.Add(sdk.NewCoin(coin.Denom, remaining))
-							}
-						}
-					}
-
-					// Log intermediate values for debugging
-					fmt.Println("TGE Amount:", tgeAmount)
-					fmt.Println("Remaining Amount after TGE:", remainingAmount)
-					fmt.Println("Amount Per Part:", amountPerPart)
-
-					// Calculate the sum of all periods
-					totalAmount = sdk.NewCoins()
-					for _, period := range periods {
-						totalAmount = totalAmount.Add(period.Amount...)
-					}
-
-					// Compare the sum with currentBalances
-					if !coinsEqual(totalAmount, currentBalances) {
-						fmt.Printf("Mismatch! Original: %s, Calculated: %s\n", currentBalances, totalAmount)
-						continue
-					} else {
-						fmt.Printf("Match! Original: %s, Calculated: %s\n", currentBalances, totalAmount)
-					}
-
-					var pubKeyAny *codectypes.Any
-					if baseAcc.GetPubKey() != nil {
-						pubKeyAny, err = codectypes.NewAnyWithValue(baseAcc.GetPubKey())
-						if err != nil {
-							fmt.Println("Error packing public key into Any:", err)
-							continue
-						}
-					}
-
-					baseAccount := &authtypes.BaseAccount{
-						Address:       baseAcc.GetAddress().String(),
-						PubKey:        pubKeyAny,
-						AccountNumber: baseAcc.GetAccountNumber(),
-						Sequence:      baseAcc.GetSequence(),
-					}
-
-					vestingAcc, err := vestingtypes.NewPeriodicVestingAccount(baseAccount, currentBalances, startTimeUnix, periods)
-					if err != nil {
-						logger := log.NewLogger(os.Stderr)
-						logger.Error("Error creating new periodic vesting account", "err", err)
-						continue
-					}
-
-					k.SetAccount(ctx, vestingAcc)
-					data.Processed = true
-					k.InMemoryVestingData.VestingAccounts[address] = data
-					fmt.Println("Processed vesting data for address:", address)
-				}
-			}
+	// collect the due records first, the store must not be written while it
+	// is being iterated
+	var due []types.VestingData
+	err := k.PendingQueue.Walk(ctx, collections.NewPrefixUntilPairRange[int64, sdk.AccAddress](currentHeight), func(key collections.Pair[int64, sdk.AccAddress]) (bool, error) {
+		data, err := k.VestingData.Get(ctx, key.K2())
+		if err != nil {
+			return true, err
 		}
+		due = append(due, data)
+		return false, nil
+	})
+	if err != nil {
+		k.Logger().Error("failed to read vesting data", "err", err)
+		return
 	}
-}
 
-// coinsEqual compares two sets of sdk.Coins for equality
-func coinsEqual(a, b sdk.Coins) bool {
-	if len(a) != len(b) {
-		return false
-	}
-	for i := range a {
-		if !a[i].IsEqual(b[i]) {
-			return false
+	for _, data := range due {
+		if data.Block < currentHeight {
+			k.emitEvent(ctx, &types.EventVestingCaughtUp{
+				Address: data.Address,
+				Block:   data.Block,
+				Height:  currentHeight,
+			})
 		}
+		if err := k.processVesting(ctx, data); err != nil {
+			k.failVesting(ctx, data, err)
+			continue
+		}
+		k.Logger().Info("converted vesting account", "address", data.Address)
 	}
-	return true
 }
 
-func (k *Keeper) ProcessVestingAccounts(ctx sdk.Context) {
-	k.mu.Lock()
-	defer func() {
-		//fmt.Println("Unlocking k.mu")
-		k.mu.Unlock()
-	}()
-
-	base := viper.GetString("hedgehog.hedgehog_url")
-	hedgehogUrl := base + "/gridspork/vesting-storage"
-
-	response, err := httpclient.Client.Get(hedgehogUrl)
+// processVesting converts the account of a due record and marks the record
+// processed. Both writes are committed together or not at all.
+func (k *Keeper) processVesting(ctx sdk.Context, data types.VestingData) error {
+	addr, err := sdk.AccAddressFromBech32(data.Address)
 	if err != nil {
-		if err == io.EOF {
-			fmt.Println("Received empty response from hedgehog server.")
-		} else {
-			fmt.Println("Error accessing hedgehog:", err.Error())
-		}
-		return
+		return errorsmod.Wrapf(types.ErrConversionFailed, "invalid address: %s", err)
 	}
-	defer response.Body.Close()
 
-	if response.ContentLength == 0 {
-		fmt.Println("Received empty response from hedgehog server.")
-		return
+	account := k.GetAccount(ctx, addr)
+	if account == nil {
+		return errorsmod.Wrap(types.ErrConversionFailed, "account not found")
 	}
 
-	var res HedgehogData
-	body, err1 := io.ReadAll(response.Body)
-	if err1 != nil {
-		fmt.Println(err1.Error())
-		return
+	vestingAcc, err := k.convertAccount(ctx, data, account)
+	if err != nil {
+		return err
 	}
 
-	e := json.Unmarshal(body, &res)
-	if e != nil {
-		fmt.Println(e.Error())
-		return
+	// the account is only converted together with its processed record
+	cacheCtx, write := ctx.CacheContext()
+	k.SetAccount(cacheCtx, vestingAcc)
+	data.Processed = true
+	data.FailureReason = ""
+	if err := k.SetVestingData(cacheCtx, data); err != nil {
+		return err
 	}
+	write()
+
+	k.emitEvent(ctx, &types.EventVestingConverted{
+		Address:             data.Address,
+		PreviousAccountType: proto.MessageName(account),
+		OriginalVesting:     vestingAcc.OriginalVesting,
+		StartTime:           vestingAcc.StartTime,
+		Periods:             vestingAcc.VestingPeriods,
+	})
+	return nil
+}
 
-	// fmt.Println("Received vesting data from Hedgehog:")
-	// fmt.Printf("Timestamp: %s\n", res.Timestamp)
-	// fmt.Printf("PreviousTimeStamp: %s\n", res.PreviousTimeStamp)
-	// fmt.Printf("Flags: %d\n", res.Flags)
-	// fmt.Printf("Hedgehogtype: %s\n", res.Hedgehogtype)
-	for key, vesting := range res.Data.VestingAddresses {
-		address := strings.TrimPrefix(key, "Address(wif=")
-		address = strings.TrimSuffix(address, ")")
-		addr, err := ConvertStringToAcc(address)
-		if err != nil {
-			fmt.Println("Error converting address:", err)
-			continue
-		}
-
-		if k.HasProcessedAddress(ctx, addr) {
-			fmt.Printf("Address already processed: %s\n", addr)
-			continue
-		}
+// failVesting records why the account of a due record could not be
+// converted. The record stays pending and is attempted again after the retry
+// interval of the params, unless an amendment, a governance schedule or a
+// snapshot replaces it first.
+func (k *Keeper) failVesting(ctx sdk.Context, data types.VestingData, cause error) {
+	k.Logger().Error("failed to convert vesting account", "address", data.Address, "err", cause)
+
+	data.FailureReason = cause.Error()
+	data.Block = ctx.BlockHeight() + k.GetParams(ctx).RetryInterval()
+	if err := k.SetVestingData(ctx, data); err != nil {
+		k.Logger().Error("failed to store vesting failure", "address", data.Address, "err", err)
+	}
 
-		// Store the parsed data in memory
-		vestingData := VestingData{
-			Address:   key,
-			Amount:    vesting.Amount,
-			Start:     vesting.Start,
-			Duration:  vesting.Duration,
-			Parts:     vesting.Parts,
-			Block:     vesting.Block,
-			Percent:   vesting.Percent,
-			Cliff:     vesting.Cliff,
-			Processed: false,
-		}
+	k.emitEvent(ctx, &types.EventVestingFailed{
+		Address:    data.Address,
+		Reason:     data.FailureReason,
+		RetryBlock: data.Block,
+	})
+}
 
-		k.SetVestingDataInMemory(address, vestingData)
-		//fmt.Printf("In-memory vesting data set for address: %s\n", address)
+// FetchVestingSnapshot returns the current vesting-storage document of the
+// keeper's VestingSource at the configured endpoint path, nil when it has
+// nothing to serve. The result depends on the node it runs on and must never
+// be applied to state directly, see ApplyVestingSnapshot.
+func (k *Keeper) FetchVestingSnapshot(ctx context.Context) ([]byte, error) {
+	if k.source == nil {
+		return nil, nil
 	}
+	return k.source.FetchVestingSnapshot(ctx, k.GetParams(ctx).EndpointPath)
+}
+
+// ApplyVestingSnapshot stores the entries of a vesting-storage document that
+// validators agreed on and that was committed in the current block. Nothing is
+// stored unless the snapshot carries enough valid hedgehog signatures and
+// chains to the last accepted snapshot.
+func (k *Keeper) ApplyVestingSnapshot(ctx sdk.Context, snapshot []byte) error {
+	_, err := k.applyVestingSnapshot(ctx, snapshot)
+	return err
 }
 
-// parseISO8601Duration parses an ISO 8601 duration string and returns the duration in seconds.
-func parseISO8601Duration(durationStr string) (int64, error) {
-	// Example implementation - you'll need a proper parser for ISO 8601 durations
-	duration, err := durationLib.Parse(durationStr)
+// applyVestingSnapshot is ApplyVestingSnapshot, returning the number of
+// entries stored.
+func (k *Keeper) applyVestingSnapshot(ctx sdk.Context, snapshot []byte) (uint32, error) {
+	res, err := types.ParseVestingSnapshot(snapshot)
 	if err != nil {
 		return 0, err
 	}
-	return int64(duration.ToTimeDuration().Seconds()), nil
-}
 
-func (k *Keeper) SetVestingDataInMemory(address string, data VestingData) {
-	// fmt.Printf("Setting VestingData in memory for address: %s\n", address)
-	// fmt.Printf("Data: %+v\n", data)
-	k.InMemoryVestingData.VestingAccounts[address] = data
-}
+	if err := k.acceptSnapshot(ctx, k.SnapshotState, types.SnapshotKindVesting, res.SnapshotHeader, snapshot); err != nil {
+		return 0, err
+	}
 
-func (k *Keeper) GetVestingDataInMemory(address string) (VestingData, bool) {
-	//fmt.Println("Locking k.mu")
-	k.mu.Lock()
-	defer func() {
-		//fmt.Println("Unlocking k.mu")
-		k.mu.Unlock()
-	}()
+	var stored uint32
+	params := k.GetParams(ctx)
+	for _, key := range res.Keys() {
+		addr, err := types.ParseHedgehogAddress(key)
+		if err != nil {
+			k.Logger().Error("invalid address in vesting snapshot", "address", key, "err", err)
+			continue
+		}
 
-	data, found := k.InMemoryVestingData.VestingAccounts[address]
-	return data, found
-}
+		vestingData, err := res.Data.VestingAddresses[key].ToVestingData(addr, params)
+		if err != nil {
+			k.Logger().Error("invalid vesting data in vesting snapshot", "address", addr, "err", err)
+			continue
+		}
 
-func (k *Keeper) LogInMemoryVestingData() {
-	fmt.Println("Logging InMemoryVestingData:")
-	for key, data := range k.InMemoryVestingData.VestingAccounts {
-		fmt.Printf("Key: %s, Value: %+v\n", key, data)
+		if ok, err := k.IngestVestingData(ctx, vestingData); err != nil {
+			k.Logger().Error("vesting data not stored", "address", addr, "err", err)
+		} else if ok {
+			stored++
+		}
 	}
+
+	return stored, nil
 }
 
-func (k *Keeper) HasProcessedAddress(ctx sdk.Context, address sdk.AccAddress) bool {
+// IngestVestingData stores a vesting record as pending, replacing the pending
+// record of the same address if any. Records of addresses that were already
+// processed are left untouched, in which case false is returned, and so are
+// pinned pending records, amended or created by governance, unless data is
+// pinned as well. Records
+// with more parts or a longer cliff than the params allow are rejected. A
+// record whose block has already passed is scheduled for the next block. Stored
+// records are reported with an EventVestingIngested and an
+// EventVestingScheduled.
+func (k *Keeper) IngestVestingData(ctx sdk.Context, data types.VestingData) (bool, error) {
+	data.Processed = false
+	data.FailureReason = ""
+	if err := data.Validate(); err != nil {
+		return false, err
+	}
+	if err := k.GetParams(ctx).ValidateScheduleLimits(data); err != nil {
+		return false, err
+	}
 
-	//k.LogInMemoryVestingData()
+	addr := sdk.MustAccAddressFromBech32(data.Address)
+	if existing, found := k.GetVestingData(ctx, addr); found && (existing.Processed || existing.Pinned && !data.Pinned) {
+		return false, nil
+	}
 
-	// if len(k.InMemoryVestingData.VestingAccounts) == 0 {
-	// 	fmt.Println("InMemoryVestingData is empty")
-	// } else {
-	// 	fmt.Println("InMemoryVestingData is not empty")
-	// }
-	data, found := k.InMemoryVestingData.VestingAccounts[address.String()]
-	//fmt.Println("After Checking if address has been processed:\n", address)
+	requestedBlock := data.Block
+	if currentHeight := ctx.BlockHeight(); data.Block < currentHeight {
+		data.Block = currentHeight + 1
+	}
 
-	return found && data.Processed
-}
+	if err := k.SetVestingData(ctx, data); err != nil {
+		return false, err
+	}
 
-func (k *Keeper) DeleteVestingDataInMemory(address string) {
-	//fmt.Println("Locking k.mu")
-	k.mu.Lock()
-	defer func() {
-		//fmt.Println("Unlocking k.mu")
-		k.mu.Unlock()
-	}()
-	delete(k.InMemoryVestingData.VestingAccounts, address)
+	k.emitEvent(ctx, &types.EventVestingIngested{Record: data})
+	k.emitEvent(ctx, &types.EventVestingScheduled{
+		Address:        data.Address,
+		Block:          data.Block,
+		RequestedBlock: requestedBlock,
+	})
+	return true, nil
 }
 
 func ConvertStringToAcc(address string) (sdk.AccAddress, error) {
-	//fmt.Println("Converting address:", address)
 	return sdk.AccAddressFromBech32(address)
 }
 
@@ -1,13 +1,17 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
-	"sync"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 
 	//paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
@@ -15,16 +19,48 @@ import (
 
 type (
 	Keeper struct {
-		cdc                 codec.BinaryCodec
-		storeService        store.KVStoreService
-		logger              log.Logger
-		authKeeper          types.AccountKeeper
-		bankKeeper          types.BankKeeper
-		mu                  sync.Mutex
-		InMemoryVestingData InMemoryVestingData
+		cdc          codec.BinaryCodec
+		storeService store.KVStoreService
+		logger       log.Logger
+		authKeeper   types.AccountKeeper
+		bankKeeper   types.BankKeeper
+		// the distribution keeper is only needed for clawbacks, the staking
+		// keeper to count the delegations of converted accounts
+		distrKeeper   types.DistributionKeeper
+		stakingKeeper types.StakingKeeper
+		source        types.VestingSource
+		// observed holds the snapshot documents the node voted on, for its
+		// proposals
+		observed *observedSnapshots
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
 		authority string
+
+		Schema collections.Schema
+		// VestingData holds the hedgehog vesting records, pending and processed,
+		// keyed by account address.
+		VestingData collections.Map[sdk.AccAddress, types.VestingData]
+		// PendingQueue holds the block and address of every pending vesting
+		// record, so only the due records are read in BeginBlock.
+		PendingQueue collections.KeySet[collections.Pair[int64, sdk.AccAddress]]
+		// SnapshotState is the last vesting snapshot accepted by the module.
+		SnapshotState collections.Item[types.SnapshotState]
+		// LastBlockTime is the time of the last block, in Unix nanoseconds,
+		// recorded while hedgehog is polled on a time interval.
+		LastBlockTime collections.Item[int64]
+		// Amendments holds the amendment history of the vesting records,
+		// keyed by account address and version.
+		Amendments collections.Map[collections.Pair[sdk.AccAddress, uint64], types.VestingAmendment]
+		// MintRecords holds the records of the executed hedgehog mints, keyed by
+		// mint key. A key is minted at most once.
+		MintRecords collections.Map[string, types.MintRecord]
+		// MintSnapshotState is the last mint snapshot accepted by the module.
+		MintSnapshotState collections.Item[types.SnapshotState]
+		// MintedTotal is the amount of the module denom minted so far.
+		MintedTotal collections.Item[math.Int]
+		// DeferredEvents holds the events of the PreBlocker until BeginBlock
+		// emits them.
+		DeferredEvents collections.Item[types.DeferredEvents]
 	}
 )
 
@@ -35,21 +71,46 @@ func NewKeeper(
 	authority string,
 	bk types.BankKeeper,
 	ak types.AccountKeeper,
-
+	dk types.DistributionKeeper,
+	sk types.StakingKeeper,
+	source types.VestingSource,
 ) Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address: %s", authority))
 	}
 
-	return Keeper{
-		cdc:                 cdc,
-		storeService:        storeService,
-		authority:           authority,
-		logger:              logger,
-		authKeeper:          ak,
-		bankKeeper:          bk,
-		InMemoryVestingData: InMemoryVestingData{VestingAccounts: make(map[string]VestingData)},
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:           cdc,
+		storeService:  storeService,
+		authority:     authority,
+		logger:        logger,
+		authKeeper:    ak,
+		bankKeeper:    bk,
+		distrKeeper:   dk,
+		stakingKeeper: sk,
+		source:        source,
+		observed:      newObservedSnapshots(),
+		VestingData:   collections.NewMap(sb, types.VestingDataKey, "vesting_data", sdk.AccAddressKey, codec.CollValue[types.VestingData](cdc)),
+		PendingQueue: collections.NewKeySet(sb, types.PendingQueueKey, "pending_queue",
+			collections.PairKeyCodec(collections.Int64Key, sdk.AccAddressKey)),
+		SnapshotState: collections.NewItem(sb, types.SnapshotStateKey, "snapshot_state", codec.CollValue[types.SnapshotState](cdc)),
+		LastBlockTime: collections.NewItem(sb, types.LastBlockTimeKey, "last_block_time", collections.Int64Value),
+		Amendments: collections.NewMap(sb, types.AmendmentsKey, "amendments",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key), codec.CollValue[types.VestingAmendment](cdc)),
+		MintRecords:       collections.NewMap(sb, types.MintsKey, "mints", collections.StringKey, codec.CollValue[types.MintRecord](cdc)),
+		MintSnapshotState: collections.NewItem(sb, types.MintSnapshotStateKey, "mint_snapshot_state", codec.CollValue[types.SnapshotState](cdc)),
+		MintedTotal:       collections.NewItem(sb, types.MintedTotalKey, "minted_total", sdk.IntValue),
+		DeferredEvents:    collections.NewItem(sb, types.DeferredEventsKey, "deferred_events", codec.CollValue[types.DeferredEvents](cdc)),
 	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
 }
 
 // GetAuthority returns the module's authority.
@@ -62,9 +123,47 @@ func (k *Keeper) Logger() log.Logger {
 	return k.logger.With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// emitEvent emits one of the module's typed events. They always encode, so a
+// failure is logged instead of failing the block.
+func (k *Keeper) emitEvent(ctx sdk.Context, event proto.Message) {
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		k.Logger().Error("failed to emit event", "event", proto.MessageName(event), "err", err)
+	}
+}
+
+// deferEvents stores events for EmitDeferredEvents. Baseapp drops the events
+// of the PreBlocker, so the events of the snapshots it applies are emitted
+// from BeginBlock instead.
+func (k *Keeper) deferEvents(ctx sdk.Context, events []abci.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	deferred, err := k.DeferredEvents.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	deferred.Events = append(deferred.Events, events...)
+	return k.DeferredEvents.Set(ctx, deferred)
+}
+
+// EmitDeferredEvents emits the events stored by the PreBlocker of the current
+// block and deletes them.
+func (k *Keeper) EmitDeferredEvents(ctx sdk.Context) error {
+	deferred, err := k.DeferredEvents.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, event := range deferred.Events {
+		ctx.EventManager().EmitEvent(sdk.Event(event))
+	}
+	return k.DeferredEvents.Remove(ctx)
+}
+
 func (k *Keeper) GetAccount(ctx sdk.Context, addr sdk.AccAddress) sdk.AccountI {
 	if k.authKeeper == nil {
-		fmt.Println("authKeeper is nil")
+		k.Logger().Error("account keeper is not set")
 		return nil
 	}
 	return k.authKeeper.GetAccount(ctx, addr)
@@ -76,7 +175,7 @@ func (k *Keeper) SetAccount(ctx sdk.Context, acc sdk.AccountI) {
 
 func (k *Keeper) GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
 	if k.bankKeeper == nil {
-		fmt.Println("bankKeeper is nil")
+		k.Logger().Error("bank keeper is not set")
 		return sdk.Coins{}
 	}
 	return k.bankKeeper.GetAllBalances(ctx, addr)
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2. Version 1 only
+// stored the params, vesting records were kept in memory, so the records,
+// snapshot states and mints of version 2 start empty. The params fields added
+// since keep their zero value, which falls back to the defaults where one
+// applies, ingestion and the lock of pending funds staying off until
+// governance turns them on.
+func (m Migrator) Migrate1to2(_ sdk.Context) error {
+	return nil
+}
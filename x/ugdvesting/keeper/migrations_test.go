@@ -0,0 +1,35 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	keepertest "github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/keeper"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/keeper"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	k, ctx := keepertest.UgdvestingKeeper(t)
+
+	// version 1 stored the coin power, precision and denom params only
+	bz := protowire.AppendTag(nil, 1, protowire.VarintType)
+	bz = protowire.AppendVarint(bz, 8)
+	bz = protowire.AppendTag(bz, 2, protowire.VarintType)
+	bz = protowire.AppendVarint(bz, 1e8)
+	bz = protowire.AppendTag(bz, 4, protowire.BytesType)
+	bz = protowire.AppendString(bz, "uugd")
+	require.NoError(t, k.StoreService().OpenKVStore(ctx).Set(types.ParamsKey, bz))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+
+	params := k.GetParams(ctx)
+	require.NoError(t, params.Validate())
+	require.EqualValues(t, 8, params.CoinPower)
+	require.Equal(t, "uugd", params.Denom)
+	require.False(t, params.Enabled)
+	require.False(t, params.LockPendingFunds)
+	require.EqualValues(t, types.DefaultMaxParts, params.PartsLimit())
+}
@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+// FetchMintSnapshot returns the current mint-storage document of the keeper's
+// VestingSource at the configured mint endpoint path, nil when the source
+// serves no mint snapshots. Like FetchVestingSnapshot, the result must never
+// be applied to state directly, see ApplyMintSnapshot.
+func (k *Keeper) FetchMintSnapshot(ctx context.Context) ([]byte, error) {
+	source, ok := k.source.(types.MintSource)
+	if !ok {
+		return nil, nil
+	}
+	return source.FetchMintSnapshot(ctx, k.GetParams(ctx).MintEndpointPath)
+}
+
+// GetMintedTotal returns the amount of the module denom minted so far.
+func (k *Keeper) GetMintedTotal(ctx context.Context) math.Int {
+	total, err := k.MintedTotal.Get(ctx)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			k.Logger().Error("failed to read minted total", "err", err)
+		}
+		return math.ZeroInt()
+	}
+	return total
+}
+
+// ApplyMintSnapshot executes the mints of a mint-storage document that
+// validators agreed on and that was committed in the current block. The
+// snapshot goes through the same checks as a vesting snapshot. Every mint key
+// is executed at most once, keys naming the same address and suffix counting
+// as one; mints that are invalid or exceed the caps of
+// Params are reported with an EventMintRejected and not recorded, so a later
+// snapshot listing them executes them once the caps allow.
+func (k *Keeper) ApplyMintSnapshot(ctx sdk.Context, snapshot []byte) error {
+	res, err := types.ParseMintSnapshot(snapshot)
+	if err != nil {
+		return err
+	}
+
+	// checked before accepting, so the snapshot is applied again once the
+	// app is fixed
+	moduleAcc := k.authKeeper.GetModuleAccount(ctx, types.ModuleName)
+	if moduleAcc == nil || !moduleAcc.HasPermission(authtypes.Minter) {
+		return errorsmod.Wrapf(types.ErrMintFailed, "module account %s has no %s permission", types.ModuleName, authtypes.Minter)
+	}
+
+	if err := k.acceptSnapshot(ctx, k.MintSnapshotState, types.SnapshotKindMint, res.SnapshotHeader, snapshot); err != nil {
+		return err
+	}
+
+	params := k.GetParams(ctx)
+	denom := params.VestingDenoms()[0]
+	total := k.GetMintedTotal(ctx)
+	block := math.ZeroInt()
+
+	for _, servedKey := range res.Keys() {
+		served := res.Data.Mints[servedKey]
+		key, address := servedKey, ""
+		reject := func(reason string, err error) {
+			k.Logger().Error("mint not executed", "key", key, "reason", reason, "err", err)
+			k.emitEvent(ctx, &types.EventMintRejected{
+				Key:     key,
+				Address: address,
+				Amount:  served.String(),
+				Reason:  reason,
+			})
+		}
+
+		addr, canonicalKey, err := types.ParseMintKey(servedKey)
+		if err != nil {
+			reject(types.MintRejectedInvalidAddress, err)
+			continue
+		}
+		key, address = canonicalKey, addr.String()
+
+		if has, err := k.MintRecords.Has(ctx, key); err != nil {
+			return err
+		} else if has {
+			continue
+		}
+
+		amount, err := served.BaseUnits(params)
+		if err == nil && !amount.IsPositive() {
+			err = errorsmod.Wrapf(types.ErrInvalidVestingData, "mint amount %s is not positive", amount)
+		}
+		if err != nil {
+			reject(types.MintRejectedInvalidAmount, err)
+			continue
+		}
+
+		switch {
+		case !types.WithinMintLimit(amount, params.MaxMintAmount):
+			reject(types.MintRejectedMintCap, errorsmod.Wrapf(types.ErrMintFailed, "%s exceeds the max mint amount %s", amount, params.MaxMintAmount))
+			continue
+		case !types.WithinMintLimit(block.Add(amount), params.MaxMintPerBlock):
+			reject(types.MintRejectedBlockCap, errorsmod.Wrapf(types.ErrMintFailed, "%s exceeds the max mint per block %s", amount, params.MaxMintPerBlock))
+			continue
+		case !types.WithinMintLimit(total.Add(amount), params.MaxTotalMinted):
+			reject(types.MintRejectedTotalCap, errorsmod.Wrapf(types.ErrMintFailed, "%s exceeds the max total minted %s", amount, params.MaxTotalMinted))
+			continue
+		}
+
+		record := types.MintRecord{
+			Key:               key,
+			Address:           address,
+			Amount:            sdk.NewCoin(denom, amount),
+			Height:            ctx.BlockHeight(),
+			SnapshotTimestamp: res.Timestamp,
+		}
+		if err := k.executeMint(ctx, addr, record); err != nil {
+			reject(types.MintRejectedFailed, err)
+			continue
+		}
+
+		block = block.Add(amount)
+		total = total.Add(amount)
+	}
+
+	return k.MintedTotal.Set(ctx, total)
+}
+
+// executeMint mints the coins of record to addr and records it. The mint and
+// transfer run in a cached context, so a failure leaves no partial mint
+// behind.
+func (k *Keeper) executeMint(ctx sdk.Context, addr sdk.AccAddress, record types.MintRecord) error {
+	cacheCtx, write := ctx.CacheContext()
+	coins := sdk.NewCoins(record.Amount)
+	if err := k.bankKeeper.MintCoins(cacheCtx, types.ModuleName, coins); err != nil {
+		return errorsmod.Wrap(types.ErrMintFailed, err.Error())
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, addr, coins); err != nil {
+		return errorsmod.Wrap(types.ErrMintFailed, err.Error())
+	}
+	if err := k.MintRecords.Set(cacheCtx, record.Key, record); err != nil {
+		return err
+	}
+	write()
+
+	k.emitEvent(ctx, &types.EventMintExecuted{Record: record})
+	return nil
+}
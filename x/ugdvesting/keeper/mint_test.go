@@ -0,0 +1,286 @@
+package keeper_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/keeper"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/sample"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/keeper"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/source"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+// mintSnapshot returns a signed mint-storage document listing mints, in base units
+// keyed by mint key.
+func mintSnapshot(timestamp, previous string, mints map[string]int64) []byte {
+	entries := make([]string, 0, len(mints))
+	for key, amount := range mints {
+		entries = append(entries, fmt.Sprintf(`"%s":%d`, key, amount))
+	}
+	return keepertest.SignSnapshot([]byte(fmt.Sprintf(`{"timestamp":"%s","previousTimeStamp":"%s","data":{"mints":{%s}}}`,
+		timestamp, previous, strings.Join(entries, ","))))
+}
+
+func mintKey(addr sdk.AccAddress, suffix string) string {
+	key := "Address(wif=" + addr.String() + ")"
+	if suffix != "" {
+		key += "/" + suffix
+	}
+	return key
+}
+
+// recordKey returns the canonical mint key the records are stored under.
+func recordKey(addr sdk.AccAddress, suffix string) string {
+	key := addr.String()
+	if suffix != "" {
+		key += "/" + suffix
+	}
+	return key
+}
+
+func expectMinter(ak *keepertest.MockAccountKeeper) {
+	ak.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).
+		Return(authtypes.NewEmptyModuleAccount(types.ModuleName, authtypes.Minter)).AnyTimes()
+}
+
+func expectMint(bk *keepertest.MockBankKeeper, addr sdk.AccAddress, amount int64) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(types.DefaultDenom, amount))
+	bk.EXPECT().MintCoins(gomock.Any(), types.ModuleName, coins).Return(nil)
+	bk.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, addr, coins).Return(nil)
+}
+
+func TestApplyMintSnapshot(t *testing.T) {
+	k, ctx, ak, bk := keepertest.UgdvestingKeeperWithMocks(t)
+	ctx = ctx.WithBlockHeight(10)
+	expectMinter(ak)
+
+	a := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	b := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	expectMint(bk, a, 100)
+	expectMint(bk, a, 200)
+	first := mintSnapshot("2024-01-01T00:00:00Z", "", map[string]int64{
+		mintKey(a, ""):   100,
+		mintKey(a, "2"):  200,
+		"not an address": 300,
+		mintKey(b, "0"):  0,
+	})
+	require.NoError(t, k.ApplyMintSnapshot(ctx, first))
+
+	record, err := k.MintRecords.Get(ctx, recordKey(a, "2"))
+	require.NoError(t, err)
+	require.Equal(t, types.MintRecord{
+		Key:               recordKey(a, "2"),
+		Address:           a.String(),
+		Amount:            sdk.NewInt64Coin(types.DefaultDenom, 200),
+		Height:            10,
+		SnapshotTimestamp: "2024-01-01T00:00:00Z",
+	}, record)
+	require.Equal(t, math.NewInt(300), k.GetMintedTotal(ctx))
+	require.Len(t, typedEvents[*types.EventMintExecuted](t, ctx), 2)
+
+	rejected := typedEvents[*types.EventMintRejected](t, ctx)
+	require.Len(t, rejected, 2)
+	reasons := map[string]string{}
+	for _, event := range rejected {
+		reasons[event.Key] = event.Reason
+	}
+	require.Equal(t, map[string]string{
+		"not an address":  types.MintRejectedInvalidAddress,
+		recordKey(b, "0"): types.MintRejectedInvalidAmount,
+	}, reasons)
+
+	accepted := typedEvents[*types.EventSnapshotAccepted](t, ctx)
+	require.Len(t, accepted, 1)
+	require.Equal(t, types.SnapshotKindMint, accepted[0].Kind)
+	require.True(t, k.IsLastMintSnapshot(ctx, first))
+	// the mint snapshot does not chain with the vesting snapshots
+	_, found := k.GetSnapshotState(ctx)
+	require.False(t, found)
+
+	// mints already executed are not executed again, however many snapshots
+	// list them and however they spell the address
+	expectMint(bk, b, 50)
+	next := mintSnapshot("2024-01-01T00:01:00Z", "2024-01-01T00:00:00Z", map[string]int64{
+		mintKey(a, ""):    100,
+		mintKey(a, "2"):   200,
+		a.String() + "/2": 200,
+		mintKey(b, ""):    50,
+		b.String():        50,
+	})
+	require.NoError(t, k.ApplyMintSnapshot(ctx, next))
+	require.Equal(t, math.NewInt(350), k.GetMintedTotal(ctx))
+
+	// a replayed snapshot is rejected as a whole
+	require.ErrorIs(t, k.ApplyMintSnapshot(ctx, next), types.ErrSnapshotRejected)
+	require.Error(t, k.ApplyMintSnapshot(ctx, []byte("not json")))
+}
+
+func TestApplyMintSnapshotCaps(t *testing.T) {
+	k, ctx, ak, bk := keepertest.UgdvestingKeeperWithMocks(t)
+	expectMinter(ak)
+
+	params := keepertest.Params()
+	params.MaxMintAmount = math.NewInt(100)
+	params.MaxMintPerBlock = math.NewInt(150)
+	params.MaxTotalMinted = math.NewInt(200)
+	require.NoError(t, k.SetParams(ctx, params))
+
+	a := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	// keys are walked in sorted order, the block cap stops the third mint
+	expectMint(bk, a, 80)
+	expectMint(bk, a, 70)
+	require.NoError(t, k.ApplyMintSnapshot(ctx, mintSnapshot("2024-01-01T00:00:00Z", "", map[string]int64{
+		mintKey(a, "1"): 80,
+		mintKey(a, "2"): 70,
+		mintKey(a, "3"): 10,
+		mintKey(a, "4"): 101,
+	})))
+
+	reasons := map[string]string{}
+	for _, event := range typedEvents[*types.EventMintRejected](t, ctx) {
+		reasons[event.Key] = event.Reason
+	}
+	require.Equal(t, map[string]string{
+		recordKey(a, "3"): types.MintRejectedBlockCap,
+		recordKey(a, "4"): types.MintRejectedMintCap,
+	}, reasons)
+
+	// rejected mints are executed from a later snapshot once the caps allow,
+	// up to the total cap
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	expectMint(bk, a, 10)
+	require.NoError(t, k.ApplyMintSnapshot(ctx, mintSnapshot("2024-01-01T00:01:00Z", "2024-01-01T00:00:00Z", map[string]int64{
+		mintKey(a, "3"): 10,
+		mintKey(a, "5"): 60,
+	})))
+	require.Equal(t, math.NewInt(160), k.GetMintedTotal(ctx))
+
+	rejected := typedEvents[*types.EventMintRejected](t, ctx)
+	require.Len(t, rejected, 1)
+	require.Equal(t, types.MintRejectedTotalCap, rejected[0].Reason)
+}
+
+func TestApplyMintSnapshotFailures(t *testing.T) {
+	k, ctx, ak, bk := keepertest.UgdvestingKeeperWithMocks(t)
+	a := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	snapshot := mintSnapshot("2024-01-01T00:00:00Z", "", map[string]int64{mintKey(a, ""): 100})
+
+	// without the minter permission nothing is accepted, so the snapshot is
+	// applied once the module account is fixed
+	ak.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(authtypes.NewEmptyModuleAccount(types.ModuleName))
+	require.ErrorIs(t, k.ApplyMintSnapshot(ctx, snapshot), types.ErrMintFailed)
+	_, found := k.GetMintSnapshotState(ctx)
+	require.False(t, found)
+
+	// a failing transfer leaves neither coins nor a record behind
+	expectMinter(ak)
+	bk.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil)
+	bk.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, a, gomock.Any()).Return(errors.New("blocked address"))
+	require.NoError(t, k.ApplyMintSnapshot(ctx, snapshot))
+
+	has, err := k.MintRecords.Has(ctx, recordKey(a, ""))
+	require.NoError(t, err)
+	require.False(t, has)
+	require.True(t, k.GetMintedTotal(ctx).IsZero())
+
+	rejected := typedEvents[*types.EventMintRejected](t, ctx)
+	require.Len(t, rejected, 1)
+	require.Equal(t, types.MintRejectedFailed, rejected[0].Reason)
+}
+
+func TestMintQueries(t *testing.T) {
+	k, ctx, ak, bk := keepertest.UgdvestingKeeperWithMocks(t)
+	expectMinter(ak)
+
+	a := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	b := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	expectMint(bk, a, 100)
+	expectMint(bk, a, 200)
+	expectMint(bk, b, 300)
+	require.NoError(t, k.ApplyMintSnapshot(ctx, mintSnapshot("2024-01-01T00:00:00Z", "", map[string]int64{
+		mintKey(a, "1"): 100,
+		mintKey(a, "2"): 200,
+		mintKey(b, ""):  300,
+	})))
+
+	res, err := k.Mint(ctx, &types.QueryMintRequest{Key: mintKey(b, "")})
+	require.NoError(t, err)
+	require.Equal(t, b.String(), res.Record.Address)
+	res, err = k.Mint(ctx, &types.QueryMintRequest{Key: recordKey(a, "2")})
+	require.NoError(t, err)
+	require.Equal(t, recordKey(a, "2"), res.Record.Key)
+
+	_, err = k.Mint(ctx, &types.QueryMintRequest{Key: mintKey(b, "2")})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	all, err := k.Mints(ctx, &types.QueryMintsRequest{})
+	require.NoError(t, err)
+	require.Len(t, all.Mints, 3)
+	require.Equal(t, math.NewInt(600), all.TotalMinted)
+
+	ofA, err := k.Mints(ctx, &types.QueryMintsRequest{Address: a.String(), Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, ofA.Mints, 1)
+	require.Equal(t, a.String(), ofA.Mints[0].Address)
+	require.EqualValues(t, 2, ofA.Pagination.Total)
+
+	_, err = k.Mints(ctx, &types.QueryMintsRequest{Address: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestExtendVoteMintSnapshot(t *testing.T) {
+	src := source.NewMemorySource(nil)
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	snapshot := mintSnapshot("2024-01-01T00:00:00Z", "", map[string]int64{mintKey(addr, ""): 100})
+	src.SetMint(snapshot)
+	k, ctx := keepertest.UgdvestingKeeperWithSource(t, src)
+	extend := k.ExtendVoteHandler()
+
+	extensionAt := func(height int64) types.VestingVoteExtension {
+		res, err := extend(ctx, &abci.RequestExtendVote{Height: height})
+		require.NoError(t, err)
+		var ext types.VestingVoteExtension
+		require.NoError(t, ext.Unmarshal(res.VoteExtension))
+		return ext
+	}
+
+	// mint snapshots are only voted on while minting is enabled
+	require.Nil(t, extensionAt(10).MintSnapshot)
+
+	params := keepertest.Params()
+	params.MintEnabled = true
+	params.MaxMintAmount = math.NewInt(1000)
+	params.MaxMintPerBlock = math.NewInt(1000)
+	params.MaxTotalMinted = math.NewInt(1000)
+	require.NoError(t, k.SetParams(ctx, params))
+	require.Equal(t, keeper.SnapshotVote(snapshot), extensionAt(10).MintSnapshot)
+	require.Nil(t, extensionAt(11).MintSnapshot)
+
+	votes := []abci.ExtendedVoteInfo{mintVote(t, 10, snapshot), mintVote(t, 10, snapshot), mintVote(t, 5, nil)}
+	require.Equal(t, keeper.SnapshotVote(snapshot), keeper.AgreedMintSnapshot(abci.ExtendedCommitInfo{Votes: votes}))
+	require.Nil(t, keeper.AgreedSnapshot(abci.ExtendedCommitInfo{Votes: votes}))
+}
+
+func mintVote(t *testing.T, power int64, snapshot []byte) abci.ExtendedVoteInfo {
+	vote := extendedVote(t, power, nil)
+	ext := types.VestingVoteExtension{MintSnapshot: snapshotVote(snapshot)}
+	bz, err := ext.Marshal()
+	require.NoError(t, err)
+	vote.VoteExtension = bz
+	return vote
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+func (k msgServer) AmendPendingVesting(goCtx context.Context, req *types.MsgAmendPendingVesting) (*types.MsgAmendPendingVestingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.Signer != k.GetAuthority() && !slices.Contains(k.GetParams(ctx).AmendmentOperators, req.Signer) {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorizedAmender, "%s", req.Signer)
+	}
+
+	amendment, err := k.AmendVestingData(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAmendPendingVestingResponse{Version: amendment.Version, Block: amendment.Amended.Block}, nil
+}
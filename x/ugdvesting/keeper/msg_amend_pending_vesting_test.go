@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/keeper"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/sample"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/keeper"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+func TestMsgAmendPendingVesting(t *testing.T) {
+	k, ctx := keepertest.UgdvestingKeeper(t)
+	ms := keeper.NewMsgServerImpl(k)
+	authority := k.GetAuthority()
+	operator := sample.AccAddress()
+	ctx = ctx.WithBlockHeight(5)
+
+	params := k.GetParams(ctx)
+	params.AmendmentOperators = []string{operator}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	addr := sample.AccAddress()
+	funder := sample.AccAddress()
+	record := types.VestingData{Address: addr, Amount: math.NewInt(1000), Start: 1700000000, Duration: 3600, Parts: 4, Block: 100, Funder: funder}
+	require.NoError(t, k.SetVestingData(ctx, record))
+
+	amend := func(signer string, modify func(*types.MsgAmendPendingVesting)) (*types.MsgAmendPendingVestingResponse, error) {
+		current, _ := k.GetVestingData(ctx, sdk.MustAccAddressFromBech32(addr))
+		msg := &types.MsgAmendPendingVesting{
+			Signer: signer, Address: addr,
+			Amount: current.Amount, Start: current.Start, Duration: current.Duration,
+			Parts: current.Parts, Percent: current.Percent, Cliff: current.Cliff, Block: current.Block,
+		}
+		modify(msg)
+		return ms.AmendPendingVesting(ctx, msg)
+	}
+
+	_, err := amend(sample.AccAddress(), func(m *types.MsgAmendPendingVesting) { m.Amount = math.NewInt(2000) })
+	require.ErrorIs(t, err, types.ErrUnauthorizedAmender)
+
+	res, err := amend(authority, func(m *types.MsgAmendPendingVesting) {
+		m.Amount = math.NewInt(2000)
+		m.Reason = "agreement extended"
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, res.Version)
+	require.EqualValues(t, 100, res.Block)
+
+	stored, _ := k.GetVestingData(ctx, sdk.MustAccAddressFromBech32(addr))
+	require.Equal(t, math.NewInt(2000), stored.Amount)
+	require.Equal(t, funder, stored.Funder)
+
+	// a block that passed moves to the next one
+	res, err = amend(operator, func(m *types.MsgAmendPendingVesting) {
+		m.Parts = 8
+		m.Block = 3
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 2, res.Version)
+	require.EqualValues(t, 6, res.Block)
+
+	_, err = amend(operator, func(m *types.MsgAmendPendingVesting) { m.Duration = 0 })
+	require.ErrorIs(t, err, types.ErrInvalidVestingData)
+
+	history, err := k.VestingAmendments(ctx, &types.QueryVestingAmendmentsRequest{Address: addr})
+	require.NoError(t, err)
+	require.Len(t, history.Amendments, 2)
+	first, second := history.Amendments[0], history.Amendments[1]
+	require.Equal(t, authority, first.Signer)
+	require.Equal(t, "agreement extended", first.Reason)
+	require.Equal(t, math.NewInt(1000), first.Previous.Amount)
+	require.Equal(t, math.NewInt(2000), first.Amended.Amount)
+	require.Equal(t, operator, second.Signer)
+	require.Equal(t, first.Amended, second.Previous)
+	require.EqualValues(t, 8, second.Amended.Parts)
+	require.EqualValues(t, 5, second.Height)
+
+	page, err := k.VestingAmendments(ctx, &types.QueryVestingAmendmentsRequest{Address: addr, Pagination: &query.PageRequest{Limit: 1}})
+	require.NoError(t, err)
+	require.Equal(t, []types.VestingAmendment{first}, page.Amendments)
+	require.NotNil(t, page.Pagination.NextKey)
+
+	events := typedEvents[*types.EventVestingAmended](t, ctx)
+	require.Len(t, events, 2)
+	require.EqualValues(t, 2, events[1].Amendment.Version)
+	require.Equal(t, second.Amended.Block, events[1].Amendment.Amended.Block)
+
+	// other records are not affected
+	history, err = k.VestingAmendments(ctx, &types.QueryVestingAmendmentsRequest{Address: sample.AccAddress()})
+	require.NoError(t, err)
+	require.Empty(t, history.Amendments)
+
+	// terms read before the second amendment would overwrite it
+	_, err = amend(operator, func(m *types.MsgAmendPendingVesting) {
+		m.Percent = 10
+		m.ExpectedHash = stored.Hash()
+	})
+	require.ErrorIs(t, err, types.ErrRecordChanged)
+	current, _ := k.GetVestingData(ctx, sdk.MustAccAddressFromBech32(addr))
+	res, err = amend(operator, func(m *types.MsgAmendPendingVesting) {
+		m.Percent = 10
+		m.ExpectedHash = current.Hash()
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 3, res.Version)
+
+	stored.Processed = true
+	require.NoError(t, k.SetVestingData(ctx, stored))
+	_, err = amend(authority, func(m *types.MsgAmendPendingVesting) { m.Amount = math.NewInt(3000) })
+	require.ErrorIs(t, err, types.ErrNotPending)
+
+	_, err = ms.AmendPendingVesting(ctx, &types.MsgAmendPendingVesting{Signer: authority, Address: sample.AccAddress(), Duration: 60, Parts: 1, Block: 10})
+	require.ErrorIs(t, err, types.ErrNotPending)
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+func (k msgServer) ClawbackVesting(goCtx context.Context, req *types.MsgClawbackVesting) (*types.MsgClawbackVestingResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid vesting account address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	amount, delegated, err := k.Clawback(ctx, addr, req.Destination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClawbackVestingResponse{Amount: amount, Delegated: delegated}, nil
+}
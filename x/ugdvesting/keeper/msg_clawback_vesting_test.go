@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/keeper"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/sample"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/keeper"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+func TestMsgClawbackVesting(t *testing.T) {
+	k, ctx, ak, bk, dk, _ := keepertest.UgdvestingKeeperWithStakingMocks(t)
+	ms := keeper.NewMsgServerImpl(k)
+	authority := k.GetAuthority()
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	// the first of two periods of 100 vested
+	ctx = ctx.WithBlockTime(start.Add(15 * time.Second))
+
+	newAccount := func(t *testing.T, delegatedVesting sdk.Coins) sdk.AccAddress {
+		addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+		acc, err := vestingtypes.NewPeriodicVestingAccount(authtypes.NewBaseAccountWithAddress(addr), ugd(200), start.Unix(), vestingtypes.Periods{
+			{Length: 10, Amount: ugd(100)},
+			{Length: 10, Amount: ugd(100)},
+		})
+		require.NoError(t, err)
+		acc.DelegatedVesting = delegatedVesting
+		ak.EXPECT().GetAccount(gomock.Any(), addr).Return(acc).MaxTimes(1)
+		return addr
+	}
+	record := func(addr sdk.AccAddress, funder string) types.VestingData {
+		return types.VestingData{Address: addr.String(), Amount: math.NewInt(200), Duration: 10, Parts: 2, Block: 1, Processed: true, Funder: funder}
+	}
+	expectBaseAccount := func(addr sdk.AccAddress) {
+		ak.EXPECT().SetAccount(gomock.Any(), gomock.Any()).Do(func(_ context.Context, acc sdk.AccountI) {
+			require.IsType(t, &authtypes.BaseAccount{}, acc)
+			require.Equal(t, addr, acc.GetAddress())
+		})
+	}
+
+	t.Run("invalid authority", func(t *testing.T) {
+		_, err := ms.ClawbackVesting(ctx, &types.MsgClawbackVesting{
+			Authority:   sample.AccAddress(),
+			Address:     sample.AccAddress(),
+			Destination: types.ClawbackDestination_CLAWBACK_DESTINATION_COMMUNITY_POOL,
+		})
+		require.ErrorIs(t, err, types.ErrInvalidSigner)
+	})
+
+	t.Run("to the community pool", func(t *testing.T) {
+		addr := newAccount(t, nil)
+		require.NoError(t, k.SetVestingData(ctx, record(addr, "")))
+		expectBaseAccount(addr)
+		dk.EXPECT().FundCommunityPool(gomock.Any(), ugd(100), addr).Return(nil)
+
+		res, err := ms.ClawbackVesting(ctx, &types.MsgClawbackVesting{
+			Authority:   authority,
+			Address:     addr.String(),
+			Destination: types.ClawbackDestination_CLAWBACK_DESTINATION_COMMUNITY_POOL,
+		})
+		require.NoError(t, err)
+		require.Equal(t, ugd(100), res.Amount)
+		require.True(t, res.Delegated.IsZero())
+
+		data, _ := k.GetVestingData(ctx, addr)
+		require.Equal(t, types.VestingStatus_VESTING_STATUS_CLAWED_BACK, data.Status())
+
+		// the account without schedule has nothing left to claw back
+		ak.EXPECT().GetAccount(gomock.Any(), addr).Return(authtypes.NewBaseAccountWithAddress(addr))
+		_, err = ms.ClawbackVesting(ctx, &types.MsgClawbackVesting{
+			Authority:   authority,
+			Address:     addr.String(),
+			Destination: types.ClawbackDestination_CLAWBACK_DESTINATION_COMMUNITY_POOL,
+		})
+		require.ErrorIs(t, err, types.ErrClawbackFailed)
+	})
+
+	t.Run("delegated coins stay on the schedule", func(t *testing.T) {
+		funder := sdk.MustAccAddressFromBech32(sample.AccAddress())
+		// 80 of the 100 unvested coins are delegated
+		addr := newAccount(t, ugd(80))
+		require.NoError(t, k.SetVestingData(ctx, record(addr, funder.String())))
+
+		var reduced *vestingtypes.PeriodicVestingAccount
+		ak.EXPECT().SetAccount(gomock.Any(), gomock.Any()).Do(func(_ context.Context, acc sdk.AccountI) {
+			reduced = acc.(*vestingtypes.PeriodicVestingAccount)
+		})
+		bk.EXPECT().SendCoins(gomock.Any(), addr, funder, ugd(20)).Return(nil)
+
+		res, err := ms.ClawbackVesting(ctx, &types.MsgClawbackVesting{
+			Authority:   authority,
+			Address:     addr.String(),
+			Destination: types.ClawbackDestination_CLAWBACK_DESTINATION_FUNDER,
+		})
+		require.NoError(t, err)
+		require.Equal(t, ugd(20), res.Amount)
+		require.Equal(t, ugd(80), res.Delegated)
+
+		// the clawed back coins are taken from the last period, the delegated
+		// ones keep vesting and are still tracked as delegated
+		require.Equal(t, ugd(180), reduced.OriginalVesting)
+		require.Len(t, reduced.VestingPeriods, 2)
+		require.Equal(t, ugd(100), reduced.VestingPeriods[0].Amount)
+		require.Equal(t, ugd(80), reduced.VestingPeriods[1].Amount)
+		require.Equal(t, start.Add(20*time.Second).Unix(), reduced.EndTime)
+		require.Equal(t, ugd(80), reduced.GetVestingCoins(ctx.BlockTime()))
+		require.Equal(t, ugd(80), reduced.DelegatedVesting)
+
+		events := typedEvents[*types.EventVestingClawedBack](t, ctx)
+		require.Equal(t, funder.String(), events[len(events)-1].Recipient)
+		require.Equal(t, ugd(80), events[len(events)-1].Delegated)
+		data, _ := k.GetVestingData(ctx, addr)
+		require.Equal(t, types.VestingStatus_VESTING_STATUS_CLAWED_BACK, data.Status())
+	})
+
+	t.Run("all unvested coins delegated", func(t *testing.T) {
+		addr := newAccount(t, ugd(100))
+		require.NoError(t, k.SetVestingData(ctx, record(addr, "")))
+
+		_, err := ms.ClawbackVesting(ctx, &types.MsgClawbackVesting{
+			Authority:   authority,
+			Address:     addr.String(),
+			Destination: types.ClawbackDestination_CLAWBACK_DESTINATION_COMMUNITY_POOL,
+		})
+		require.ErrorIs(t, err, types.ErrClawbackFailed)
+		require.ErrorContains(t, err, "are delegated")
+	})
+
+	t.Run("no funder", func(t *testing.T) {
+		addr := newAccount(t, nil)
+		require.NoError(t, k.SetVestingData(ctx, record(addr, "")))
+
+		_, err := ms.ClawbackVesting(ctx, &types.MsgClawbackVesting{
+			Authority:   authority,
+			Address:     addr.String(),
+			Destination: types.ClawbackDestination_CLAWBACK_DESTINATION_FUNDER,
+		})
+		require.ErrorIs(t, err, types.ErrClawbackFailed)
+	})
+
+	t.Run("pending record", func(t *testing.T) {
+		addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+		pending := record(addr, "")
+		pending.Processed = false
+		require.NoError(t, k.SetVestingData(ctx, pending))
+
+		_, err := ms.ClawbackVesting(ctx, &types.MsgClawbackVesting{
+			Authority:   authority,
+			Address:     addr.String(),
+			Destination: types.ClawbackDestination_CLAWBACK_DESTINATION_COMMUNITY_POOL,
+		})
+		require.ErrorIs(t, err, types.ErrClawbackFailed)
+	})
+}
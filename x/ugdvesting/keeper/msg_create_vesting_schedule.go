@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+func (k msgServer) CreateVestingSchedule(goCtx context.Context, req *types.MsgCreateVestingSchedule) (*types.MsgCreateVestingScheduleResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// hedgehog snapshots must not replace the schedule
+	schedule := req.Schedule
+	schedule.Pinned = true
+	stored, err := k.IngestVestingData(ctx, schedule)
+	if err != nil {
+		return nil, err
+	}
+	if !stored {
+		return nil, errorsmod.Wrapf(types.ErrInvalidVestingData, "vesting of %s was already processed", req.Schedule.Address)
+	}
+
+	// the block moves to the next one when it already passed
+	data, _ := k.GetVestingData(ctx, sdk.MustAccAddressFromBech32(req.Schedule.Address))
+	return &types.MsgCreateVestingScheduleResponse{Block: data.Block}, nil
+}
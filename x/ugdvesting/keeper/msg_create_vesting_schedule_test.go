@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/keeper"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/sample"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/keeper"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+func TestMsgCreateVestingSchedule(t *testing.T) {
+	k, ctx, ak, bk := keepertest.UgdvestingKeeperWithMocks(t)
+	ms := keeper.NewMsgServerImpl(k)
+	authority := k.GetAuthority()
+	ctx = ctx.WithBlockHeight(5)
+
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	schedule := types.VestingData{Address: addr.String(), Amount: math.NewInt(1000), Duration: 3600, Parts: 4, Percent: 10, Cliff: 2, Block: 10}
+
+	_, err := ms.CreateVestingSchedule(ctx, &types.MsgCreateVestingSchedule{Authority: sample.AccAddress(), Schedule: schedule})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	res, err := ms.CreateVestingSchedule(ctx, &types.MsgCreateVestingSchedule{Authority: authority, Schedule: schedule})
+	require.NoError(t, err)
+	require.EqualValues(t, 10, res.Block)
+
+	invalid := schedule
+	invalid.Parts = 0
+	_, err = ms.CreateVestingSchedule(ctx, &types.MsgCreateVestingSchedule{Authority: authority, Schedule: invalid})
+	require.ErrorIs(t, err, types.ErrInvalidVestingData)
+
+	// the schedule goes through the same activation as hedgehog records
+	var converted sdk.AccountI
+	ak.EXPECT().GetAccount(gomock.Any(), addr).Return(authtypes.NewBaseAccountWithAddress(addr))
+	bk.EXPECT().GetAllBalances(gomock.Any(), addr).Return(sdk.NewCoins(sdk.NewInt64Coin("uugd", 1000)))
+	ak.EXPECT().SetAccount(gomock.Any(), gomock.Any()).Do(func(_ interface{}, acc sdk.AccountI) {
+		converted = acc
+	})
+	k.ProcessPendingVesting(ctx.WithBlockHeight(10))
+
+	periodic, ok := converted.(*vestingtypes.PeriodicVestingAccount)
+	require.True(t, ok)
+	require.Len(t, periodic.VestingPeriods, 1+2+3)
+	require.True(t, k.HasProcessedAddress(ctx, addr))
+
+	_, err = ms.CreateVestingSchedule(ctx, &types.MsgCreateVestingSchedule{Authority: authority, Schedule: schedule})
+	require.ErrorIs(t, err, types.ErrInvalidVestingData)
+
+	// a schedule whose block passed is activated in the next block
+	late := types.VestingData{Address: sample.AccAddress(), Amount: math.NewInt(1000), Duration: 3600, Parts: 4, Block: 3}
+	res, err = ms.CreateVestingSchedule(ctx, &types.MsgCreateVestingSchedule{Authority: authority, Schedule: late})
+	require.NoError(t, err)
+	require.EqualValues(t, 6, res.Block)
+}
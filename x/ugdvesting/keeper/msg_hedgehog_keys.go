@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+func (k msgServer) AddHedgehogKey(goCtx context.Context, req *types.MsgAddHedgehogKey) (*types.MsgAddHedgehogKeyResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	if hedgehogKeyIndex(params.HedgehogKeys, req.Key.Id) >= 0 {
+		return nil, errorsmod.Wrapf(types.ErrInvalidHedgehogKey, "key id %s is already in use", req.Key.Id)
+	}
+
+	params.HedgehogKeys = append(params.HedgehogKeys, req.Key)
+	if err := k.setSignerParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddHedgehogKeyResponse{}, nil
+}
+
+func (k msgServer) RotateHedgehogKey(goCtx context.Context, req *types.MsgRotateHedgehogKey) (*types.MsgRotateHedgehogKeyResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	i := hedgehogKeyIndex(params.HedgehogKeys, req.Key.Id)
+	if i < 0 {
+		return nil, errorsmod.Wrapf(types.ErrUnknownHedgehogKey, "%s", req.Key.Id)
+	}
+
+	params.HedgehogKeys[i] = req.Key
+	if err := k.setSignerParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRotateHedgehogKeyResponse{}, nil
+}
+
+func (k msgServer) RetireHedgehogKey(goCtx context.Context, req *types.MsgRetireHedgehogKey) (*types.MsgRetireHedgehogKeyResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	i := hedgehogKeyIndex(params.HedgehogKeys, req.Id)
+	if i < 0 {
+		return nil, errorsmod.Wrapf(types.ErrUnknownHedgehogKey, "%s", req.Id)
+	}
+
+	params.HedgehogKeys = slices.Delete(params.HedgehogKeys, i, i+1)
+	if err := k.setSignerParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRetireHedgehogKeyResponse{}, nil
+}
+
+// setSignerParams stores params after a signer set change, refusing changes
+// that leave fewer keys than the signature threshold.
+func (k msgServer) setSignerParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidHedgehogKey, err.Error())
+	}
+	return k.SetParams(ctx, params)
+}
+
+func hedgehogKeyIndex(keys []types.HedgehogKey, id string) int {
+	return slices.IndexFunc(keys, func(key types.HedgehogKey) bool {
+		return key.Id == id
+	})
+}
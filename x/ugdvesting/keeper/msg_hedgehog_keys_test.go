@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/stretchr/testify/require"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+func TestMsgHedgehogKeys(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	authority := k.GetAuthority()
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+
+	keyA := types.HedgehogKey{Id: "a", Algorithm: types.KeyAlgorithmEd25519, PubKey: ed25519.GenPrivKey().PubKey().Bytes()}
+	keyB := types.HedgehogKey{Id: "b", Algorithm: types.KeyAlgorithmEd25519, PubKey: ed25519.GenPrivKey().PubKey().Bytes()}
+
+	_, err := ms.AddHedgehogKey(ctx, &types.MsgAddHedgehogKey{Authority: "invalid", Key: keyA})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	_, err = ms.AddHedgehogKey(ctx, &types.MsgAddHedgehogKey{Authority: authority, Key: keyA})
+	require.NoError(t, err)
+	_, err = ms.AddHedgehogKey(ctx, &types.MsgAddHedgehogKey{Authority: authority, Key: keyB})
+	require.NoError(t, err)
+	_, err = ms.AddHedgehogKey(ctx, &types.MsgAddHedgehogKey{Authority: authority, Key: keyA})
+	require.ErrorIs(t, err, types.ErrInvalidHedgehogKey)
+
+	params := k.GetParams(ctx)
+	params.SignatureThreshold = 2
+	require.NoError(t, k.SetParams(ctx, params))
+
+	rotated := types.HedgehogKey{Id: "a", Algorithm: types.KeyAlgorithmEd25519, PubKey: ed25519.GenPrivKey().PubKey().Bytes()}
+	_, err = ms.RotateHedgehogKey(ctx, &types.MsgRotateHedgehogKey{Authority: authority, Key: rotated})
+	require.NoError(t, err)
+	require.Equal(t, []types.HedgehogKey{rotated, keyB}, k.GetParams(ctx).HedgehogKeys)
+
+	_, err = ms.RotateHedgehogKey(ctx, &types.MsgRotateHedgehogKey{
+		Authority: authority,
+		Key:       types.HedgehogKey{Id: "c", Algorithm: keyB.Algorithm, PubKey: keyB.PubKey},
+	})
+	require.ErrorIs(t, err, types.ErrUnknownHedgehogKey)
+
+	// retiring a key must not leave fewer keys than the threshold
+	_, err = ms.RetireHedgehogKey(ctx, &types.MsgRetireHedgehogKey{Authority: authority, Id: "a"})
+	require.ErrorIs(t, err, types.ErrInvalidHedgehogKey)
+
+	params = k.GetParams(ctx)
+	params.SignatureThreshold = 1
+	require.NoError(t, k.SetParams(ctx, params))
+
+	_, err = ms.RetireHedgehogKey(ctx, &types.MsgRetireHedgehogKey{Authority: authority, Id: "a"})
+	require.NoError(t, err)
+	require.Equal(t, []types.HedgehogKey{keyB}, k.GetParams(ctx).HedgehogKeys)
+
+	_, err = ms.RetireHedgehogKey(ctx, &types.MsgRetireHedgehogKey{Authority: authority, Id: "a"})
+	require.ErrorIs(t, err, types.ErrUnknownHedgehogKey)
+}
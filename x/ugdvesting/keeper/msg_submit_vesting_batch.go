@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+func (k msgServer) SubmitVestingBatch(goCtx context.Context, req *types.MsgSubmitVestingBatch) (*types.MsgSubmitVestingBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	if !slices.Contains(params.Relayers, req.Relayer) {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorizedRelayer, "%s", req.Relayer)
+	}
+	if !params.IsActive(ctx.BlockHeight()) {
+		return nil, errorsmod.Wrapf(types.ErrIngestionInactive, "at height %d", ctx.BlockHeight())
+	}
+	if limit := params.SnapshotSizeLimit(); uint64(len(req.Snapshot)) > limit {
+		return nil, errorsmod.Wrapf(types.ErrInvalidVestingData, "snapshot of %d bytes exceeds %d bytes", len(req.Snapshot), limit)
+	}
+
+	// the snapshot goes through the signature and ordering checks of the
+	// snapshots agreed on through vote extensions. A rejection is reported
+	// rather than failing the transaction, which would drop its event.
+	accepted, err := k.applyVestingSnapshot(ctx, req.Snapshot)
+	var rejected *snapshotRejectedError
+	if errors.As(err, &rejected) {
+		return &types.MsgSubmitVestingBatchResponse{Rejected: rejected.reason}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitVestingBatchResponse{Accepted: accepted}, nil
+}
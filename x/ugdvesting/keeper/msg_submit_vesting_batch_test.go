@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/keeper"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/sample"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+func TestMsgSubmitVestingBatch(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	relayer := sample.AccAddress()
+
+	params := keepertest.Params()
+	params.Relayers = []string{relayer}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	pending := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	processed := types.VestingData{Address: sample.AccAddress(), Amount: math.NewInt(500), Duration: 3600, Parts: 2, Block: 5, Processed: true}
+	require.NoError(t, k.SetVestingData(ctx, processed))
+
+	snapshot := chainedSnapshot(pending.String(), 100, "2024-01-01T00:00:00Z", "")
+
+	_, err := ms.SubmitVestingBatch(ctx, &types.MsgSubmitVestingBatch{Relayer: sample.AccAddress(), Snapshot: snapshot})
+	require.ErrorIs(t, err, types.ErrUnauthorizedRelayer)
+
+	// entries hedgehog did not sign are refused as a whole, the transaction
+	// succeeds so the rejection event is kept
+	unsigned := []byte(`{"timestamp":"2024-01-01T00:00:00Z","data":{"vestingAddresses":{}}}`)
+	res, err := ms.SubmitVestingBatch(ctx, &types.MsgSubmitVestingBatch{Relayer: relayer, Snapshot: unsigned})
+	require.NoError(t, err)
+	require.Equal(t, types.SnapshotRejectedInvalidSignature, res.Rejected)
+	rejected := typedEvents[*types.EventSnapshotRejected](t, sdkCtx)
+	require.Len(t, rejected, 1)
+	require.Equal(t, types.SnapshotRejectedInvalidSignature, rejected[0].Reason)
+	_, found := k.GetSnapshotState(sdkCtx)
+	require.False(t, found)
+
+	res, err = ms.SubmitVestingBatch(ctx, &types.MsgSubmitVestingBatch{Relayer: relayer, Snapshot: snapshot})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, res.Accepted)
+	require.Empty(t, res.Rejected)
+	_, found = k.GetVestingData(sdkCtx, pending)
+	require.True(t, found)
+	require.True(t, k.IsLastSnapshot(sdkCtx, snapshot))
+
+	// so are replayed and unchained snapshots
+	res, err = ms.SubmitVestingBatch(ctx, &types.MsgSubmitVestingBatch{Relayer: relayer, Snapshot: snapshot})
+	require.NoError(t, err)
+	require.Equal(t, types.SnapshotRejectedReplayed, res.Rejected)
+	unchained := chainedSnapshot(processed.Address, 100, "2024-01-01T00:02:00Z", "2024-01-01T00:01:00Z")
+	res, err = ms.SubmitVestingBatch(ctx, &types.MsgSubmitVestingBatch{Relayer: relayer, Snapshot: unchained})
+	require.NoError(t, err)
+	require.Equal(t, types.SnapshotRejectedUnchained, res.Rejected)
+	require.Zero(t, res.Accepted)
+	require.Len(t, typedEvents[*types.EventSnapshotRejected](t, sdkCtx), 3)
+
+	// entries of processed addresses are skipped
+	next := chainedSnapshot(processed.Address, 100, "2024-01-01T00:01:00Z", "2024-01-01T00:00:00Z")
+	res, err = ms.SubmitVestingBatch(ctx, &types.MsgSubmitVestingBatch{Relayer: relayer, Snapshot: next})
+	require.NoError(t, err)
+	require.EqualValues(t, 0, res.Accepted)
+
+	params.MaxSnapshotBytes = 10
+	require.NoError(t, k.SetParams(ctx, params))
+	_, err = ms.SubmitVestingBatch(ctx, &types.MsgSubmitVestingBatch{Relayer: relayer, Snapshot: next})
+	require.ErrorIs(t, err, types.ErrInvalidVestingData)
+
+	params.Enabled = false
+	require.NoError(t, k.SetParams(ctx, params))
+	_, err = ms.SubmitVestingBatch(ctx, &types.MsgSubmitVestingBatch{Relayer: relayer, Snapshot: next})
+	require.ErrorIs(t, err, types.ErrIngestionInactive)
+}
@@ -0,0 +1,232 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+// ProposalHandler injects the vesting and mint snapshots validators agreed on
+// through vote extensions into block proposals, and applies them once the
+// block is finalized. It wraps the application's own proposal handlers.
+type ProposalHandler struct {
+	keeper   *Keeper
+	valStore baseapp.ValidatorStore
+	prepare  sdk.PrepareProposalHandler
+	process  sdk.ProcessProposalHandler
+}
+
+// NewProposalHandler returns a ProposalHandler delegating the regular
+// transactions of a proposal to prepare and process.
+func NewProposalHandler(
+	k *Keeper,
+	valStore baseapp.ValidatorStore,
+	prepare sdk.PrepareProposalHandler,
+	process sdk.ProcessProposalHandler,
+) *ProposalHandler {
+	return &ProposalHandler{
+		keeper:   k,
+		valStore: valStore,
+		prepare:  prepare,
+		process:  process,
+	}
+}
+
+// voteExtensionsEnabled reports whether the proposal at the given height
+// carries the vote extensions of the previous height.
+func voteExtensionsEnabled(ctx sdk.Context, height int64) bool {
+	cp := ctx.ConsensusParams()
+	return cp.Abci != nil && cp.Abci.VoteExtensionsEnableHeight > 0 && height > cp.Abci.VoteExtensionsEnableHeight
+}
+
+// PrepareProposalHandler places an InjectedVestingSnapshot as the first
+// transaction of the proposal. It carries the extended commit of the previous
+// height and the snapshot documents its vote extensions agreed on. The
+// documents are left out when they do not fit in the block; the proposal
+// fails when even the extended commit does not.
+func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		if !voteExtensionsEnabled(ctx, req.Height) {
+			return h.prepare(ctx, req)
+		}
+
+		if err := baseapp.ValidateVoteExtensions(ctx, h.valStore, req.Height, ctx.ChainID(), req.LocalLastCommit); err != nil {
+			return nil, fmt.Errorf("invalid vote extensions: %w", err)
+		}
+
+		injected := types.InjectedVestingSnapshot{ExtendedCommitInfo: req.LocalLastCommit}
+		if vote := agreedSnapshot(req.LocalLastCommit); vote != nil {
+			injected.Snapshot = h.keeper.snapshotDocument(ctx, types.SnapshotKindVesting, vote)
+			if injected.Snapshot == nil {
+				h.keeper.Logger().Error("agreed vesting snapshot is not available, the proposal will be rejected", "height", req.Height)
+			}
+		}
+		if vote := agreedMintSnapshot(req.LocalLastCommit); vote != nil {
+			injected.MintSnapshot = h.keeper.snapshotDocument(ctx, types.SnapshotKindMint, vote)
+			if injected.MintSnapshot == nil {
+				h.keeper.Logger().Error("agreed mint snapshot is not available, the proposal will be rejected", "height", req.Height)
+			}
+		}
+
+		bz, err := injected.Marshal()
+		if err == nil && int64(len(bz)) >= req.MaxTxBytes && (len(injected.Snapshot) > 0 || len(injected.MintSnapshot) > 0) {
+			h.keeper.Logger().Error("agreed snapshots do not fit in the block, proposing without them", "height", req.Height, "size", len(bz), "max_tx_bytes", req.MaxTxBytes)
+			injected.Snapshot, injected.MintSnapshot = nil, nil
+			bz, err = injected.Marshal()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode injected vesting snapshot: %w", err)
+		}
+		if int64(len(bz)) >= req.MaxTxBytes {
+			return nil, fmt.Errorf("injected extended commit of %d bytes does not fit in %d block bytes", len(bz), req.MaxTxBytes)
+		}
+
+		inner := *req
+		inner.MaxTxBytes -= int64(len(bz))
+		resp, err := h.prepare(ctx, &inner)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Txs = append([][]byte{bz}, resp.Txs...)
+		return resp, nil
+	}
+}
+
+// ProcessProposalHandler rejects proposals whose injected snapshots are not
+// the ones backed by a supermajority of the included vote extensions. Every
+// proposal must carry the extended commit, so an agreed snapshot can only be
+// left out when it does not fit in the block.
+func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		if !voteExtensionsEnabled(ctx, req.Height) {
+			return h.process(ctx, req)
+		}
+
+		if len(req.Txs) == 0 {
+			h.keeper.Logger().Error("proposal is missing the injected vesting snapshot", "height", req.Height)
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		var injected types.InjectedVestingSnapshot
+		if err := injected.Unmarshal(req.Txs[0]); err != nil {
+			h.keeper.Logger().Error("failed to decode injected vesting snapshot", "height", req.Height, "err", err)
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		if err := baseapp.ValidateVoteExtensions(ctx, h.valStore, req.Height, ctx.ChainID(), injected.ExtendedCommitInfo); err != nil {
+			h.keeper.Logger().Error("injected vesting snapshot has invalid vote extensions", "height", req.Height, "err", err)
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		injectedSize := int64(len(req.Txs[0]))
+		if err := h.checkInjected(ctx, agreedSnapshot(injected.ExtendedCommitInfo), injected.Snapshot, injectedSize, parseVestingHeader); err != nil {
+			h.keeper.Logger().Error("injected vesting snapshot is invalid", "height", req.Height, "err", err)
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+		if err := h.checkInjected(ctx, agreedMintSnapshot(injected.ExtendedCommitInfo), injected.MintSnapshot, injectedSize, parseMintHeader); err != nil {
+			h.keeper.Logger().Error("injected mint snapshot is invalid", "height", req.Height, "err", err)
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		inner := *req
+		inner.Txs = req.Txs[1:]
+		return h.process(ctx, &inner)
+	}
+}
+
+// checkInjected checks the injected snapshot document doc against the vote
+// agreed, nil when validators agreed on none. An agreed document may only be
+// missing when adding it to the injected transaction of injectedSize bytes
+// would not fit in the block.
+func (h *ProposalHandler) checkInjected(
+	ctx sdk.Context,
+	agreed *types.SnapshotVote,
+	doc []byte,
+	injectedSize int64,
+	parse func([]byte) (types.SnapshotHeader, error),
+) error {
+	if agreed == nil {
+		if len(doc) > 0 {
+			return errors.New("snapshot was not agreed on by validators")
+		}
+		return nil
+	}
+
+	params := h.keeper.GetParams(ctx)
+	if err := validateSnapshotVote(agreed, params.SnapshotSizeLimit()); err != nil {
+		return err
+	}
+
+	if len(doc) == 0 {
+		if maxBytes := maxBlockBytes(ctx); injectedSize+int64(agreed.Length) < maxBytes {
+			return fmt.Errorf("agreed snapshot of %d bytes is missing but fits in %d block bytes", agreed.Length, maxBytes)
+		}
+		return nil
+	}
+
+	if !snapshotVote(doc).Equal(agreed) {
+		return errors.New("snapshot does not match the one agreed on by validators")
+	}
+
+	header, err := parse(doc)
+	if err != nil {
+		return err
+	}
+	return params.VerifySnapshotSignature(header)
+}
+
+// maxBlockBytes returns the size limit of blocks set by the consensus
+// params.
+func maxBlockBytes(ctx sdk.Context) int64 {
+	if block := ctx.ConsensusParams().Block; block != nil && block.MaxBytes > 0 {
+		return block.MaxBytes
+	}
+	return cmttypes.MaxBlockSizeBytes
+}
+
+// PreBlocker applies the vesting and mint snapshots committed in the block.
+// It has to be called from the application's PreBlocker, so the records are
+// stored before the module's BeginBlock activates them. Baseapp drops the
+// events of the PreBlocker, the events of the snapshots are stored and the
+// module's BeginBlock emits them.
+func (h *ProposalHandler) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) error {
+	if !voteExtensionsEnabled(ctx, req.Height) || len(req.Txs) == 0 {
+		return nil
+	}
+
+	events := sdk.NewEventManager()
+	ctx = ctx.WithEventManager(events)
+
+	var injected types.InjectedVestingSnapshot
+	if err := injected.Unmarshal(req.Txs[0]); err != nil {
+		return fmt.Errorf("failed to decode injected vesting snapshot: %w", err)
+	}
+
+	params := h.keeper.GetParams(ctx)
+
+	if len(injected.Snapshot) > 0 {
+		if !params.IsActive(req.Height) {
+			h.keeper.Logger().Info("vesting ingestion is not active, vesting snapshot ignored", "height", req.Height)
+		} else if err := h.keeper.ApplyVestingSnapshot(ctx, injected.Snapshot); err != nil {
+			// ProcessProposal already checked the snapshot, this is not expected
+			h.keeper.Logger().Error("failed to apply vesting snapshot", "height", req.Height, "err", err)
+		}
+	}
+
+	if len(injected.MintSnapshot) > 0 {
+		if !params.IsMintActive(req.Height) {
+			h.keeper.Logger().Info("minting is not active, mint snapshot ignored", "height", req.Height)
+		} else if err := h.keeper.ApplyMintSnapshot(ctx, injected.MintSnapshot); err != nil {
+			h.keeper.Logger().Error("failed to apply mint snapshot", "height", req.Height, "err", err)
+		}
+	}
+
+	return h.keeper.deferEvents(ctx, events.ABCIEvents())
+}
@@ -0,0 +1,242 @@
+package keeper_test
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"testing"
+
+	"cosmossdk.io/core/header"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/keeper"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/sample"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/keeper"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/source"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+const proposalChainID = "ugd-test"
+
+// validatorSet is a baseapp.ValidatorStore of validators signing vote
+// extensions.
+type validatorSet map[string]ed25519.PrivKey
+
+func (v validatorSet) GetPubKeyByConsAddr(_ context.Context, addr sdk.ConsAddress) (cmtprotocrypto.PublicKey, error) {
+	return cmtprotocrypto.PublicKey{Sum: &cmtprotocrypto.PublicKey_Ed25519{Ed25519: v[string(addr)].PubKey().Bytes()}}, nil
+}
+
+// extendedCommit returns the signed extended commit of height, one vote of
+// power 10 per extension, and the context of the next height it is proposed
+// in.
+func extendedCommit(t *testing.T, ctx sdk.Context, height int64, extensions ...types.VestingVoteExtension) (sdk.Context, validatorSet, abci.ExtendedCommitInfo) {
+	vals := validatorSet{}
+	commit := abci.ExtendedCommitInfo{}
+	for _, ext := range extensions {
+		key := ed25519.GenPrivKey()
+		vals[string(key.PubKey().Address())] = key
+
+		bz, err := ext.Marshal()
+		require.NoError(t, err)
+		var signBytes bytes.Buffer
+		require.NoError(t, protoio.NewDelimitedWriter(&signBytes).WriteMsg(&cmtproto.CanonicalVoteExtension{
+			Extension: bz,
+			Height:    height,
+			ChainId:   proposalChainID,
+		}))
+		sig, err := key.Sign(signBytes.Bytes())
+		require.NoError(t, err)
+
+		commit.Votes = append(commit.Votes, abci.ExtendedVoteInfo{
+			Validator:          abci.Validator{Address: key.PubKey().Address(), Power: 10},
+			VoteExtension:      bz,
+			ExtensionSignature: sig,
+			BlockIdFlag:        cmtproto.BlockIDFlagCommit,
+		})
+	}
+	sort.Slice(commit.Votes, func(i, j int) bool {
+		return bytes.Compare(commit.Votes[i].Validator.Address, commit.Votes[j].Validator.Address) < 0
+	})
+
+	lastCommit := abci.CommitInfo{}
+	for _, vote := range commit.Votes {
+		lastCommit.Votes = append(lastCommit.Votes, abci.VoteInfo{Validator: vote.Validator, BlockIdFlag: vote.BlockIdFlag})
+	}
+
+	ctx = ctx.WithBlockHeight(height + 1).
+		WithHeaderInfo(header.Info{Height: height + 1, ChainID: proposalChainID}).
+		WithConsensusParams(cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{MaxBytes: 1 << 20},
+			Abci:  &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 1},
+		}).
+		WithCometInfo(baseapp.NewBlockInfo(nil, nil, nil, lastCommit))
+	return ctx, vals, commit
+}
+
+func acceptAll(_ sdk.Context, _ *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+	return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+}
+
+func proposeAll(_ sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+	return &abci.ResponsePrepareProposal{Txs: req.Txs}, nil
+}
+
+// proposalFixture votes on snapshot through the extensions of three
+// validators at height 10 and returns the handler of the proposals of height
+// 11.
+func proposalFixture(t *testing.T, snapshot []byte) (keeper.Keeper, sdk.Context, *keeper.ProposalHandler, abci.ExtendedCommitInfo) {
+	k, ctx := keepertest.UgdvestingKeeperWithSource(t, source.NewMemorySource(snapshot))
+
+	res, err := k.ExtendVoteHandler()(ctx, &abci.RequestExtendVote{Height: 10})
+	require.NoError(t, err)
+	var ext types.VestingVoteExtension
+	require.NoError(t, ext.Unmarshal(res.VoteExtension))
+	require.NotNil(t, ext.Snapshot)
+
+	ctx, vals, commit := extendedCommit(t, ctx, 10, ext, ext, ext)
+	return k, ctx, keeper.NewProposalHandler(&k, vals, proposeAll, acceptAll), commit
+}
+
+func TestProposalHandlers(t *testing.T) {
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	snapshot := vestingSnapshot(addr.String(), 100)
+	k, ctx, h, commit := proposalFixture(t, snapshot)
+
+	resp, err := h.PrepareProposalHandler()(ctx, &abci.RequestPrepareProposal{
+		Height:          11,
+		MaxTxBytes:      1 << 20,
+		LocalLastCommit: commit,
+		Txs:             [][]byte{[]byte("tx")},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Txs, 2)
+	require.Equal(t, []byte("tx"), resp.Txs[1])
+
+	// the document is included once, the votes only carry its hash
+	var injected types.InjectedVestingSnapshot
+	require.NoError(t, injected.Unmarshal(resp.Txs[0]))
+	require.Equal(t, snapshot, injected.Snapshot)
+	require.Equal(t, commit, injected.ExtendedCommitInfo)
+	for _, vote := range injected.ExtendedCommitInfo.Votes {
+		require.Less(t, len(vote.VoteExtension), len(snapshot))
+	}
+
+	processed, err := h.ProcessProposalHandler()(ctx, &abci.RequestProcessProposal{Height: 11, Txs: resp.Txs})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, processed.Status)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, h.PreBlocker(ctx, &abci.RequestFinalizeBlock{Height: 11, Txs: resp.Txs}))
+	_, found := k.GetVestingData(ctx, addr)
+	require.True(t, found)
+	require.True(t, k.IsLastSnapshot(ctx, snapshot))
+
+	// baseapp drops the events of the PreBlocker, BeginBlock emits them
+	require.Empty(t, ctx.EventManager().Events())
+	require.NoError(t, k.EmitDeferredEvents(ctx))
+	require.Len(t, typedEvents[*types.EventSnapshotAccepted](t, ctx), 1)
+	require.Len(t, typedEvents[*types.EventVestingIngested](t, ctx), 1)
+	require.Len(t, typedEvents[*types.EventVestingScheduled](t, ctx), 1)
+
+	// and only once
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.EmitDeferredEvents(ctx))
+	require.Empty(t, ctx.EventManager().Events())
+	has, err := k.DeferredEvents.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestProcessProposalRejects(t *testing.T) {
+	snapshot := vestingSnapshot(sample.AccAddress(), 100)
+	_, ctx, h, commit := proposalFixture(t, snapshot)
+
+	encode := func(injected types.InjectedVestingSnapshot) []byte {
+		bz, err := injected.Marshal()
+		require.NoError(t, err)
+		return bz
+	}
+
+	tampered := commit
+	tampered.Votes = append([]abci.ExtendedVoteInfo(nil), commit.Votes...)
+	tampered.Votes[0].VoteExtension = []byte("forged")
+
+	tests := []struct {
+		desc string
+		txs  [][]byte
+	}{
+		{desc: "missing injected transaction"},
+		{desc: "undecodable injected transaction", txs: [][]byte{[]byte("tx")}},
+		{desc: "missing extended commit", txs: [][]byte{encode(types.InjectedVestingSnapshot{Snapshot: snapshot})}},
+		{desc: "tampered extended commit", txs: [][]byte{encode(types.InjectedVestingSnapshot{Snapshot: snapshot, ExtendedCommitInfo: tampered})}},
+		{desc: "censored snapshot", txs: [][]byte{encode(types.InjectedVestingSnapshot{ExtendedCommitInfo: commit})}},
+		{
+			desc: "replaced snapshot",
+			txs:  [][]byte{encode(types.InjectedVestingSnapshot{Snapshot: vestingSnapshot(sample.AccAddress(), 100), ExtendedCommitInfo: commit})},
+		},
+		{
+			desc: "snapshot not agreed on",
+			txs:  [][]byte{encode(types.InjectedVestingSnapshot{Snapshot: snapshot, MintSnapshot: snapshot, ExtendedCommitInfo: commit})},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			resp, err := h.ProcessProposalHandler()(ctx, &abci.RequestProcessProposal{Height: 11, Txs: tc.txs})
+			require.NoError(t, err)
+			require.Equal(t, abci.ResponseProcessProposal_REJECT, resp.Status)
+		})
+	}
+}
+
+func TestPrepareProposalSizeLimit(t *testing.T) {
+	snapshot := vestingSnapshot(sample.AccAddress(), 100)
+	_, ctx, h, commit := proposalFixture(t, snapshot)
+	prepare := h.PrepareProposalHandler()
+
+	withoutSnapshot, err := (&types.InjectedVestingSnapshot{ExtendedCommitInfo: commit}).Marshal()
+	require.NoError(t, err)
+
+	// the snapshot is left out when it does not fit in the block
+	resp, err := prepare(ctx, &abci.RequestPrepareProposal{Height: 11, MaxTxBytes: int64(len(withoutSnapshot)) + 10, LocalLastCommit: commit})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{withoutSnapshot}, resp.Txs)
+
+	// which validators only accept when it cannot fit
+	processed, err := h.ProcessProposalHandler()(ctx, &abci.RequestProcessProposal{Height: 11, Txs: resp.Txs})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, processed.Status)
+
+	small := ctx.WithConsensusParams(cmtproto.ConsensusParams{
+		Block: &cmtproto.BlockParams{MaxBytes: int64(len(withoutSnapshot) + len(snapshot))},
+		Abci:  ctx.ConsensusParams().Abci,
+	})
+	processed, err = h.ProcessProposalHandler()(small, &abci.RequestProcessProposal{Height: 11, Txs: resp.Txs})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, processed.Status)
+
+	// an extended commit that does not fit fails the proposal
+	_, err = prepare(ctx, &abci.RequestPrepareProposal{Height: 11, MaxTxBytes: int64(len(withoutSnapshot)), LocalLastCommit: commit})
+	require.ErrorContains(t, err, "does not fit")
+}
+
+func TestExtendVoteSnapshotSizeLimit(t *testing.T) {
+	snapshot := vestingSnapshot(sample.AccAddress(), 100)
+	k, ctx := keepertest.UgdvestingKeeperWithSource(t, source.NewMemorySource(snapshot))
+
+	params := k.GetParams(ctx)
+	params.MaxSnapshotBytes = uint64(len(snapshot)) - 1
+	require.NoError(t, k.SetParams(ctx, params))
+
+	res, err := k.ExtendVoteHandler()(ctx, &abci.RequestExtendVote{Height: 10})
+	require.NoError(t, err)
+	var ext types.VestingVoteExtension
+	require.NoError(t, ext.Unmarshal(res.VoteExtension))
+	require.Nil(t, ext.Snapshot)
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+func (k Keeper) LastSnapshot(goCtx context.Context, req *types.QueryLastSnapshotRequest) (*types.QueryLastSnapshotResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var (
+		state types.SnapshotState
+		found bool
+	)
+	switch req.Kind {
+	case "", types.SnapshotKindVesting:
+		state, found = k.GetSnapshotState(ctx)
+	case types.SnapshotKindMint:
+		state, found = k.GetMintSnapshotState(ctx)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown snapshot kind %q", req.Kind)
+	}
+
+	if !found {
+		return &types.QueryLastSnapshotResponse{}, nil
+	}
+	return &types.QueryLastSnapshotResponse{State: &state}, nil
+}
@@ -0,0 +1,44 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/keeper"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/sample"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+func TestLastSnapshotQuery(t *testing.T) {
+	k, ctx := keepertest.UgdvestingKeeper(t)
+	ctx = ctx.WithBlockHeight(7)
+
+	res, err := k.LastSnapshot(ctx, &types.QueryLastSnapshotRequest{})
+	require.NoError(t, err)
+	require.Nil(t, res.State)
+
+	snapshot := vestingSnapshot(sample.AccAddress(), 100)
+	require.NoError(t, k.ApplyVestingSnapshot(ctx, snapshot))
+
+	hash := sha256.Sum256(snapshot)
+	res, err = k.LastSnapshot(ctx, &types.QueryLastSnapshotRequest{Kind: types.SnapshotKindVesting})
+	require.NoError(t, err)
+	require.Equal(t, &types.SnapshotState{Timestamp: "2024-01-01T00:00:00Z", Hash: hash[:], Height: 7}, res.State)
+
+	// what a relayer checks before broadcasting
+	reason, err := types.CheckSnapshotOrder(res.State, types.SnapshotHeader{Timestamp: "2024-01-01T00:00:00Z"}, hash[:])
+	require.ErrorIs(t, err, types.ErrSnapshotRejected)
+	require.Equal(t, types.SnapshotRejectedReplayed, reason)
+
+	// mint snapshots are tracked separately
+	res, err = k.LastSnapshot(ctx, &types.QueryLastSnapshotRequest{Kind: types.SnapshotKindMint})
+	require.NoError(t, err)
+	require.Nil(t, res.State)
+
+	_, err = k.LastSnapshot(ctx, &types.QueryLastSnapshotRequest{Kind: "other"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+func (k Keeper) Mint(goCtx context.Context, req *types.QueryMintRequest) (*types.QueryMintResponse, error) {
+	if req == nil || req.Key == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	key := req.Key
+	if _, canonicalKey, err := types.ParseMintKey(req.Key); err == nil {
+		key = canonicalKey
+	}
+
+	record, err := k.MintRecords.Get(goCtx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "no mint executed for key %s", req.Key)
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMintResponse{Record: record}, nil
+}
+
+func (k Keeper) Mints(goCtx context.Context, req *types.QueryMintsRequest) (*types.QueryMintsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Address != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	mints, pageRes, err := query.CollectionFilteredPaginate(goCtx, k.MintRecords, req.Pagination,
+		func(_ string, record types.MintRecord) (bool, error) {
+			return req.Address == "" || record.Address == req.Address, nil
+		},
+		func(_ string, record types.MintRecord) (types.MintRecord, error) {
+			return record, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMintsResponse{Mints: mints, Pagination: pageRes, TotalMinted: k.GetMintedTotal(goCtx)}, nil
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+func (k Keeper) VestingAmendments(goCtx context.Context, req *types.QueryVestingAmendmentsRequest) (*types.QueryVestingAmendmentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	amendments, pageRes, err := query.CollectionPaginate(goCtx, k.Amendments, req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, uint64], amendment types.VestingAmendment) (types.VestingAmendment, error) {
+			return amendment, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](addr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVestingAmendmentsResponse{Amendments: amendments, Pagination: pageRes}, nil
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+func (k Keeper) VestingBalance(goCtx context.Context, req *types.QueryVestingBalanceRequest) (*types.QueryVestingBalanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	at := ctx.BlockTime()
+	if req.Time != nil {
+		at = *req.Time
+	}
+
+	account := k.GetAccount(ctx, addr)
+	if account == nil {
+		return nil, status.Errorf(codes.NotFound, "account %s not found", req.Address)
+	}
+
+	progress, err := types.AccountVestingProgress(account, at)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	spendable := k.GetAllBalances(ctx, addr)
+	if vestingAcc, ok := account.(vestingexported.VestingAccount); ok {
+		spendable = subFloor(spendable, vestingAcc.LockedCoins(at))
+	}
+
+	res := &types.QueryVestingBalanceResponse{
+		Time:             at,
+		Vested:           progress.Vested,
+		Unvested:         progress.Unvested,
+		Spendable:        spendable,
+		NextUnlockAmount: progress.NextUnlockAmount,
+
+		DisplayVested:           k.DisplayCoins(ctx, progress.Vested),
+		DisplayUnvested:         k.DisplayCoins(ctx, progress.Unvested),
+		DisplaySpendable:        k.DisplayCoins(ctx, spendable),
+		DisplayNextUnlockAmount: k.DisplayCoins(ctx, progress.NextUnlockAmount),
+	}
+	if !progress.NextUnlockTime.IsZero() {
+		res.NextUnlockTime = &progress.NextUnlockTime
+	}
+	return res, nil
+}
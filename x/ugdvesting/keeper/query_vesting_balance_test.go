@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/keeper"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/sample"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+func TestVestingBalanceQuery(t *testing.T) {
+	k, ctx, ak, bk := keepertest.UgdvestingKeeperWithMocks(t)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(start.Add(5 * time.Second))
+
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	account, err := vestingtypes.NewPeriodicVestingAccount(authtypes.NewBaseAccountWithAddress(addr), ugd(300), start.Unix(), vestingtypes.Periods{
+		{Length: 10, Amount: ugd(100)},
+		{Length: 10, Amount: ugd(200)},
+	})
+	require.NoError(t, err)
+	ak.EXPECT().GetAccount(gomock.Any(), addr).Return(account).AnyTimes()
+	// 50 received after the conversion are always spendable
+	bk.EXPECT().GetAllBalances(gomock.Any(), addr).Return(ugd(350)).AnyTimes()
+	bk.EXPECT().GetDenomMetaData(gomock.Any(), "uugd").Return(banktypes.Metadata{
+		Base:       "uugd",
+		Display:    "ugd",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "uugd"}, {Denom: "ugd", Exponent: 2}},
+	}, true).AnyTimes()
+
+	at := func(seconds int64) *time.Time {
+		t := start.Add(time.Duration(seconds) * time.Second).UTC()
+		return &t
+	}
+
+	tests := []struct {
+		name string
+		time *time.Time
+		res  types.QueryVestingBalanceResponse
+	}{
+		{
+			name: "block time",
+			res: types.QueryVestingBalanceResponse{
+				Time:   *at(5),
+				Vested: sdk.NewCoins(), Unvested: ugd(300), Spendable: ugd(50),
+				NextUnlockTime: at(10), NextUnlockAmount: ugd(100),
+			},
+		},
+		{
+			name: "requested time",
+			time: at(15),
+			res: types.QueryVestingBalanceResponse{
+				Time:   *at(15),
+				Vested: ugd(100), Unvested: ugd(200), Spendable: ugd(150),
+				NextUnlockTime: at(20), NextUnlockAmount: ugd(200),
+			},
+		},
+		{
+			name: "everything vested",
+			time: at(20),
+			res: types.QueryVestingBalanceResponse{
+				Time:   *at(20),
+				Vested: ugd(300), Unvested: sdk.NewCoins(), Spendable: ugd(350),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := k.VestingBalance(ctx, &types.QueryVestingBalanceRequest{Address: addr.String(), Time: tc.time})
+			require.NoError(t, err)
+			require.Equal(t, tc.res.Time.Unix(), res.Time.Unix())
+			res.Time = tc.res.Time
+			require.Equal(t, displayCoins(tc.res.Vested), res.DisplayVested)
+			require.Equal(t, displayCoins(tc.res.Unvested), res.DisplayUnvested)
+			require.Equal(t, displayCoins(tc.res.Spendable), res.DisplaySpendable)
+			require.Equal(t, displayCoins(tc.res.NextUnlockAmount), res.DisplayNextUnlockAmount)
+			res.DisplayVested, res.DisplayUnvested, res.DisplaySpendable, res.DisplayNextUnlockAmount = nil, nil, nil, nil
+			require.Equal(t, &tc.res, res)
+		})
+	}
+
+	base := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	ak.EXPECT().GetAccount(gomock.Any(), base).Return(authtypes.NewBaseAccountWithAddress(base))
+	bk.EXPECT().GetAllBalances(gomock.Any(), base).Return(ugd(10))
+	res, err := k.VestingBalance(ctx, &types.QueryVestingBalanceRequest{Address: base.String()})
+	require.NoError(t, err)
+	require.Equal(t, ugd(10), res.Spendable)
+	require.Equal(t, []types.DisplayCoin{{Denom: "ugd", Amount: "0.10"}}, res.DisplaySpendable)
+	require.True(t, res.Unvested.IsZero())
+	require.Nil(t, res.NextUnlockTime)
+
+	missing := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	ak.EXPECT().GetAccount(gomock.Any(), missing).Return(nil)
+	_, err = k.VestingBalance(ctx, &types.QueryVestingBalanceRequest{Address: missing.String()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = k.VestingBalance(ctx, &types.QueryVestingBalanceRequest{Address: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// displayCoins returns coins of uugd in ugd, two decimals.
+func displayCoins(coins sdk.Coins) []types.DisplayCoin {
+	res := []types.DisplayCoin{}
+	for _, coin := range coins {
+		res = append(res, types.DisplayCoin{Denom: "ugd", Amount: fmt.Sprintf("%d.%02d", coin.Amount.Int64()/100, coin.Amount.Int64()%100)})
+	}
+	return res
+}
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+func (k Keeper) VestingRecord(goCtx context.Context, req *types.QueryVestingRecordRequest) (*types.QueryVestingRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	record, found := k.GetVestingData(ctx, addr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no vesting record for %s", req.Address)
+	}
+
+	return &types.QueryVestingRecordResponse{
+		Record:        record,
+		Status:        record.Status(),
+		DisplayAmount: k.recordDisplayAmount(ctx, record),
+	}, nil
+}
+
+func (k Keeper) PendingVestings(goCtx context.Context, req *types.QueryPendingVestingsRequest) (*types.QueryPendingVestingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.MinBlock < 0 || req.MaxBlock < 0 || (req.MaxBlock > 0 && req.MinBlock > req.MaxBlock) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid block range [%d, %d]", req.MinBlock, req.MaxBlock)
+	}
+
+	// only the queue entries within the block range are read, in block order
+	queue, err := newPendingBlockRange(k.PendingQueue, req.MinBlock, req.MaxBlock)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	transform := k.vestingRecord(goCtx)
+	records, pageRes, err := query.CollectionPaginate(goCtx, queue, req.Pagination,
+		func(key collections.Pair[int64, sdk.AccAddress], _ collections.NoValue) (types.VestingRecord, error) {
+			data, err := k.VestingData.Get(goCtx, key.K2())
+			if err != nil {
+				return types.VestingRecord{}, err
+			}
+			return transform(key.K2(), data)
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingVestingsResponse{Records: records, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ProcessedVestings(goCtx context.Context, req *types.QueryProcessedVestingsRequest) (*types.QueryProcessedVestingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	records, pageRes, err := query.CollectionFilteredPaginate(goCtx, k.VestingData, req.Pagination,
+		func(_ sdk.AccAddress, data types.VestingData) (bool, error) {
+			return data.Processed, nil
+		},
+		k.vestingRecord(goCtx),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProcessedVestingsResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// pendingBlockRange is the pending queue bounded to the entries queued from a
+// minimum block up to a maximum block, paginated queries then never read the
+// entries outside the range.
+type pendingBlockRange struct {
+	collections.KeySet[collections.Pair[int64, sdk.AccAddress]]
+	start, end []byte
+}
+
+// newPendingBlockRange bounds queue to the blocks from minBlock to maxBlock,
+// without upper bound when maxBlock is zero.
+func newPendingBlockRange(queue collections.KeySet[collections.Pair[int64, sdk.AccAddress]], minBlock, maxBlock int64) (pendingBlockRange, error) {
+	encode := func(block int64) ([]byte, error) {
+		prefix := collections.PairPrefix[int64, sdk.AccAddress](block)
+		buf := make([]byte, queue.KeyCodec().Size(prefix))
+		_, err := queue.KeyCodec().Encode(buf, prefix)
+		return buf, err
+	}
+
+	r := pendingBlockRange{KeySet: queue}
+	var err error
+	if r.start, err = encode(minBlock); err != nil {
+		return r, err
+	}
+	if maxBlock > 0 {
+		if r.end, err = encode(maxBlock); err != nil {
+			return r, err
+		}
+		r.end = storetypes.PrefixEndBytes(r.end)
+	}
+	return r, nil
+}
+
+// IterateRaw iterates over the entries between start and end that lie
+// within the block range.
+func (r pendingBlockRange) IterateRaw(ctx context.Context, start, end []byte, order collections.Order) (collections.Iterator[collections.Pair[int64, sdk.AccAddress], collections.NoValue], error) {
+	if bytes.Compare(start, r.start) < 0 {
+		start = r.start
+	}
+	if r.end != nil && (end == nil || bytes.Compare(end, r.end) > 0) {
+		end = r.end
+	}
+	return r.KeySet.IterateRaw(ctx, start, end, order)
+}
+
+// vestingRecord returns the transform of a stored record into a listed one.
+func (k Keeper) vestingRecord(ctx context.Context) func(sdk.AccAddress, types.VestingData) (types.VestingRecord, error) {
+	return func(_ sdk.AccAddress, data types.VestingData) (types.VestingRecord, error) {
+		return types.VestingRecord{Data: data, Status: data.Status(), DisplayAmount: k.recordDisplayAmount(ctx, data)}, nil
+	}
+}
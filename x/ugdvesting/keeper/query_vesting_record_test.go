@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/keeper"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/sample"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+func TestVestingRecordQuery(t *testing.T) {
+	k, ctx, _, bk := keepertest.UgdvestingKeeperWithMocks(t)
+	bk.EXPECT().GetDenomMetaData(gomock.Any(), "uugd").Return(banktypes.Metadata{
+		Base:       "uugd",
+		Display:    "ugd",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "uugd"}, {Denom: "ugd", Exponent: 3}},
+	}, true).AnyTimes()
+	bk.EXPECT().GetDenomMetaData(gomock.Any(), "uatom").Return(banktypes.Metadata{}, false).AnyTimes()
+
+	data := types.VestingData{Address: sample.AccAddress(), Amount: math.NewInt(1500), Duration: 3600, Parts: 4, Block: 10,
+		AdditionalAmounts: sdk.NewCoins(sdk.NewInt64Coin("uatom", 20))}
+	require.NoError(t, k.SetVestingData(ctx, data))
+
+	res, err := k.VestingRecord(ctx, &types.QueryVestingRecordRequest{Address: data.Address})
+	require.NoError(t, err)
+	require.Equal(t, data, res.Record)
+	require.Equal(t, types.VestingStatus_VESTING_STATUS_PENDING, res.Status)
+	// denoms without metadata stay in base units
+	require.Equal(t, []types.DisplayCoin{{Denom: "uatom", Amount: "20"}, {Denom: "ugd", Amount: "1.500"}}, res.DisplayAmount)
+
+	_, err = k.VestingRecord(ctx, &types.QueryVestingRecordRequest{Address: sample.AccAddress()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = k.VestingRecord(ctx, &types.QueryVestingRecordRequest{Address: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = k.VestingRecord(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestPendingAndProcessedVestingsQuery(t *testing.T) {
+	k, ctx, _, bk := keepertest.UgdvestingKeeperWithMocks(t)
+	bk.EXPECT().GetDenomMetaData(gomock.Any(), gomock.Any()).Return(banktypes.Metadata{}, false).AnyTimes()
+
+	records := map[string]types.VestingData{}
+	for block := int64(1); block <= 6; block++ {
+		data := types.VestingData{Address: sample.AccAddress(), Amount: math.NewInt(1000), Duration: 3600, Parts: 4, Block: block * 10}
+		switch block {
+		case 5:
+			data.Processed = true
+		case 6:
+			data.FailureReason = "account not found"
+		}
+		require.NoError(t, k.SetVestingData(ctx, data))
+		records[data.Address] = data
+	}
+
+	pendingBlocks := func(req *types.QueryPendingVestingsRequest) []int64 {
+		var blocks []int64
+		for {
+			res, err := k.PendingVestings(ctx, req)
+			require.NoError(t, err)
+			for _, record := range res.Records {
+				require.Equal(t, records[record.Data.Address], record.Data)
+				require.Equal(t, records[record.Data.Address].Status(), record.Status)
+				require.Equal(t, []types.DisplayCoin{{Denom: "uugd", Amount: "1000"}}, record.DisplayAmount)
+				blocks = append(blocks, record.Data.Block)
+			}
+			if len(res.Pagination.NextKey) == 0 {
+				return blocks
+			}
+			req.Pagination.Key = res.Pagination.NextKey
+		}
+	}
+
+	// pending records are listed in block order
+	require.Equal(t, []int64{10, 20, 30, 40, 60},
+		pendingBlocks(&types.QueryPendingVestingsRequest{Pagination: &query.PageRequest{Limit: 3}}))
+	require.Equal(t, []int64{20, 30},
+		pendingBlocks(&types.QueryPendingVestingsRequest{MinBlock: 20, MaxBlock: 30, Pagination: &query.PageRequest{Limit: 1}}))
+	require.Equal(t, []int64{30, 40, 60},
+		pendingBlocks(&types.QueryPendingVestingsRequest{MinBlock: 30, Pagination: &query.PageRequest{}}))
+	require.Equal(t, []int64{40, 30, 20},
+		pendingBlocks(&types.QueryPendingVestingsRequest{MinBlock: 15, MaxBlock: 45, Pagination: &query.PageRequest{Reverse: true}}))
+	require.Empty(t, pendingBlocks(&types.QueryPendingVestingsRequest{MinBlock: 41, MaxBlock: 59, Pagination: &query.PageRequest{}}))
+
+	res, err := k.PendingVestings(ctx, &types.QueryPendingVestingsRequest{Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	require.EqualValues(t, 5, res.Pagination.Total)
+	res, err = k.PendingVestings(ctx, &types.QueryPendingVestingsRequest{MinBlock: 20, MaxBlock: 40, Pagination: &query.PageRequest{Offset: 1, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, res.Records, 2)
+	require.EqualValues(t, 30, res.Records[0].Data.Block)
+	require.EqualValues(t, 3, res.Pagination.Total)
+
+	_, err = k.PendingVestings(ctx, &types.QueryPendingVestingsRequest{MinBlock: 30, MaxBlock: 20})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	processed, err := k.ProcessedVestings(ctx, &types.QueryProcessedVestingsRequest{})
+	require.NoError(t, err)
+	statuses := map[int64]types.VestingStatus{}
+	for _, record := range processed.Records {
+		statuses[record.Data.Block] = record.Status
+	}
+	require.Equal(t, map[int64]types.VestingStatus{
+		50: types.VestingStatus_VESTING_STATUS_CONVERTED,
+	}, statuses)
+
+	_, err = k.PendingVestings(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = k.ProcessedVestings(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+var _ banktypes.SendRestrictionFn = Keeper{}.SendRestriction
+
+// SendRestriction is the bank send restriction locking the coins a pending
+// vesting record schedules, so they are still held when the record is
+// converted. While Params.LockPendingFunds is set, an address with a pending
+// record may only send the coins of a scheduled denom that conversion would
+// not need: the coins it could vest, balance and delegations, above the
+// scheduled amount. The coins an existing vesting account still vests already
+// back its schedule, so when they cover the scheduled amount nothing more is
+// locked. Other denoms and addresses are not restricted.
+//
+// Delegations do not go through send restrictions. They are not locked, as
+// conversion counts delegated coins as held.
+func (k Keeper) SendRestriction(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	params := k.GetParams(ctx)
+	if !params.LockPendingFunds {
+		return toAddr, nil
+	}
+
+	data, found := k.GetVestingData(ctx, fromAddr)
+	if !found || data.Processed {
+		return toAddr, nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	scheduled := data.VestedCoins(params.VestingDenoms()[0])
+	var (
+		account  sdk.AccountI
+		unvested sdk.Coins
+		surplus  sdk.Coins
+	)
+	for _, coin := range amt {
+		locked := scheduled.AmountOf(coin.Denom)
+		if !locked.IsPositive() {
+			continue
+		}
+		// the account is only read for sends of scheduled denoms
+		if account == nil {
+			if account = k.GetAccount(sdkCtx, fromAddr); account == nil {
+				return toAddr, nil
+			}
+			if vestingAcc, ok := account.(vestingexported.VestingAccount); ok {
+				unvested = vestingAcc.GetVestingCoins(sdkCtx.BlockTime())
+			}
+			available, _, err := k.availableCoins(sdkCtx, account)
+			if err != nil {
+				return nil, err
+			}
+			surplus = subFloor(available, scheduled)
+		}
+		if unvested.AmountOf(coin.Denom).GTE(locked) {
+			continue
+		}
+		if available := surplus.AmountOf(coin.Denom); coin.Amount.GT(available) {
+			return nil, errorsmod.Wrapf(types.ErrFundsLocked,
+				"%s has a vesting schedule pending for block %d that locks %s%s; at most %s%s can be sent",
+				fromAddr, data.Block, locked, coin.Denom, available, coin.Denom)
+		}
+	}
+
+	return toAddr, nil
+}
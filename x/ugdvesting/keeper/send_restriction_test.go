@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/keeper"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/sample"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+func TestSendRestriction(t *testing.T) {
+	k, ctx, ak, bk := keepertest.UgdvestingKeeperWithMocks(t)
+
+	params := types.DefaultParams()
+	params.AdditionalDenoms = []string{"uatom"}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	from := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	to := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	record := types.VestingData{
+		Address:           from.String(),
+		Amount:            math.NewInt(1000),
+		AdditionalAmounts: sdk.NewCoins(sdk.NewInt64Coin("uatom", 50)),
+		Duration:          3600,
+		Parts:             4,
+		Block:             100,
+	}
+	require.NoError(t, k.SetVestingData(ctx, record))
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin("uugd", 1200), sdk.NewInt64Coin("uatom", 50), sdk.NewInt64Coin("ufoo", 10))
+	ak.EXPECT().GetAccount(gomock.Any(), from).Return(authtypes.NewBaseAccountWithAddress(from)).AnyTimes()
+	bk.EXPECT().GetAllBalances(gomock.Any(), from).Return(balances).AnyTimes()
+
+	send := func(addr sdk.AccAddress, coins ...sdk.Coin) error {
+		got, err := k.SendRestriction(ctx, addr, to, sdk.NewCoins(coins...))
+		if err == nil {
+			require.Equal(t, to, got)
+		}
+		return err
+	}
+
+	// coins above the scheduled amounts and other denoms stay spendable
+	require.NoError(t, send(from, sdk.NewInt64Coin("uugd", 200)))
+	require.NoError(t, send(from, sdk.NewInt64Coin("ufoo", 10)))
+
+	err := send(from, sdk.NewInt64Coin("uugd", 201))
+	require.ErrorIs(t, err, types.ErrFundsLocked)
+	require.ErrorContains(t, err, "at most 200uugd can be sent")
+	require.ErrorIs(t, send(from, sdk.NewInt64Coin("ufoo", 1), sdk.NewInt64Coin("uatom", 1)), types.ErrFundsLocked)
+
+	// other addresses are not restricted
+	require.NoError(t, send(to, sdk.NewInt64Coin("uugd", 5000)))
+
+	// governance can lift the lock
+	params.LockPendingFunds = false
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, send(from, sdk.NewInt64Coin("uugd", 1200)))
+
+	// processed records no longer lock anything
+	params.LockPendingFunds = true
+	require.NoError(t, k.SetParams(ctx, params))
+	record.Processed = true
+	require.NoError(t, k.SetVestingData(ctx, record))
+	require.NoError(t, send(from, sdk.NewInt64Coin("uugd", 1200)))
+}
+
+func TestSendRestrictionVestingAccount(t *testing.T) {
+	k, ctx, ak, bk := keepertest.UgdvestingKeeperWithMocks(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+
+	from := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	to := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	// 1000 still vest under the delayed schedule, 500 are free
+	acc, err := vestingtypes.NewDelayedVestingAccount(authtypes.NewBaseAccountWithAddress(from), sdk.NewCoins(sdk.NewInt64Coin("uugd", 1000)), 2000)
+	require.NoError(t, err)
+	ak.EXPECT().GetAccount(gomock.Any(), from).Return(acc).AnyTimes()
+	bk.EXPECT().GetAllBalances(gomock.Any(), from).Return(sdk.NewCoins(sdk.NewInt64Coin("uugd", 1500))).AnyTimes()
+
+	send := func(amount int64) error {
+		_, err := k.SendRestriction(ctx, from, to, sdk.NewCoins(sdk.NewInt64Coin("uugd", amount)))
+		return err
+	}
+	schedule := func(amount int64) {
+		require.NoError(t, k.SetVestingData(ctx, types.VestingData{
+			Address: from.String(), Amount: math.NewInt(amount), Duration: 3600, Parts: 4, Block: 100,
+		}))
+	}
+
+	// the coins still vesting back the schedule, the free coins stay spendable
+	schedule(1000)
+	require.NoError(t, send(500))
+
+	// above the coins still vesting, only the balance beyond the schedule
+	// can be sent
+	schedule(1200)
+	require.NoError(t, send(300))
+	err = send(301)
+	require.ErrorIs(t, err, types.ErrFundsLocked)
+	require.ErrorContains(t, err, "at most 300uugd can be sent")
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+// GetSnapshotState returns the last accepted vesting snapshot, if any.
+func (k Keeper) GetSnapshotState(ctx sdk.Context) (types.SnapshotState, bool) {
+	return k.getSnapshotState(ctx, k.SnapshotState)
+}
+
+// GetMintSnapshotState returns the last accepted mint snapshot, if any.
+func (k Keeper) GetMintSnapshotState(ctx sdk.Context) (types.SnapshotState, bool) {
+	return k.getSnapshotState(ctx, k.MintSnapshotState)
+}
+
+func (k Keeper) getSnapshotState(ctx sdk.Context, item collections.Item[types.SnapshotState]) (types.SnapshotState, bool) {
+	state, err := item.Get(ctx)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			k.Logger().Error("failed to read snapshot state", "err", err)
+		}
+		return types.SnapshotState{}, false
+	}
+	return state, true
+}
+
+// IsLastSnapshot reports whether raw is the document of the last accepted
+// vesting snapshot.
+func (k Keeper) IsLastSnapshot(ctx sdk.Context, raw []byte) bool {
+	state, found := k.GetSnapshotState(ctx)
+	hash := sha256.Sum256(raw)
+	return found && bytes.Equal(state.Hash, hash[:])
+}
+
+// IsLastMintSnapshot reports whether raw is the document of the last accepted
+// mint snapshot.
+func (k Keeper) IsLastMintSnapshot(ctx sdk.Context, raw []byte) bool {
+	state, found := k.GetMintSnapshotState(ctx)
+	hash := sha256.Sum256(raw)
+	return found && bytes.Equal(state.Hash, hash[:])
+}
+
+// snapshotRejectedError is the error of a snapshot acceptSnapshot rejected,
+// carrying the reason it reported in its EventSnapshotRejected.
+type snapshotRejectedError struct {
+	reason string
+	err    error
+}
+
+func (e *snapshotRejectedError) Error() string { return e.err.Error() }
+
+func (e *snapshotRejectedError) Unwrap() error { return e.err }
+
+// acceptSnapshot checks that the snapshot of the given kind is signed and
+// follows the last accepted one of its kind, then records it in item as the
+// new last snapshot. A rejected snapshot emits an EventSnapshotRejected
+// carrying the reason and returns a *snapshotRejectedError.
+func (k Keeper) acceptSnapshot(ctx sdk.Context, item collections.Item[types.SnapshotState], kind string, snapshot types.SnapshotHeader, raw []byte) error {
+	hash := sha256.Sum256(raw)
+
+	reason, err := k.checkSnapshot(ctx, item, snapshot, hash[:])
+	if err != nil {
+		k.emitEvent(ctx, &types.EventSnapshotRejected{
+			Reason:            reason,
+			Timestamp:         snapshot.Timestamp,
+			PreviousTimestamp: snapshot.PreviousTimeStamp,
+			Hash:              hex.EncodeToString(hash[:]),
+			Kind:              kind,
+		})
+		return &snapshotRejectedError{reason: reason, err: err}
+	}
+
+	if err := item.Set(ctx, types.SnapshotState{
+		Timestamp: snapshot.Timestamp,
+		Hash:      hash[:],
+		Height:    ctx.BlockHeight(),
+	}); err != nil {
+		return err
+	}
+
+	k.emitEvent(ctx, &types.EventSnapshotAccepted{
+		Timestamp: snapshot.Timestamp,
+		Hash:      hex.EncodeToString(hash[:]),
+		Height:    ctx.BlockHeight(),
+		Kind:      kind,
+	})
+	return nil
+}
+
+// checkSnapshot returns the rejection reason and error for a snapshot that
+// is not signed or may not follow the last one recorded in item.
+func (k Keeper) checkSnapshot(ctx sdk.Context, item collections.Item[types.SnapshotState], snapshot types.SnapshotHeader, hash []byte) (string, error) {
+	if err := k.GetParams(ctx).VerifySnapshotSignature(snapshot); err != nil {
+		return types.SnapshotRejectedInvalidSignature, err
+	}
+
+	var last *types.SnapshotState
+	if state, found := k.getSnapshotState(ctx, item); found {
+		last = &state
+	}
+	return types.CheckSnapshotOrder(last, snapshot, hash)
+}
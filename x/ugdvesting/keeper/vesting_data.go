@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+// SetVestingData stores the vesting record under its address. A pending
+// record is queued under its block, a processed one leaves the queue.
+func (k Keeper) SetVestingData(ctx context.Context, data types.VestingData) error {
+	addr, err := sdk.AccAddressFromBech32(data.Address)
+	if err != nil {
+		return err
+	}
+	if err := k.dequeuePending(ctx, addr); err != nil {
+		return err
+	}
+	if !data.Processed {
+		if err := k.PendingQueue.Set(ctx, collections.Join(data.Block, addr)); err != nil {
+			return err
+		}
+	}
+	return k.VestingData.Set(ctx, addr, data)
+}
+
+// GetVestingData returns the vesting record stored for the address.
+func (k Keeper) GetVestingData(ctx context.Context, address sdk.AccAddress) (types.VestingData, bool) {
+	data, err := k.VestingData.Get(ctx, address)
+	if err != nil {
+		return types.VestingData{}, false
+	}
+	return data, true
+}
+
+// RemoveVestingData deletes the vesting record stored for the address.
+func (k Keeper) RemoveVestingData(ctx context.Context, address sdk.AccAddress) error {
+	if err := k.dequeuePending(ctx, address); err != nil {
+		return err
+	}
+	return k.VestingData.Remove(ctx, address)
+}
+
+// dequeuePending removes the stored record of address from the pending queue,
+// if it is pending.
+func (k Keeper) dequeuePending(ctx context.Context, address sdk.AccAddress) error {
+	previous, err := k.VestingData.Get(ctx, address)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return nil
+	case err != nil:
+		return err
+	case previous.Processed:
+		return nil
+	}
+	return k.PendingQueue.Remove(ctx, collections.Join(previous.Block, address))
+}
+
+// HasProcessedAddress reports whether the vesting record of the address has
+// already been applied to its account.
+func (k Keeper) HasProcessedAddress(ctx context.Context, address sdk.AccAddress) bool {
+	data, err := k.VestingData.Get(ctx, address)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			k.Logger().Error("failed to read vesting data", "address", address, "err", err)
+		}
+		return false
+	}
+	return data.Processed
+}
@@ -0,0 +1,260 @@
+package keeper_test
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/keeper"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/sample"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/keeper"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+func TestVestingDataStore(t *testing.T) {
+	k, ctx := keepertest.UgdvestingKeeper(t)
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	_, found := k.GetVestingData(ctx, addr)
+	require.False(t, found)
+	require.False(t, k.HasProcessedAddress(ctx, addr))
+
+	data := types.VestingData{Address: addr.String(), Amount: math.NewInt(1000), Parts: 4, Block: 10}
+	require.NoError(t, k.SetVestingData(ctx, data))
+
+	got, found := k.GetVestingData(ctx, addr)
+	require.True(t, found)
+	require.Equal(t, data, got)
+	require.False(t, k.HasProcessedAddress(ctx, addr))
+
+	data.Processed = true
+	require.NoError(t, k.SetVestingData(ctx, data))
+	require.True(t, k.HasProcessedAddress(ctx, addr))
+
+	require.NoError(t, k.RemoveVestingData(ctx, addr))
+	_, found = k.GetVestingData(ctx, addr)
+	require.False(t, found)
+
+	require.Error(t, k.SetVestingData(ctx, types.VestingData{Address: "invalid"}))
+}
+
+func TestProcessPendingVesting(t *testing.T) {
+	k, ctx, ak, bk := keepertest.UgdvestingKeeperWithMocks(t)
+	ctx = ctx.WithBlockHeight(10)
+
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	balances := sdk.NewCoins(sdk.NewInt64Coin("uugd", 1000))
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+	account, err := vestingtypes.NewDelayedVestingAccount(authtypes.NewBaseAccountWithAddress(addr), balances, start)
+	require.NoError(t, err)
+
+	require.NoError(t, k.SetVestingData(ctx, types.VestingData{
+		Address:  addr.String(),
+		Amount:   math.NewInt(1000),
+		Start:    start,
+		Duration: 3600,
+		Parts:    4,
+		Block:    10,
+	}))
+
+	var converted sdk.AccountI
+	ak.EXPECT().GetAccount(gomock.Any(), addr).Return(account)
+	bk.EXPECT().GetAllBalances(gomock.Any(), addr).Return(balances)
+	ak.EXPECT().SetAccount(gomock.Any(), gomock.Any()).Do(func(_ interface{}, acc sdk.AccountI) {
+		converted = acc
+	})
+
+	k.ProcessPendingVesting(ctx)
+
+	periodic, ok := converted.(*vestingtypes.PeriodicVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, balances, periodic.OriginalVesting)
+	require.Len(t, periodic.VestingPeriods, 4)
+	require.Equal(t, start+3600, periodic.StartTime)
+	require.True(t, k.HasProcessedAddress(ctx, addr))
+
+	// processed records are not applied a second time
+	k.ProcessPendingVesting(ctx)
+}
+
+func TestProcessPendingVestingCatchUp(t *testing.T) {
+	k, ctx, ak, bk := keepertest.UgdvestingKeeperWithMocks(t)
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+
+	blocks := []int64{5, 8, 10, 12}
+	addrs := make([]sdk.AccAddress, len(blocks))
+	for i, block := range blocks {
+		addrs[i] = sdk.MustAccAddressFromBech32(sample.AccAddress())
+		require.NoError(t, k.SetVestingData(ctx, types.VestingData{
+			Address:  addrs[i].String(),
+			Amount:   math.NewInt(1000),
+			Duration: 3600,
+			Parts:    4,
+			Block:    block,
+		}))
+	}
+
+	var converted []string
+	ak.EXPECT().GetAccount(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, addr sdk.AccAddress) sdk.AccountI {
+		return authtypes.NewBaseAccountWithAddress(addr)
+	}).Times(3)
+	bk.EXPECT().GetAllBalances(gomock.Any(), gomock.Any()).Return(sdk.NewCoins(sdk.NewInt64Coin("uugd", 1000))).Times(3)
+	ak.EXPECT().SetAccount(gomock.Any(), gomock.Any()).Do(func(_ interface{}, acc sdk.AccountI) {
+		converted = append(converted, acc.GetAddress().String())
+	}).Times(3)
+
+	k.ProcessPendingVesting(ctx)
+
+	// every due record is activated in the order of its block
+	require.Equal(t, []string{addrs[0].String(), addrs[1].String(), addrs[2].String()}, converted)
+	for i, block := range blocks {
+		require.Equal(t, block <= 10, k.HasProcessedAddress(ctx, addrs[i]))
+	}
+	// and leaves the pending queue
+	require.Equal(t, []collections.Pair[int64, sdk.AccAddress]{collections.Join(int64(12), addrs[3])}, pendingQueue(t, k, ctx))
+
+	caughtUp := map[string]int64{}
+	for _, event := range typedEvents[*types.EventVestingCaughtUp](t, ctx) {
+		require.EqualValues(t, 10, event.Height)
+		caughtUp[event.Address] = event.Block
+	}
+	require.Equal(t, map[string]int64{addrs[0].String(): 5, addrs[1].String(): 8}, caughtUp)
+	require.Len(t, typedEvents[*types.EventVestingConverted](t, ctx), 3)
+}
+
+func TestIngestVestingDataReschedules(t *testing.T) {
+	k, ctx := keepertest.UgdvestingKeeper(t)
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+
+	past := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	current := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	for _, data := range []types.VestingData{
+		{Address: past.String(), Amount: math.NewInt(1000), Duration: 3600, Parts: 4, Block: 3},
+		{Address: current.String(), Amount: math.NewInt(1000), Duration: 3600, Parts: 4, Block: 10},
+	} {
+		stored, err := k.IngestVestingData(ctx, data)
+		require.NoError(t, err)
+		require.True(t, stored)
+	}
+
+	data, found := k.GetVestingData(ctx, past)
+	require.True(t, found)
+	require.Equal(t, int64(11), data.Block)
+	data, found = k.GetVestingData(ctx, current)
+	require.True(t, found)
+	require.Equal(t, int64(10), data.Block)
+
+	require.Equal(t, []*types.EventVestingScheduled{
+		{Address: past.String(), Block: 11, RequestedBlock: 3},
+		{Address: current.String(), Block: 10, RequestedBlock: 10},
+	}, typedEvents[*types.EventVestingScheduled](t, ctx))
+
+	ingested := typedEvents[*types.EventVestingIngested](t, ctx)
+	require.Len(t, ingested, 2)
+	require.Equal(t, past.String(), ingested[0].Record.Address)
+	require.EqualValues(t, 11, ingested[0].Record.Block)
+}
+
+func TestIngestVestingDataScheduleLimits(t *testing.T) {
+	k, ctx := keepertest.UgdvestingKeeper(t)
+	ctx = ctx.WithBlockHeight(10)
+
+	params := k.GetParams(ctx)
+	params.MaxParts = 12
+	params.MaxCliff = 6
+	require.NoError(t, k.SetParams(ctx, params))
+
+	tests := []struct {
+		name   string
+		parts  int32
+		cliff  int32
+		reason string
+	}{
+		{name: "at the limits", parts: 12, cliff: 6},
+		{name: "too many parts", parts: 13, reason: "parts must be at most 12"},
+		{name: "cliff too long", parts: 12, cliff: 7, reason: "cliff must be at most 6"},
+		{name: "beyond the hard limit", parts: types.MaxScheduleParts + 1, reason: "parts must be between 1 and"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+			stored, err := k.IngestVestingData(ctx, types.VestingData{
+				Address: addr.String(), Amount: math.NewInt(1000), Duration: 3600, Parts: tc.parts, Cliff: tc.cliff, Block: 20,
+			})
+			_, found := k.GetVestingData(ctx, addr)
+			if tc.reason != "" {
+				require.ErrorIs(t, err, types.ErrInvalidVestingData)
+				require.ErrorContains(t, err, tc.reason)
+				require.False(t, found)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, stored)
+			require.True(t, found)
+		})
+	}
+}
+
+func TestPendingQueue(t *testing.T) {
+	k, ctx := keepertest.UgdvestingKeeper(t)
+	addrs := []sdk.AccAddress{sdk.MustAccAddressFromBech32(sample.AccAddress()), sdk.MustAccAddressFromBech32(sample.AccAddress())}
+	slices.SortFunc(addrs, func(a, b sdk.AccAddress) int { return bytes.Compare(a, b) })
+	record := func(addr sdk.AccAddress, block int64) types.VestingData {
+		return types.VestingData{Address: addr.String(), Amount: math.NewInt(1000), Duration: 3600, Parts: 4, Block: block}
+	}
+
+	require.NoError(t, k.SetVestingData(ctx, record(addrs[0], 20)))
+	require.NoError(t, k.SetVestingData(ctx, record(addrs[1], 20)))
+	require.Equal(t, []collections.Pair[int64, sdk.AccAddress]{
+		collections.Join(int64(20), addrs[0]),
+		collections.Join(int64(20), addrs[1]),
+	}, pendingQueue(t, k, ctx))
+
+	// a rescheduled record moves in the queue
+	require.NoError(t, k.SetVestingData(ctx, record(addrs[1], 15)))
+	require.Equal(t, []collections.Pair[int64, sdk.AccAddress]{
+		collections.Join(int64(15), addrs[1]),
+		collections.Join(int64(20), addrs[0]),
+	}, pendingQueue(t, k, ctx))
+
+	// processed and removed records leave it
+	processed := record(addrs[1], 15)
+	processed.Processed = true
+	require.NoError(t, k.SetVestingData(ctx, processed))
+	require.NoError(t, k.RemoveVestingData(ctx, addrs[0]))
+	require.Empty(t, pendingQueue(t, k, ctx))
+}
+
+// pendingQueue returns the entries of the pending queue in order.
+func pendingQueue(t *testing.T, k keeper.Keeper, ctx sdk.Context) []collections.Pair[int64, sdk.AccAddress] {
+	t.Helper()
+	iter, err := k.PendingQueue.Iterate(ctx, nil)
+	require.NoError(t, err)
+	keys, err := iter.Keys()
+	require.NoError(t, err)
+	return keys
+}
+
+// typedEvents returns the events of type T emitted on ctx.
+func typedEvents[T proto.Message](t *testing.T, ctx sdk.Context) []T {
+	t.Helper()
+	var events []T
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err)
+		if typed, ok := msg.(T); ok {
+			events = append(events, typed)
+		}
+	}
+	return events
+}
@@ -0,0 +1,262 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sync"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+// ExtendVoteHandler returns the handler validators use to vote on the hedgehog
+// vesting-storage snapshot they observe in their precommit, and on the
+// mint-storage snapshot while minting is enabled. Fetching happens here,
+// outside of block execution, so a missing or diverging hedgehog response can
+// never make the state of validators diverge. Votes only carry the hash and
+// size of a document; the node keeps the document for its own proposals.
+func (k *Keeper) ExtendVoteHandler() sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, req *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+		ext := types.VestingVoteExtension{}
+		if k.isPollHeight(ctx, req.Height, req.Time) {
+			// on failure the vote stays unset, which is a valid vote that
+			// simply does not count towards any snapshot
+			ext.Snapshot = k.observeSnapshot(ctx, req.Height, types.SnapshotKindVesting, k.FetchVestingSnapshot, k.IsLastSnapshot, parseVestingHeader)
+			if k.GetParams(ctx).MintEnabled {
+				ext.MintSnapshot = k.observeSnapshot(ctx, req.Height, types.SnapshotKindMint, k.FetchMintSnapshot, k.IsLastMintSnapshot, parseMintHeader)
+			}
+		}
+
+		bz, err := ext.Marshal()
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode vote extension: %w", err)
+		}
+
+		return &abci.ResponseExtendVote{VoteExtension: bz}, nil
+	}
+}
+
+// observeSnapshot fetches a snapshot of the given kind and returns the vote
+// on it when it is new, within the size limit, parses and carries enough
+// hedgehog signatures, nil otherwise. The document is remembered for
+// snapshotDocument.
+func (k *Keeper) observeSnapshot(
+	ctx sdk.Context,
+	height int64,
+	kind string,
+	fetch func(context.Context) ([]byte, error),
+	isLast func(sdk.Context, []byte) bool,
+	parse func([]byte) (types.SnapshotHeader, error),
+) *types.SnapshotVote {
+	snapshot, err := fetch(ctx)
+	switch {
+	case err != nil:
+		k.Logger().Error("failed to fetch snapshot", "kind", kind, "height", height, "err", err)
+		return nil
+	case len(snapshot) == 0:
+		return nil
+	case isLast(ctx, snapshot):
+		k.Logger().Debug("snapshot unchanged since the last accepted one", "kind", kind, "height", height)
+		return nil
+	}
+
+	params := k.GetParams(ctx)
+	if limit := params.SnapshotSizeLimit(); uint64(len(snapshot)) > limit {
+		k.Logger().Error("hedgehog served an oversized snapshot", "kind", kind, "height", height, "size", len(snapshot), "limit", limit)
+		return nil
+	}
+
+	header, err := parse(snapshot)
+	if err != nil {
+		k.Logger().Error("hedgehog served an invalid snapshot", "kind", kind, "height", height, "err", err)
+		return nil
+	}
+	if err := params.VerifySnapshotSignature(header); err != nil {
+		k.Logger().Error("hedgehog served an unsigned snapshot", "kind", kind, "height", height, "err", err)
+		return nil
+	}
+
+	k.observed.set(kind, snapshot)
+	return snapshotVote(snapshot)
+}
+
+// parseVestingHeader returns the header of a vesting snapshot document.
+func parseVestingHeader(bz []byte) (types.SnapshotHeader, error) {
+	snapshot, err := types.ParseVestingSnapshot(bz)
+	return snapshot.SnapshotHeader, err
+}
+
+// parseMintHeader returns the header of a mint snapshot document.
+func parseMintHeader(bz []byte) (types.SnapshotHeader, error) {
+	snapshot, err := types.ParseMintSnapshot(bz)
+	return snapshot.SnapshotHeader, err
+}
+
+// snapshotVote returns the vote on the snapshot document bz.
+func snapshotVote(bz []byte) *types.SnapshotVote {
+	hash := sha256.Sum256(bz)
+	return &types.SnapshotVote{Hash: hash[:], Length: uint64(len(bz))}
+}
+
+// snapshotDocument returns the document of the given kind matching vote: the
+// one this node voted on, or else the one its source serves now. It returns
+// nil when neither matches.
+func (k *Keeper) snapshotDocument(ctx sdk.Context, kind string, vote *types.SnapshotVote) []byte {
+	if doc := k.observed.get(kind); doc != nil && snapshotVote(doc).Equal(vote) {
+		return doc
+	}
+
+	fetch := k.FetchVestingSnapshot
+	if kind == types.SnapshotKindMint {
+		fetch = k.FetchMintSnapshot
+	}
+	doc, err := fetch(ctx)
+	if err != nil {
+		k.Logger().Error("failed to fetch snapshot", "kind", kind, "err", err)
+		return nil
+	}
+	if len(doc) == 0 || !snapshotVote(doc).Equal(vote) {
+		return nil
+	}
+	return doc
+}
+
+// observedSnapshots holds the last snapshot document of each kind the node
+// voted on. It is shared by the copies of the keeper.
+type observedSnapshots struct {
+	mu   sync.Mutex
+	docs map[string][]byte
+}
+
+func newObservedSnapshots() *observedSnapshots {
+	return &observedSnapshots{docs: make(map[string][]byte)}
+}
+
+func (o *observedSnapshots) set(kind string, doc []byte) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.docs[kind] = doc
+}
+
+func (o *observedSnapshots) get(kind string) []byte {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.docs[kind]
+}
+
+// isPollHeight reports whether validators poll hedgehog in their vote
+// extension at height, see Params.IsPollHeight.
+func (k *Keeper) isPollHeight(ctx sdk.Context, height int64, blockTime time.Time) bool {
+	var lastBlockTime *time.Time
+	if nanos, err := k.LastBlockTime.Get(ctx); err == nil {
+		t := time.Unix(0, nanos)
+		lastBlockTime = &t
+	}
+	return k.GetParams(ctx).IsPollHeight(height, blockTime, lastBlockTime)
+}
+
+// RecordBlockTime stores the time of the current block while hedgehog is
+// polled on a time interval, so the vote extensions of the next height can
+// tell whether a new interval started.
+func (k *Keeper) RecordBlockTime(ctx sdk.Context) error {
+	if k.GetParams(ctx).PollIntervalTime <= 0 {
+		return nil
+	}
+	return k.LastBlockTime.Set(ctx, ctx.BlockTime().UnixNano())
+}
+
+// VerifyVoteExtensionHandler returns the handler checking the vote extensions
+// of other validators. Extensions are only rejected when they are malformed,
+// differing snapshots are settled by voting power in PrepareProposal.
+func (k *Keeper) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
+	return func(ctx sdk.Context, req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
+		if len(req.VoteExtension) == 0 {
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+		}
+
+		var ext types.VestingVoteExtension
+		if err := ext.Unmarshal(req.VoteExtension); err != nil {
+			k.Logger().Error("rejecting undecodable vote extension", "height", req.Height, "err", err)
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+
+		limit := k.GetParams(ctx).SnapshotSizeLimit()
+		for _, vote := range []*types.SnapshotVote{ext.Snapshot, ext.MintSnapshot} {
+			if err := validateSnapshotVote(vote, limit); err != nil {
+				k.Logger().Error("rejecting vote extension with an invalid snapshot vote", "height", req.Height, "err", err)
+				return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+			}
+		}
+
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+	}
+}
+
+// validateSnapshotVote checks that an optional snapshot vote names a
+// SHA-256 hash and a document of at most limit bytes.
+func validateSnapshotVote(vote *types.SnapshotVote, limit uint64) error {
+	if vote == nil {
+		return nil
+	}
+	if len(vote.Hash) != sha256.Size {
+		return fmt.Errorf("snapshot hash must be %d bytes, got %d", sha256.Size, len(vote.Hash))
+	}
+	if vote.Length == 0 || vote.Length > limit {
+		return fmt.Errorf("snapshot size %d is not within 1 and %d bytes", vote.Length, limit)
+	}
+	return nil
+}
+
+// agreedSnapshot returns the vesting snapshot vote carried by the vote
+// extensions of more than two thirds of the voting power of the commit, or
+// nil when no snapshot reached that threshold.
+func agreedSnapshot(extCommit abci.ExtendedCommitInfo) *types.SnapshotVote {
+	return agreedVote(extCommit, func(ext types.VestingVoteExtension) *types.SnapshotVote { return ext.Snapshot })
+}
+
+// agreedMintSnapshot is agreedSnapshot for the mint snapshot.
+func agreedMintSnapshot(extCommit abci.ExtendedCommitInfo) *types.SnapshotVote {
+	return agreedVote(extCommit, func(ext types.VestingVoteExtension) *types.SnapshotVote { return ext.MintSnapshot })
+}
+
+// agreedVote returns the snapshot vote selected by vote from the vote
+// extensions of more than two thirds of the voting power of the commit.
+func agreedVote(extCommit abci.ExtendedCommitInfo, vote func(types.VestingVoteExtension) *types.SnapshotVote) *types.SnapshotVote {
+	var totalPower int64
+	power := make(map[string]int64)
+	votes := make(map[string]*types.SnapshotVote)
+
+	for _, info := range extCommit.Votes {
+		totalPower += info.Validator.Power
+		if info.BlockIdFlag != cmtproto.BlockIDFlagCommit || len(info.VoteExtension) == 0 {
+			continue
+		}
+
+		var ext types.VestingVoteExtension
+		if err := ext.Unmarshal(info.VoteExtension); err != nil {
+			continue
+		}
+		v := vote(ext)
+		if v == nil {
+			continue
+		}
+
+		key := fmt.Sprintf("%x/%d", v.Hash, v.Length)
+		power[key] += info.Validator.Power
+		votes[key] = v
+	}
+
+	for key, p := range power {
+		// at most one snapshot can hold more than two thirds
+		if 3*p > 2*totalPower {
+			return votes[key]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,326 @@
+package keeper_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/keeper"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/sample"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/keeper"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/source"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+func vestingSnapshot(addr string, block int64) []byte {
+	return chainedSnapshot(addr, block, "2024-01-01T00:00:00Z", "")
+}
+
+// chainedSnapshot returns a signed vesting-storage document scheduling addr
+// at block.
+func chainedSnapshot(addr string, block int64, timestamp, previous string) []byte {
+	return keepertest.SignSnapshot([]byte(fmt.Sprintf(`{"timestamp":"%s","previousTimeStamp":"%s","data":{"vestingAddresses":{"Address(wif=%s)":`+
+		`{"amount":1000,"start":"2024-01-01T00:00:00Z","duration":"PT1H","parts":4,"block":%d,"percent":10,"cliff":0}}}}`,
+		timestamp, previous, addr, block)))
+}
+
+// snapshotVote returns the vote on snapshot, nil for an empty snapshot.
+func snapshotVote(snapshot []byte) *types.SnapshotVote {
+	if len(snapshot) == 0 {
+		return nil
+	}
+	return keeper.SnapshotVote(snapshot)
+}
+
+func extendedVote(t *testing.T, power int64, snapshot []byte) abci.ExtendedVoteInfo {
+	ext := types.VestingVoteExtension{Snapshot: snapshotVote(snapshot)}
+	bz, err := ext.Marshal()
+	require.NoError(t, err)
+	return abci.ExtendedVoteInfo{
+		Validator:     abci.Validator{Power: power},
+		VoteExtension: bz,
+		BlockIdFlag:   cmtproto.BlockIDFlagCommit,
+	}
+}
+
+func TestAgreedSnapshot(t *testing.T) {
+	a := vestingSnapshot(sample.AccAddress(), 100)
+	b := vestingSnapshot(sample.AccAddress(), 100)
+
+	tests := []struct {
+		desc     string
+		votes    []abci.ExtendedVoteInfo
+		expected *types.SnapshotVote
+	}{
+		{
+			desc:     "unanimous",
+			votes:    []abci.ExtendedVoteInfo{extendedVote(t, 10, a), extendedVote(t, 10, a), extendedVote(t, 10, a)},
+			expected: snapshotVote(a),
+		},
+		{
+			desc:     "supermajority",
+			votes:    []abci.ExtendedVoteInfo{extendedVote(t, 10, a), extendedVote(t, 10, a), extendedVote(t, 10, a), extendedVote(t, 10, b)},
+			expected: snapshotVote(a),
+		},
+		{
+			desc:  "exactly two thirds",
+			votes: []abci.ExtendedVoteInfo{extendedVote(t, 10, a), extendedVote(t, 10, a), extendedVote(t, 10, b)},
+		},
+		{
+			desc:  "absent validators count towards the total",
+			votes: []abci.ExtendedVoteInfo{extendedVote(t, 10, a), extendedVote(t, 10, a), {Validator: abci.Validator{Power: 20}}},
+		},
+		{
+			desc:  "empty extensions",
+			votes: []abci.ExtendedVoteInfo{extendedVote(t, 10, nil), extendedVote(t, 10, nil)},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, tc.expected, keeper.AgreedSnapshot(abci.ExtendedCommitInfo{Votes: tc.votes}))
+		})
+	}
+}
+
+func TestVerifyVoteExtension(t *testing.T) {
+	k, ctx := keepertest.UgdvestingKeeper(t)
+	verify := k.VerifyVoteExtensionHandler()
+
+	extension := func(vote *types.SnapshotVote) []byte {
+		ext := types.VestingVoteExtension{Snapshot: vote}
+		bz, err := ext.Marshal()
+		require.NoError(t, err)
+		return bz
+	}
+	valid := keeper.SnapshotVote(vestingSnapshot(sample.AccAddress(), 100))
+
+	tests := []struct {
+		desc      string
+		extension []byte
+		expected  abci.ResponseVerifyVoteExtension_VerifyStatus
+	}{
+		{desc: "empty", extension: nil, expected: abci.ResponseVerifyVoteExtension_ACCEPT},
+		{desc: "no snapshot", extension: extension(nil), expected: abci.ResponseVerifyVoteExtension_ACCEPT},
+		{desc: "valid snapshot", extension: extension(valid), expected: abci.ResponseVerifyVoteExtension_ACCEPT},
+		{desc: "short hash", extension: extension(&types.SnapshotVote{Hash: valid.Hash[:8], Length: valid.Length}), expected: abci.ResponseVerifyVoteExtension_REJECT},
+		{desc: "empty snapshot", extension: extension(&types.SnapshotVote{Hash: valid.Hash}), expected: abci.ResponseVerifyVoteExtension_REJECT},
+		{desc: "oversized snapshot", extension: extension(&types.SnapshotVote{Hash: valid.Hash, Length: types.DefaultMaxSnapshotBytes + 1}), expected: abci.ResponseVerifyVoteExtension_REJECT},
+		{desc: "undecodable", extension: []byte{0xff, 0xff}, expected: abci.ResponseVerifyVoteExtension_REJECT},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			resp, err := verify(ctx, &abci.RequestVerifyVoteExtension{Height: 10, VoteExtension: tc.extension})
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, resp.Status)
+		})
+	}
+}
+
+func TestApplyVestingSnapshot(t *testing.T) {
+	k, ctx := keepertest.UgdvestingKeeper(t)
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	require.NoError(t, k.ApplyVestingSnapshot(ctx, vestingSnapshot(addr.String(), 100)))
+
+	data, found := k.GetVestingData(ctx, addr)
+	require.True(t, found)
+	require.Equal(t, types.VestingData{
+		Address:  addr.String(),
+		Amount:   math.NewInt(1000),
+		Start:    1704067200,
+		Duration: 3600,
+		Parts:    4,
+		Block:    100,
+		Percent:  10,
+	}, data)
+
+	// processed records are left untouched by later snapshots
+	data.Processed = true
+	require.NoError(t, k.SetVestingData(ctx, data))
+	require.NoError(t, k.ApplyVestingSnapshot(ctx, chainedSnapshot(addr.String(), 200, "2024-01-01T00:01:00Z", "2024-01-01T00:00:00Z")))
+	stored, _ := k.GetVestingData(ctx, addr)
+	require.Equal(t, data, stored)
+
+	require.Error(t, k.ApplyVestingSnapshot(ctx, []byte("not json")))
+
+	// snapshots not signed by the signer set are refused
+	params := k.GetParams(ctx)
+	params.HedgehogKeys = []types.HedgehogKey{{Id: "a", Algorithm: types.KeyAlgorithmEd25519, PubKey: ed25519.GenPrivKey().PubKey().Bytes()}}
+	params.SignatureThreshold = 1
+	require.NoError(t, k.SetParams(ctx, params))
+
+	other := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	require.ErrorIs(t, k.ApplyVestingSnapshot(ctx, vestingSnapshot(other.String(), 100)), types.ErrInvalidSignature)
+	_, found = k.GetVestingData(ctx, other)
+	require.False(t, found)
+}
+
+func TestApplyVestingSnapshotPinned(t *testing.T) {
+	k, ctx := keepertest.UgdvestingKeeper(t)
+	ms := keeper.NewMsgServerImpl(k)
+	amended := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	scheduled := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	require.NoError(t, k.ApplyVestingSnapshot(ctx, chainedSnapshot(amended.String(), 100, "2024-01-01T00:00:00Z", "")))
+	_, err := ms.AmendPendingVesting(ctx, &types.MsgAmendPendingVesting{
+		Signer: k.GetAuthority(), Address: amended.String(),
+		Amount: math.NewInt(2000), Start: 1704067200, Duration: 3600, Parts: 4, Percent: 10, Block: 100,
+	})
+	require.NoError(t, err)
+	_, err = ms.CreateVestingSchedule(ctx, &types.MsgCreateVestingSchedule{
+		Authority: k.GetAuthority(),
+		Schedule:  types.VestingData{Address: scheduled.String(), Amount: math.NewInt(3000), Duration: 3600, Parts: 4, Block: 100},
+	})
+	require.NoError(t, err)
+
+	// later snapshots keep the amendment and the governance schedule
+	require.NoError(t, k.ApplyVestingSnapshot(ctx, chainedSnapshot(amended.String(), 200, "2024-01-01T00:01:00Z", "2024-01-01T00:00:00Z")))
+	require.NoError(t, k.ApplyVestingSnapshot(ctx, chainedSnapshot(scheduled.String(), 200, "2024-01-01T00:02:00Z", "2024-01-01T00:01:00Z")))
+
+	data, _ := k.GetVestingData(ctx, amended)
+	require.True(t, data.Pinned)
+	require.Equal(t, math.NewInt(2000), data.Amount)
+	require.EqualValues(t, 100, data.Block)
+
+	data, _ = k.GetVestingData(ctx, scheduled)
+	require.True(t, data.Pinned)
+	require.Equal(t, math.NewInt(3000), data.Amount)
+	require.EqualValues(t, 100, data.Block)
+}
+
+func TestApplyVestingSnapshotDecimalAmounts(t *testing.T) {
+	k, ctx := keepertest.UgdvestingKeeper(t)
+	params := k.GetParams(ctx)
+	params.CoinPower, params.CoinPowerValue, params.Precision = 8, 1e8, 2
+	require.NoError(t, k.SetParams(ctx, params))
+
+	exact := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	precise := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	entry := `{"amount":%q,"start":"2024-01-01T00:00:00Z","duration":"PT1H","parts":4,"block":100}`
+	snapshot := fmt.Sprintf(`{"timestamp":"2024-01-01T00:00:00Z","data":{"vestingAddresses":{"Address(wif=%s)":%s,"Address(wif=%s)":%s}}}`,
+		exact, fmt.Sprintf(entry, "1234.56"), precise, fmt.Sprintf(entry, "0.001"))
+	require.NoError(t, k.ApplyVestingSnapshot(ctx, keepertest.SignSnapshot([]byte(snapshot))))
+
+	data, found := k.GetVestingData(ctx, exact)
+	require.True(t, found)
+	require.Equal(t, math.NewInt(123456000000), data.Amount)
+
+	// more decimals than the precision param rejects the entry only
+	_, found = k.GetVestingData(ctx, precise)
+	require.False(t, found)
+}
+
+func TestApplyVestingSnapshotOrdering(t *testing.T) {
+	k, ctx := keepertest.UgdvestingKeeper(t)
+	addr := sample.AccAddress()
+
+	first := chainedSnapshot(addr, 100, "2024-01-01T00:00:00Z", "")
+	require.NoError(t, k.ApplyVestingSnapshot(ctx, first))
+	require.True(t, k.IsLastSnapshot(ctx, first))
+
+	tests := []struct {
+		name     string
+		snapshot []byte
+		reason   string
+	}{
+		{name: "replayed", snapshot: first, reason: types.SnapshotRejectedReplayed},
+		{name: "stale", snapshot: chainedSnapshot(addr, 200, "2023-12-31T00:00:00Z", ""), reason: types.SnapshotRejectedStale},
+		{name: "same timestamp", snapshot: chainedSnapshot(addr, 200, "2024-01-01T00:00:00Z", ""), reason: types.SnapshotRejectedStale},
+		{name: "unchained", snapshot: chainedSnapshot(addr, 200, "2024-01-01T00:02:00Z", "2024-01-01T00:01:00Z"), reason: types.SnapshotRejectedUnchained},
+		{name: "invalid timestamp", snapshot: chainedSnapshot(addr, 200, "yesterday", ""), reason: types.SnapshotRejectedInvalidTimestamp},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := ctx.WithEventManager(sdk.NewEventManager())
+			require.Error(t, k.ApplyVestingSnapshot(ctx, tc.snapshot))
+
+			events := typedEvents[*types.EventSnapshotRejected](t, ctx)
+			require.Len(t, events, 1)
+			require.Equal(t, tc.reason, events[0].Reason)
+			require.True(t, k.IsLastSnapshot(ctx, first))
+		})
+	}
+
+	next := chainedSnapshot(addr, 200, "2024-01-01T00:01:00Z", "2024-01-01T00:00:00Z")
+	require.NoError(t, k.ApplyVestingSnapshot(ctx.WithBlockHeight(20), next))
+
+	state, found := k.GetSnapshotState(ctx)
+	require.True(t, found)
+	require.Equal(t, "2024-01-01T00:01:00Z", state.Timestamp)
+	require.EqualValues(t, 20, state.Height)
+	require.True(t, k.IsLastSnapshot(ctx, next))
+}
+
+func TestExtendVote(t *testing.T) {
+	src := source.NewMemorySource(vestingSnapshot(sample.AccAddress(), 100))
+	k, ctx := keepertest.UgdvestingKeeperWithSource(t, src)
+	extend := k.ExtendVoteHandler()
+
+	extensionAt := func(height int64) types.VestingVoteExtension {
+		res, err := extend(ctx, &abci.RequestExtendVote{Height: height})
+		require.NoError(t, err)
+		var ext types.VestingVoteExtension
+		require.NoError(t, ext.Unmarshal(res.VoteExtension))
+		return ext
+	}
+
+	snapshot, err := src.FetchVestingSnapshot(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, keeper.SnapshotVote(snapshot), extensionAt(10).Snapshot)
+
+	// hedgehog is only polled every snapshotPollInterval blocks
+	require.Nil(t, extensionAt(11).Snapshot)
+
+	// an already accepted snapshot is not voted on again
+	require.NoError(t, k.ApplyVestingSnapshot(ctx, snapshot))
+	require.Nil(t, extensionAt(20).Snapshot)
+
+	src.Set([]byte("not json"))
+	require.Nil(t, extensionAt(30).Snapshot)
+
+	src.SetError(errors.New("hedgehog unavailable"))
+	require.Nil(t, extensionAt(40).Snapshot)
+}
+
+func TestExtendVotePollInterval(t *testing.T) {
+	src := source.NewMemorySource(vestingSnapshot(sample.AccAddress(), 100))
+	k, ctx := keepertest.UgdvestingKeeperWithSource(t, src)
+	extend := k.ExtendVoteHandler()
+	now := time.Date(2024, 1, 1, 0, 0, 30, 0, time.UTC)
+
+	extensionAt := func(height int64, blockTime time.Time) *types.SnapshotVote {
+		res, err := extend(ctx, &abci.RequestExtendVote{Height: height, Time: blockTime})
+		require.NoError(t, err)
+		var ext types.VestingVoteExtension
+		require.NoError(t, ext.Unmarshal(res.VoteExtension))
+		return ext.Snapshot
+	}
+
+	params := keepertest.Params()
+	params.PollIntervalBlocks = 0
+	params.PollIntervalTime = time.Minute
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, k.RecordBlockTime(ctx.WithBlockTime(now)))
+
+	// hedgehog is polled in the first block of every minute
+	require.Nil(t, extensionAt(11, now.Add(5*time.Second)))
+	require.NotEmpty(t, extensionAt(11, now.Add(30*time.Second)))
+
+	params.ActivationHeight = 100
+	require.NoError(t, k.SetParams(ctx, params))
+	require.Nil(t, extensionAt(12, now.Add(30*time.Second)))
+
+	params.ActivationHeight = 0
+	params.Enabled = false
+	require.NoError(t, k.SetParams(ctx, params))
+	require.Nil(t, extensionAt(12, now.Add(30*time.Second)))
+}
@@ -6,17 +6,23 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-const startBlockHeight = 50 // example block height for testing vestsing start
-
+// BeginBlock emits the events of the snapshots the PreBlocker applied and
+// activates the pending vesting records that are due from the activation
+// height on, whether or not hedgehog ingestion is enabled, see
+// Params.IsActivationHeight. New records are not fetched here: they arrive
+// through the snapshot validators agree on with vote extensions and that the
+// proposer injects into the block, see keeper.ProposalHandler.
 func (am AppModule) BeginBlock(goCtx context.Context) error {
 	k := am.keeper
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	if ctx.BlockHeight() >= startBlockHeight {
+	if err := k.EmitDeferredEvents(ctx); err != nil {
+		return err
+	}
+	if k.GetParams(ctx).IsActivationHeight(ctx.BlockHeight()) {
 		k.ProcessPendingVesting(ctx)
 	}
-	if ctx.BlockHeight()%10 == 0 {
-		// Call the function to process the vesting accounts
-		k.ProcessVestingAccounts(ctx)
+	if err := k.RecordBlockTime(ctx); err != nil {
+		return err
 	}
 	// FORE TESTING ONLY TODO: REMOVE OR DISABLE IN PRODUCTION
 	// if ctx.BlockHeight() == 9 {
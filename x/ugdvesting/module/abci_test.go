@@ -0,0 +1,52 @@
+package ugdvesting_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/keeper"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/sample"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/keeper"
+	ugdvesting "github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/module"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+func TestBeginBlockActivatesWithoutIngestion(t *testing.T) {
+	k, ctx, ak, bk := keepertest.UgdvestingKeeperWithMocks(t)
+	am := ugdvesting.NewAppModule(nil, &k, ak, bk)
+	ctx = ctx.WithBlockHeight(5).WithBlockTime(time.Unix(1700000000, 0))
+	// a chain without hedgehog signer set, ingestion stays disabled
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	_, err := keeper.NewMsgServerImpl(k).CreateVestingSchedule(ctx, &types.MsgCreateVestingSchedule{
+		Authority: k.GetAuthority(),
+		Schedule:  types.VestingData{Address: addr.String(), Amount: math.NewInt(1000), Start: 1700000000, Duration: 3600, Parts: 4, Block: 10},
+	})
+	require.NoError(t, err)
+
+	// nothing is due before the block of the schedule
+	require.NoError(t, am.BeginBlock(ctx))
+	data, _ := k.GetVestingData(ctx, addr)
+	require.False(t, data.Processed)
+
+	ak.EXPECT().GetAccount(gomock.Any(), addr).Return(authtypes.NewBaseAccountWithAddress(addr))
+	bk.EXPECT().GetAllBalances(gomock.Any(), addr).Return(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultDenom, 1000)))
+	var converted sdk.AccountI
+	ak.EXPECT().SetAccount(gomock.Any(), gomock.Any()).Do(func(_ interface{}, acc sdk.AccountI) {
+		converted = acc
+	})
+
+	require.NoError(t, am.BeginBlock(ctx.WithBlockHeight(10)))
+	data, _ = k.GetVestingData(ctx, addr)
+	require.True(t, data.Processed)
+	require.Empty(t, data.FailureReason)
+	require.IsType(t, &vestingtypes.PeriodicVestingAccount{}, converted)
+}
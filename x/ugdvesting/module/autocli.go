@@ -17,6 +17,61 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Shows the parameters of the module",
 				},
+				{
+					RpcMethod:      "VestingRecord",
+					Use:            "vesting-record [address]",
+					Short:          "Shows the hedgehog vesting record of an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "PendingVestings",
+					Use:       "pending-vestings",
+					Short:     "Lists the vesting records waiting for their block or their retry",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"min_block": {Name: "min-block", Usage: "lowest activation height to list"},
+						"max_block": {Name: "max-block", Usage: "highest activation height to list"},
+					},
+				},
+				{
+					RpcMethod: "ProcessedVestings",
+					Use:       "processed-vestings",
+					Short:     "Lists the vesting records that were converted",
+				},
+				{
+					RpcMethod:      "VestingBalance",
+					Use:            "vesting-balance [address]",
+					Short:          "Shows the vested, unvested and spendable coins of an address",
+					Long:           "Shows the vested, unvested and spendable coins of an address and its next unlock, at the current block time or the time given with --time (RFC 3339).",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "VestingAmendments",
+					Use:            "vesting-amendments [address]",
+					Short:          "Lists the amendments of the vesting record of an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "Mint",
+					Use:            "mint [key]",
+					Short:          "Shows the executed hedgehog mint of a mint key",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "key"}},
+				},
+				{
+					RpcMethod: "Mints",
+					Use:       "mints",
+					Short:     "Lists the executed hedgehog mints and the total minted",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"address": {Name: "address", Usage: "only list the mints to this address"},
+					},
+				},
+				{
+					RpcMethod: "LastSnapshot",
+					Use:       "last-snapshot",
+					Short:     "Shows the last accepted hedgehog snapshot",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"kind": {Name: "kind", Usage: "kind of snapshot, vesting or mint"},
+					},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -28,6 +83,34 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod: "SubmitVestingBatch",
+					Skip:      true, // submitted by the relay command
+				},
+				{
+					RpcMethod: "AddHedgehogKey",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RotateHedgehogKey",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RetireHedgehogKey",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "CreateVestingSchedule",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "ClawbackVesting",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "AmendPendingVesting",
+					Skip:      true, // submitted by the amend-pending-vesting command
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -1,6 +1,10 @@
 package ugdvesting
 
 import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/keeper"
 	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
@@ -8,6 +12,47 @@ import (
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState types.GenesisState) {
+	for _, data := range genState.PendingVestings {
+		if err := k.SetVestingData(ctx, data); err != nil {
+			panic(err)
+		}
+	}
+	for _, data := range genState.ProcessedVestings {
+		if err := k.SetVestingData(ctx, data); err != nil {
+			panic(err)
+		}
+	}
+	if genState.SnapshotState != nil {
+		if err := k.SnapshotState.Set(ctx, *genState.SnapshotState); err != nil {
+			panic(err)
+		}
+	}
+	for _, amendment := range genState.Amendments {
+		addr := sdk.MustAccAddressFromBech32(amendment.Address)
+		if err := k.Amendments.Set(ctx, collections.Join(addr, amendment.Version), amendment); err != nil {
+			panic(err)
+		}
+	}
+	minted := math.ZeroInt()
+	for _, record := range genState.Mints {
+		if err := k.MintRecords.Set(ctx, record.Key, record); err != nil {
+			panic(err)
+		}
+		minted = minted.Add(record.Amount.Amount)
+	}
+	if err := k.MintedTotal.Set(ctx, minted); err != nil {
+		panic(err)
+	}
+	if genState.MintSnapshotState != nil {
+		if err := k.MintSnapshotState.Set(ctx, *genState.MintSnapshotState); err != nil {
+			panic(err)
+		}
+	}
+	if genState.LastBlockTime != 0 {
+		if err := k.LastBlockTime.Set(ctx, genState.LastBlockTime); err != nil {
+			panic(err)
+		}
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
 }
@@ -17,6 +62,48 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
 
+	err := k.VestingData.Walk(ctx, nil, func(_ sdk.AccAddress, data types.VestingData) (bool, error) {
+		if data.Processed {
+			genesis.ProcessedVestings = append(genesis.ProcessedVestings, data)
+		} else {
+			genesis.PendingVestings = append(genesis.PendingVestings, data)
+		}
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	if state, found := k.GetSnapshotState(ctx); found {
+		genesis.SnapshotState = &state
+	}
+
+	err = k.Amendments.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, uint64], amendment types.VestingAmendment) (bool, error) {
+		genesis.Amendments = append(genesis.Amendments, amendment)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.MintRecords.Walk(ctx, nil, func(_ string, record types.MintRecord) (bool, error) {
+		genesis.Mints = append(genesis.Mints, record)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	if state, found := k.GetMintSnapshotState(ctx); found {
+		genesis.MintSnapshotState = &state
+	}
+
+	lastBlockTime, err := k.LastBlockTime.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
+	}
+	genesis.LastBlockTime = lastBlockTime
+
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
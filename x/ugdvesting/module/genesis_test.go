@@ -1,21 +1,49 @@
 package ugdvesting_test
 
 import (
+	"crypto/sha256"
 	"testing"
+	"time"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	keepertest "github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/keeper"
 	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/nullify"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/sample"
 	ugdvesting "github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/module"
 	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
 )
 
 func TestGenesis(t *testing.T) {
+	hash := sha256.Sum256([]byte("snapshot"))
+	amended := types.VestingData{Address: sample.AccAddress(), Amount: math.NewInt(1000), Duration: 3600, Parts: 4, Block: 100}
+	original := amended
+	original.Amount = math.NewInt(900)
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
+		PendingVestings: []types.VestingData{
+			amended,
+			{Address: sample.AccAddress(), Amount: math.NewInt(500), Duration: 60, Parts: 2, Percent: 10, Cliff: 1, Block: 200},
+			{Address: sample.AccAddress(), Amount: math.NewInt(1000), Duration: 3600, Parts: 4, Block: 120, FailureReason: "account not found"},
+		},
+		ProcessedVestings: []types.VestingData{
+			{Address: sample.AccAddress(), Amount: math.NewInt(1000), Duration: 3600, Parts: 4, Block: 10, Processed: true},
+		},
+		SnapshotState: &types.SnapshotState{Timestamp: "2024-01-01T00:00:00Z", Hash: hash[:], Height: 30},
+		Amendments: []types.VestingAmendment{
+			{Address: amended.Address, Version: 1, Signer: sample.AccAddress(), Height: 40, Previous: original, Amended: amended, Reason: "amount"},
+		},
+		Mints: []types.MintRecord{
+			{Key: "a", Address: sample.AccAddress(), Amount: sdk.NewInt64Coin(types.DefaultDenom, 100), Height: 50, SnapshotTimestamp: "2024-01-01T00:00:00Z"},
+			{Key: "b", Address: sample.AccAddress(), Amount: sdk.NewInt64Coin(types.DefaultDenom, 200), Height: 50, SnapshotTimestamp: "2024-01-01T00:00:00Z"},
+		},
+		MintSnapshotState: &types.SnapshotState{Timestamp: "2024-01-01T00:00:00Z", Hash: hash[:], Height: 50},
+		LastBlockTime:     time.Date(2024, 1, 1, 0, 0, 5, 0, time.UTC).UnixNano(),
 
 		// this line is used by starport scaffolding # genesis/test/state
 	}
+	require.NoError(t, genesisState.Validate())
 
 	k, ctx := keepertest.UgdvestingKeeper(t)
 	ugdvesting.InitGenesis(ctx, &k, genesisState)
@@ -25,5 +53,15 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 
+	require.Equal(t, genesisState.Params, got.Params)
+	require.ElementsMatch(t, genesisState.PendingVestings, got.PendingVestings)
+	require.ElementsMatch(t, genesisState.ProcessedVestings, got.ProcessedVestings)
+	require.Equal(t, genesisState.SnapshotState, got.SnapshotState)
+	require.Equal(t, genesisState.Amendments, got.Amendments)
+	require.Equal(t, genesisState.Mints, got.Mints)
+	require.Equal(t, genesisState.MintSnapshotState, got.MintSnapshotState)
+	require.Equal(t, genesisState.LastBlockTime, got.LastBlockTime)
+	// the minted total is recomputed from the records
+	require.Equal(t, math.NewInt(300), k.GetMintedTotal(ctx))
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -0,0 +1,26 @@
+package source
+
+import (
+	"context"
+	"os"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+var _ types.VestingSource = FileSource{}
+
+// FileSource reads the vesting-storage document from a JSON file, so a local
+// chain can run from a static vesting schedule.
+type FileSource struct {
+	path string
+}
+
+// NewFileSource returns a source reading the file at path on every fetch.
+func NewFileSource(path string) FileSource {
+	return FileSource{path: path}
+}
+
+// FetchVestingSnapshot implements types.VestingSource.
+func (s FileSource) FetchVestingSnapshot(_ context.Context, _ string) ([]byte, error) {
+	return os.ReadFile(s.path)
+}
@@ -0,0 +1,126 @@
+package source
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/hedgehog"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+// DefaultPollInterval is the time between two background fetches of an
+// HTTPSource.
+const DefaultPollInterval = 5 * time.Second
+
+var (
+	_ types.VestingSource = (*HTTPSource)(nil)
+	_ types.MintSource    = (*HTTPSource)(nil)
+)
+
+// HTTPSource serves the vesting-storage and mint-storage documents of a
+// hedgehog node. Each document is fetched on a background goroutine started
+// by the first call to its fetch method, which therefore never waits for
+// hedgehog and returns nil until the first fetch completed. Polling restarts
+// on the new endpoint when the path passed to a fetch method changes.
+type HTTPSource struct {
+	baseURL  string
+	interval time.Duration
+
+	mu      sync.Mutex
+	vesting *pathPoller
+	mint    *pathPoller
+	closed  bool
+}
+
+// pathPoller is a poller and the endpoint path it polls.
+type pathPoller struct {
+	path   string
+	poller *hedgehog.Poller
+}
+
+// NewHTTPSource returns a source polling the hedgehog node at baseURL. An
+// empty baseURL reads hedgehog.hedgehog_url from the node configuration when
+// polling starts.
+func NewHTTPSource(baseURL string) *HTTPSource {
+	return &HTTPSource{baseURL: baseURL, interval: DefaultPollInterval}
+}
+
+// NewHTTPSourceWithInterval returns a source polling the hedgehog node at
+// baseURL every interval, which must be positive.
+func NewHTTPSourceWithInterval(baseURL string, interval time.Duration) (*HTTPSource, error) {
+	if err := hedgehog.ValidatePollInterval(interval); err != nil {
+		return nil, err
+	}
+	return &HTTPSource{baseURL: baseURL, interval: interval}, nil
+}
+
+// FetchVestingSnapshot implements types.VestingSource. An empty path polls
+// types.VestingStoragePath.
+func (s *HTTPSource) FetchVestingSnapshot(_ context.Context, path string) ([]byte, error) {
+	if path == "" {
+		path = types.VestingStoragePath
+	}
+	return s.latest(&s.vesting, path, func(base string) (*hedgehog.Poller, error) {
+		config := hedgehog.DefaultConfig()
+		config.Path = path
+		return hedgehog.NewPoller(hedgehog.NewClientWithConfig(base, config), s.interval)
+	})
+}
+
+// FetchMintSnapshot implements types.MintSource. An empty path polls
+// types.MintStoragePath.
+func (s *HTTPSource) FetchMintSnapshot(_ context.Context, path string) ([]byte, error) {
+	if path == "" {
+		path = types.MintStoragePath
+	}
+	return s.latest(&s.mint, path, func(base string) (*hedgehog.Poller, error) {
+		config := hedgehog.DefaultConfig()
+		config.MintPath = path
+		return hedgehog.NewMintPoller(hedgehog.NewClientWithConfig(base, config), s.interval)
+	})
+}
+
+// latest returns the last document of the poller in slot, first (re)starting
+// it with newPoller when it does not poll path.
+func (s *HTTPSource) latest(slot **pathPoller, path string, newPoller func(base string) (*hedgehog.Poller, error)) ([]byte, error) {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil, nil
+	}
+	if *slot == nil || (*slot).path != path {
+		base := s.baseURL
+		if base == "" {
+			base = viper.GetString("hedgehog.hedgehog_url")
+		}
+		poller, err := newPoller(base)
+		if err != nil {
+			s.mu.Unlock()
+			return nil, err
+		}
+		if *slot != nil {
+			(*slot).poller.Stop()
+		}
+		poller.Start()
+		*slot = &pathPoller{path: path, poller: poller}
+	}
+	poller := (*slot).poller
+	s.mu.Unlock()
+
+	return poller.Latest()
+}
+
+// Close stops background polling.
+func (s *HTTPSource) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	for _, p := range []*pathPoller{s.vesting, s.mint} {
+		if p != nil {
+			p.poller.Stop()
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package source
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+var (
+	_ types.VestingSource = (*MemorySource)(nil)
+	_ types.MintSource    = (*MemorySource)(nil)
+)
+
+// MemorySource serves vesting-storage and mint-storage documents held in
+// memory, for tests.
+type MemorySource struct {
+	mu       sync.Mutex
+	snapshot []byte
+	mint     []byte
+	err      error
+}
+
+// NewMemorySource returns a source serving snapshot.
+func NewMemorySource(snapshot []byte) *MemorySource {
+	return &MemorySource{snapshot: bytes.Clone(snapshot)}
+}
+
+// Set replaces the served document and clears the error set by SetError.
+func (s *MemorySource) Set(snapshot []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.snapshot = bytes.Clone(snapshot)
+	s.err = nil
+}
+
+// SetMint replaces the served mint-storage document.
+func (s *MemorySource) SetMint(snapshot []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.mint = bytes.Clone(snapshot)
+}
+
+// SetError makes fetches fail with err until the next Set.
+func (s *MemorySource) SetError(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+// FetchVestingSnapshot implements types.VestingSource.
+func (s *MemorySource) FetchVestingSnapshot(_ context.Context, _ string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return nil, s.err
+	}
+	return bytes.Clone(s.snapshot), nil
+}
+
+// FetchMintSnapshot implements types.MintSource.
+func (s *MemorySource) FetchMintSnapshot(_ context.Context, _ string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return nil, s.err
+	}
+	return bytes.Clone(s.mint), nil
+}
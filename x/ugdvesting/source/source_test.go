@@ -0,0 +1,106 @@
+package source_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/source"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+const (
+	snapshot      = `{"timestamp":"2024-01-01T00:00:00Z","data":{"vestingAddresses":{}}}`
+	movedSnapshot = `{"timestamp":"2024-01-02T00:00:00Z","data":{"vestingAddresses":{}}}`
+	mintSnapshot  = `{"timestamp":"2024-01-01T00:00:00Z","data":{"mints":{}}}`
+)
+
+func TestHTTPSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case types.VestingStoragePath:
+			_, _ = w.Write([]byte(snapshot))
+		case "/v2/vesting-storage":
+			_, _ = w.Write([]byte(movedSnapshot))
+		case types.MintStoragePath:
+			_, _ = w.Write([]byte(mintSnapshot))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	src, err := source.NewHTTPSourceWithInterval(server.URL+"/", time.Millisecond)
+	require.NoError(t, err)
+	defer src.Close()
+
+	// fetching happens in the background, the first call does not wait for it
+	require.Eventually(t, func() bool {
+		got, err := src.FetchVestingSnapshot(context.Background(), "")
+		return err == nil && string(got) == snapshot
+	}, time.Second, time.Millisecond)
+
+	// a new endpoint path restarts polling
+	require.Eventually(t, func() bool {
+		got, err := src.FetchVestingSnapshot(context.Background(), "/v2/vesting-storage")
+		return err == nil && string(got) == movedSnapshot
+	}, time.Second, time.Millisecond)
+
+	// the mint snapshot is polled alongside, without disturbing the vesting one
+	require.Eventually(t, func() bool {
+		got, err := src.FetchMintSnapshot(context.Background(), "")
+		return err == nil && string(got) == mintSnapshot
+	}, time.Second, time.Millisecond)
+	got, err := src.FetchVestingSnapshot(context.Background(), "/v2/vesting-storage")
+	require.NoError(t, err)
+	require.Equal(t, movedSnapshot, string(got))
+}
+
+func TestHTTPSourceRejectsNonPositiveInterval(t *testing.T) {
+	_, err := source.NewHTTPSourceWithInterval("http://localhost", 0)
+	require.ErrorContains(t, err, "poll interval must be positive")
+	_, err = source.NewHTTPSourceWithInterval("http://localhost", -time.Second)
+	require.ErrorContains(t, err, "poll interval must be positive")
+}
+
+func TestFileSource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "vesting.json")
+	require.NoError(t, os.WriteFile(path, []byte(snapshot), 0o600))
+
+	got, err := source.NewFileSource(path).FetchVestingSnapshot(context.Background(), "")
+	require.NoError(t, err)
+	require.Equal(t, snapshot, string(got))
+
+	_, err = source.NewFileSource(filepath.Join(t.TempDir(), "missing.json")).FetchVestingSnapshot(context.Background(), "")
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestMemorySource(t *testing.T) {
+	src := source.NewMemorySource(nil)
+
+	got, err := src.FetchVestingSnapshot(context.Background(), "")
+	require.NoError(t, err)
+	require.Empty(t, got)
+
+	src.Set([]byte(snapshot))
+	got, err = src.FetchVestingSnapshot(context.Background(), "")
+	require.NoError(t, err)
+	require.Equal(t, snapshot, string(got))
+
+	src.SetMint([]byte(mintSnapshot))
+	got, err = src.FetchMintSnapshot(context.Background(), "")
+	require.NoError(t, err)
+	require.Equal(t, mintSnapshot, string(got))
+
+	fail := errors.New("unavailable")
+	src.SetError(fail)
+	_, err = src.FetchVestingSnapshot(context.Background(), "")
+	require.ErrorIs(t, err, fail)
+}
@@ -0,0 +1,1119 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ugdvesting/ugdvesting/abci.proto
+
+package types
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	types "github.com/cometbft/cometbft/abci/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// VestingVoteExtension is attached by validators to their precommits and
+// carries the hashes of the hedgehog snapshots they fetched. The documents
+// themselves are only included once, by the proposer.
+type VestingVoteExtension struct {
+	// snapshot identifies the vesting-storage document, unset when the
+	// validator did not fetch one at this height.
+	Snapshot *SnapshotVote `protobuf:"bytes,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// mint_snapshot identifies the mint-storage document, unset when the
+	// validator did not fetch one at this height.
+	MintSnapshot *SnapshotVote `protobuf:"bytes,4,opt,name=mint_snapshot,json=mintSnapshot,proto3" json:"mint_snapshot,omitempty"`
+}
+
+func (m *VestingVoteExtension) Reset()         { *m = VestingVoteExtension{} }
+func (m *VestingVoteExtension) String() string { return proto.CompactTextString(m) }
+func (*VestingVoteExtension) ProtoMessage()    {}
+func (*VestingVoteExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc5ab13ef3c071c1, []int{0}
+}
+func (m *VestingVoteExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingVoteExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingVoteExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingVoteExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingVoteExtension.Merge(m, src)
+}
+func (m *VestingVoteExtension) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingVoteExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingVoteExtension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingVoteExtension proto.InternalMessageInfo
+
+func (m *VestingVoteExtension) GetSnapshot() *SnapshotVote {
+	if m != nil {
+		return m.Snapshot
+	}
+	return nil
+}
+
+func (m *VestingVoteExtension) GetMintSnapshot() *SnapshotVote {
+	if m != nil {
+		return m.MintSnapshot
+	}
+	return nil
+}
+
+// SnapshotVote identifies a snapshot document validators vote on.
+type SnapshotVote struct {
+	// hash is the SHA-256 hash of the raw document.
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// length is the size of the raw document in bytes, at most
+	// Params.max_snapshot_bytes.
+	Length uint64 `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+}
+
+func (m *SnapshotVote) Reset()         { *m = SnapshotVote{} }
+func (m *SnapshotVote) String() string { return proto.CompactTextString(m) }
+func (*SnapshotVote) ProtoMessage()    {}
+func (*SnapshotVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc5ab13ef3c071c1, []int{1}
+}
+func (m *SnapshotVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotVote.Merge(m, src)
+}
+func (m *SnapshotVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotVote proto.InternalMessageInfo
+
+func (m *SnapshotVote) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *SnapshotVote) GetLength() uint64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+// InjectedVestingSnapshot is placed by the proposer as the first transaction
+// of a block. It carries the extended commit of the previous height, and the
+// snapshot documents whose hashes a supermajority of its vote extensions
+// agreed on.
+type InjectedVestingSnapshot struct {
+	// snapshot is the agreed vesting-storage document, empty when no snapshot
+	// reached a supermajority.
+	Snapshot []byte `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// extended_commit_info is the extended commit of the previous height.
+	ExtendedCommitInfo types.ExtendedCommitInfo `protobuf:"bytes,2,opt,name=extended_commit_info,json=extendedCommitInfo,proto3" json:"extended_commit_info"`
+	// mint_snapshot is the agreed mint-storage document, empty when no mint
+	// snapshot reached a supermajority.
+	MintSnapshot []byte `protobuf:"bytes,3,opt,name=mint_snapshot,json=mintSnapshot,proto3" json:"mint_snapshot,omitempty"`
+}
+
+func (m *InjectedVestingSnapshot) Reset()         { *m = InjectedVestingSnapshot{} }
+func (m *InjectedVestingSnapshot) String() string { return proto.CompactTextString(m) }
+func (*InjectedVestingSnapshot) ProtoMessage()    {}
+func (*InjectedVestingSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc5ab13ef3c071c1, []int{2}
+}
+func (m *InjectedVestingSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InjectedVestingSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InjectedVestingSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InjectedVestingSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InjectedVestingSnapshot.Merge(m, src)
+}
+func (m *InjectedVestingSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *InjectedVestingSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_InjectedVestingSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InjectedVestingSnapshot proto.InternalMessageInfo
+
+func (m *InjectedVestingSnapshot) GetSnapshot() []byte {
+	if m != nil {
+		return m.Snapshot
+	}
+	return nil
+}
+
+func (m *InjectedVestingSnapshot) GetExtendedCommitInfo() types.ExtendedCommitInfo {
+	if m != nil {
+		return m.ExtendedCommitInfo
+	}
+	return types.ExtendedCommitInfo{}
+}
+
+func (m *InjectedVestingSnapshot) GetMintSnapshot() []byte {
+	if m != nil {
+		return m.MintSnapshot
+	}
+	return nil
+}
+
+// DeferredEvents are the events of the snapshots applied in the PreBlocker,
+// which baseapp does not record. The module's BeginBlock of the same block
+// emits and deletes them.
+type DeferredEvents struct {
+	Events []types.Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events"`
+}
+
+func (m *DeferredEvents) Reset()         { *m = DeferredEvents{} }
+func (m *DeferredEvents) String() string { return proto.CompactTextString(m) }
+func (*DeferredEvents) ProtoMessage()    {}
+func (*DeferredEvents) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc5ab13ef3c071c1, []int{3}
+}
+func (m *DeferredEvents) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeferredEvents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeferredEvents.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeferredEvents) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeferredEvents.Merge(m, src)
+}
+func (m *DeferredEvents) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeferredEvents) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeferredEvents.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeferredEvents proto.InternalMessageInfo
+
+func (m *DeferredEvents) GetEvents() []types.Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*VestingVoteExtension)(nil), "ugdvesting.ugdvesting.VestingVoteExtension")
+	proto.RegisterType((*SnapshotVote)(nil), "ugdvesting.ugdvesting.SnapshotVote")
+	proto.RegisterType((*InjectedVestingSnapshot)(nil), "ugdvesting.ugdvesting.InjectedVestingSnapshot")
+	proto.RegisterType((*DeferredEvents)(nil), "ugdvesting.ugdvesting.DeferredEvents")
+}
+
+func init() { proto.RegisterFile("ugdvesting/ugdvesting/abci.proto", fileDescriptor_dc5ab13ef3c071c1) }
+
+var fileDescriptor_dc5ab13ef3c071c1 = []byte{
+	// 424 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xb1, 0x8e, 0xd3, 0x40,
+	0x10, 0xf5, 0xda, 0x56, 0x14, 0xed, 0x05, 0x74, 0x5a, 0x85, 0xc3, 0x0a, 0x92, 0xcf, 0xf2, 0x35,
+	0x6e, 0x62, 0x4b, 0x07, 0x15, 0x0d, 0xe8, 0x20, 0x88, 0x3b, 0x89, 0xc6, 0x48, 0x57, 0x40, 0x11,
+	0x25, 0xf6, 0x64, 0xbd, 0x08, 0xef, 0x5a, 0xde, 0xcd, 0x29, 0xfc, 0x05, 0x9f, 0xc0, 0x0f, 0x50,
+	0xf3, 0x0b, 0x57, 0x5e, 0x49, 0x85, 0x50, 0xd2, 0xf0, 0x19, 0xc8, 0x6b, 0x3b, 0x36, 0x84, 0x82,
+	0xee, 0x79, 0xe6, 0xcd, 0x1b, 0xcf, 0xdb, 0x87, 0xbd, 0x35, 0x4d, 0x6f, 0x40, 0x2a, 0xc6, 0x69,
+	0xd4, 0x83, 0x8b, 0x65, 0xc2, 0xc2, 0xa2, 0x14, 0x4a, 0x90, 0x07, 0x5d, 0x39, 0xec, 0xe0, 0x64,
+	0x4c, 0x05, 0x15, 0x9a, 0x11, 0x55, 0xa8, 0x26, 0x4f, 0x1e, 0x29, 0xe0, 0x29, 0x94, 0x39, 0xe3,
+	0x4a, 0x6b, 0x44, 0xea, 0x53, 0x01, 0xb2, 0x6e, 0xfa, 0x5f, 0x11, 0x1e, 0x5f, 0xd7, 0xe3, 0xd7,
+	0x42, 0xc1, 0x6c, 0xa3, 0x80, 0x4b, 0x26, 0x38, 0x79, 0x86, 0x87, 0x92, 0x2f, 0x0a, 0x99, 0x09,
+	0xe5, 0x58, 0x1e, 0x0a, 0x8e, 0xce, 0xcf, 0xc2, 0x7f, 0x6e, 0x0d, 0xdf, 0x36, 0xb4, 0x6a, 0x3e,
+	0xde, 0x0f, 0x91, 0xd7, 0xf8, 0x5e, 0xb5, 0x72, 0xbe, 0x57, 0xb1, 0xff, 0x5f, 0x65, 0x54, 0x4d,
+	0xb6, 0x95, 0x2b, 0x7b, 0x88, 0x8e, 0xcd, 0x2b, 0x7b, 0x68, 0x1e, 0x5b, 0xfe, 0x73, 0x3c, 0xea,
+	0x33, 0x09, 0xc1, 0x76, 0xb6, 0x90, 0x99, 0x83, 0x3c, 0x14, 0x8c, 0x62, 0x8d, 0xc9, 0x09, 0x1e,
+	0x7c, 0x04, 0x4e, 0x55, 0xe6, 0x98, 0x1e, 0x0a, 0xec, 0xb8, 0xf9, 0x7a, 0x6a, 0xff, 0xfa, 0x72,
+	0x8a, 0xfc, 0x6f, 0x08, 0x3f, 0xbc, 0xe4, 0x1f, 0x20, 0x51, 0x90, 0x36, 0x97, 0xb7, 0x8a, 0x64,
+	0xd2, 0x3b, 0xba, 0x56, 0xec, 0xee, 0x79, 0x8f, 0xc7, 0xb0, 0xd1, 0x56, 0xa6, 0xf3, 0x44, 0xe4,
+	0x39, 0x53, 0x73, 0xc6, 0x57, 0xc2, 0x31, 0x9b, 0xb3, 0x3a, 0x97, 0x43, 0xfd, 0x52, 0xb3, 0x86,
+	0xfc, 0x42, 0x73, 0x2f, 0xf9, 0x4a, 0x5c, 0xd8, 0xb7, 0x3f, 0x4e, 0x8d, 0x98, 0xc0, 0x41, 0x87,
+	0x9c, 0xfd, 0x6d, 0x96, 0xa5, 0xb7, 0xff, 0xe1, 0x83, 0xff, 0x0a, 0xdf, 0x7f, 0x09, 0x2b, 0x28,
+	0x4b, 0x48, 0x67, 0x37, 0xc0, 0x95, 0x24, 0x4f, 0xf0, 0x00, 0x34, 0x72, 0x90, 0x67, 0x05, 0x47,
+	0xe7, 0x27, 0x87, 0x7f, 0x51, 0xb5, 0x9b, 0xc5, 0x0d, 0xf7, 0x82, 0xde, 0x6e, 0x5d, 0x74, 0xb7,
+	0x75, 0xd1, 0xcf, 0xad, 0x8b, 0x3e, 0xef, 0x5c, 0xe3, 0x6e, 0xe7, 0x1a, 0xdf, 0x77, 0xae, 0xf1,
+	0xee, 0x0d, 0x65, 0x2a, 0x5b, 0x2f, 0xc3, 0x44, 0xe4, 0xd1, 0x9a, 0x33, 0x5a, 0xb2, 0x74, 0x5a,
+	0x94, 0xa2, 0x32, 0x2b, 0x4a, 0x84, 0xcc, 0x85, 0x9c, 0xb6, 0xe5, 0x0c, 0x52, 0x0a, 0x99, 0xa0,
+	0xd3, 0x36, 0x9e, 0x9b, 0x7e, 0x56, 0x75, 0xc4, 0x96, 0x03, 0x9d, 0xb1, 0xc7, 0xbf, 0x07, 0x00,
+	0x5b, 0x64, 0x18, 0xde, 0xd1, 0x02, 0x00, 0x00,
+}
+
+func (this *SnapshotVote) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SnapshotVote)
+	if !ok {
+		that2, ok := that.(SnapshotVote)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Hash, that1.Hash) {
+		return false
+	}
+	if this.Length != that1.Length {
+		return false
+	}
+	return true
+}
+func (m *VestingVoteExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingVoteExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MintSnapshot != nil {
+		{
+			size, err := m.MintSnapshot.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAbci(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Snapshot != nil {
+		{
+			size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAbci(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Length != 0 {
+		i = encodeVarintAbci(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintAbci(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InjectedVestingSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InjectedVestingSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InjectedVestingSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MintSnapshot) > 0 {
+		i -= len(m.MintSnapshot)
+		copy(dAtA[i:], m.MintSnapshot)
+		i = encodeVarintAbci(dAtA, i, uint64(len(m.MintSnapshot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.ExtendedCommitInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAbci(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Snapshot) > 0 {
+		i -= len(m.Snapshot)
+		copy(dAtA[i:], m.Snapshot)
+		i = encodeVarintAbci(dAtA, i, uint64(len(m.Snapshot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeferredEvents) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeferredEvents) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeferredEvents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAbci(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAbci(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAbci(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *VestingVoteExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Snapshot != nil {
+		l = m.Snapshot.Size()
+		n += 1 + l + sovAbci(uint64(l))
+	}
+	if m.MintSnapshot != nil {
+		l = m.MintSnapshot.Size()
+		n += 1 + l + sovAbci(uint64(l))
+	}
+	return n
+}
+
+func (m *SnapshotVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovAbci(uint64(l))
+	}
+	if m.Length != 0 {
+		n += 1 + sovAbci(uint64(m.Length))
+	}
+	return n
+}
+
+func (m *InjectedVestingSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Snapshot)
+	if l > 0 {
+		n += 1 + l + sovAbci(uint64(l))
+	}
+	l = m.ExtendedCommitInfo.Size()
+	n += 1 + l + sovAbci(uint64(l))
+	l = len(m.MintSnapshot)
+	if l > 0 {
+		n += 1 + l + sovAbci(uint64(l))
+	}
+	return n
+}
+
+func (m *DeferredEvents) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovAbci(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAbci(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAbci(x uint64) (n int) {
+	return sovAbci(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *VestingVoteExtension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAbci
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingVoteExtension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingVoteExtension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Snapshot == nil {
+				m.Snapshot = &SnapshotVote{}
+			}
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintSnapshot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MintSnapshot == nil {
+				m.MintSnapshot = &SnapshotVote{}
+			}
+			if err := m.MintSnapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAbci(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAbci
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAbci(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InjectedVestingSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAbci
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InjectedVestingSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InjectedVestingSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Snapshot = append(m.Snapshot[:0], dAtA[iNdEx:postIndex]...)
+			if m.Snapshot == nil {
+				m.Snapshot = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedCommitInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExtendedCommitInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintSnapshot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintSnapshot = append(m.MintSnapshot[:0], dAtA[iNdEx:postIndex]...)
+			if m.MintSnapshot == nil {
+				m.MintSnapshot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAbci(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeferredEvents) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAbci
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeferredEvents: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeferredEvents: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, types.Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAbci(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAbci(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAbci
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAbci
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAbci
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAbci
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAbci        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAbci          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAbci = fmt.Errorf("proto: unexpected end of group")
+)
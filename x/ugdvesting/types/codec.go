@@ -12,6 +12,13 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgSubmitVestingBatch{},
+		&MsgAddHedgehogKey{},
+		&MsgRotateHedgehogKey{},
+		&MsgRetireHedgehogKey{},
+		&MsgCreateVestingSchedule{},
+		&MsgClawbackVesting{},
+		&MsgAmendPendingVesting{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
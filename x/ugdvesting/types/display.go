@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/math"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// FormatAmount formats an amount of base units in a unit of exponent
+// decimals, exactly and with all of its decimals.
+func FormatAmount(amount math.Int, exponent uint32) (string, error) {
+	if exponent > math.LegacyPrecision {
+		return "", fmt.Errorf("exponent %d exceeds the %d decimals of an amount", exponent, math.LegacyPrecision)
+	}
+	if exponent == 0 {
+		return amount.String(), nil
+	}
+
+	// the decimal always prints LegacyPrecision decimals, the ones past
+	// exponent are zero
+	s := math.LegacyNewDecFromIntWithPrec(amount, int64(exponent)).String()
+	return s[:len(s)-int(math.LegacyPrecision-exponent)], nil
+}
+
+// DisplayUnit returns the display denom of a bank metadata and its exponent.
+func DisplayUnit(metadata banktypes.Metadata) (string, uint32, error) {
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == metadata.Display || slices.Contains(unit.Aliases, metadata.Display) {
+			return metadata.Display, unit.Exponent, nil
+		}
+	}
+	return "", 0, fmt.Errorf("no denom unit for display denom %q of %s", metadata.Display, metadata.Base)
+}
+
+// DisplayAmount returns amount of the base denom of metadata in its display
+// unit.
+func DisplayAmount(amount math.Int, metadata banktypes.Metadata) (DisplayCoin, error) {
+	denom, exponent, err := DisplayUnit(metadata)
+	if err != nil {
+		return DisplayCoin{}, err
+	}
+	formatted, err := FormatAmount(amount, exponent)
+	if err != nil {
+		return DisplayCoin{}, err
+	}
+	return DisplayCoin{Denom: denom, Amount: formatted}, nil
+}
@@ -0,0 +1,67 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+func TestFormatAmount(t *testing.T) {
+	var large big.Int
+	large.SetString("7000707070707070707070", 10)
+
+	tests := []struct {
+		name     string
+		amount   sdkmath.Int
+		exponent uint32
+		expected string
+	}{
+		{name: "18 decimals", amount: sdkmath.NewIntFromBigInt(&large), exponent: 18, expected: "7000.707070707070707070"},
+		{name: "6 decimals", amount: sdkmath.NewInt(1500000), exponent: 6, expected: "1.500000"},
+		{name: "below one", amount: sdkmath.NewInt(5), exponent: 8, expected: "0.00000005"},
+		{name: "negative", amount: sdkmath.NewInt(-1500000), exponent: 6, expected: "-1.500000"},
+		{name: "base unit", amount: sdkmath.NewInt(1500000), expected: "1500000"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			formatted, err := types.FormatAmount(tc.amount, tc.exponent)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, formatted)
+		})
+	}
+
+	_, err := types.FormatAmount(sdkmath.NewInt(1), 19)
+	require.Error(t, err)
+}
+
+func TestDisplayAmount(t *testing.T) {
+	metadata := banktypes.Metadata{
+		Base:    "uugd",
+		Display: "ugd",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "uugd"},
+			{Denom: "mugd", Exponent: 3},
+			{Denom: "ugd", Exponent: 8},
+		},
+	}
+
+	coin, err := types.DisplayAmount(sdkmath.NewInt(123456789), metadata)
+	require.NoError(t, err)
+	require.Equal(t, types.DisplayCoin{Denom: "ugd", Amount: "1.23456789"}, coin)
+
+	metadata.Display = "UGD"
+	metadata.DenomUnits[2].Aliases = []string{"UGD"}
+	coin, err = types.DisplayAmount(sdkmath.NewInt(123456789), metadata)
+	require.NoError(t, err)
+	require.Equal(t, types.DisplayCoin{Denom: "UGD", Amount: "1.23456789"}, coin)
+
+	metadata.Display = "gugd"
+	_, err = types.DisplayAmount(sdkmath.NewInt(1), metadata)
+	require.Error(t, err)
+}
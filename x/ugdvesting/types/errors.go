@@ -10,4 +10,21 @@ import (
 var (
 	ErrInvalidSigner = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
 	ErrSample        = sdkerrors.Register(ModuleName, 1101, "sample error")
+
+	ErrInvalidVestingData  = sdkerrors.Register(ModuleName, 1102, "invalid vesting data")
+	ErrUnauthorizedRelayer = sdkerrors.Register(ModuleName, 1103, "relayer is not authorized")
+	ErrInvalidSignature    = sdkerrors.Register(ModuleName, 1104, "invalid snapshot signature")
+	ErrInvalidHedgehogKey  = sdkerrors.Register(ModuleName, 1105, "invalid hedgehog key")
+	ErrUnknownHedgehogKey  = sdkerrors.Register(ModuleName, 1106, "unknown hedgehog key")
+	ErrSnapshotRejected    = sdkerrors.Register(ModuleName, 1107, "vesting snapshot rejected")
+	ErrConversionFailed    = sdkerrors.Register(ModuleName, 1108, "account cannot be converted to a vesting account")
+	ErrInvalidSchedule     = sdkerrors.Register(ModuleName, 1109, "invalid vesting schedule")
+	ErrInsufficientBalance = sdkerrors.Register(ModuleName, 1110, "balance does not cover the vesting amount")
+	ErrIngestionInactive   = sdkerrors.Register(ModuleName, 1111, "vesting ingestion is not active")
+	ErrClawbackFailed      = sdkerrors.Register(ModuleName, 1112, "vesting cannot be clawed back")
+	ErrUnauthorizedAmender = sdkerrors.Register(ModuleName, 1113, "signer is not allowed to amend vesting records")
+	ErrNotPending          = sdkerrors.Register(ModuleName, 1114, "vesting record is not pending")
+	ErrMintFailed          = sdkerrors.Register(ModuleName, 1115, "mint cannot be executed")
+	ErrFundsLocked         = sdkerrors.Register(ModuleName, 1116, "funds are locked until the pending vesting schedule is processed")
+	ErrRecordChanged       = sdkerrors.Register(ModuleName, 1117, "vesting record changed since the amendment was prepared")
 )
@@ -0,0 +1,27 @@
+package types
+
+// Kinds of hedgehog snapshots, reported by EventSnapshotAccepted and
+// EventSnapshotRejected.
+const (
+	SnapshotKindVesting = "vesting"
+	SnapshotKindMint    = "mint"
+)
+
+// Reasons a snapshot is rejected, reported by EventSnapshotRejected.
+const (
+	SnapshotRejectedInvalidSignature = "invalid_signature"
+	SnapshotRejectedInvalidTimestamp = "invalid_timestamp"
+	SnapshotRejectedReplayed         = "replayed"
+	SnapshotRejectedStale            = "stale"
+	SnapshotRejectedUnchained        = "unchained"
+)
+
+// Reasons a mint is rejected, reported by EventMintRejected.
+const (
+	MintRejectedInvalidAddress = "invalid_address"
+	MintRejectedInvalidAmount  = "invalid_amount"
+	MintRejectedMintCap        = "mint_cap"
+	MintRejectedBlockCap       = "block_cap"
+	MintRejectedTotalCap       = "total_cap"
+	MintRejectedFailed         = "failed"
+)
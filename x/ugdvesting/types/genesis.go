@@ -1,7 +1,12 @@
 package types
 
 import (
-// this line is used by starport scaffolding # genesis/types/import
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	// this line is used by starport scaffolding # genesis/types/import
 )
 
 // DefaultIndex is the default global index
@@ -18,7 +23,131 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	seen := make(map[string]struct{}, len(gs.PendingVestings)+len(gs.ProcessedVestings))
+	checkRecord := func(data VestingData, processed bool) error {
+		if err := data.Validate(); err != nil {
+			return err
+		}
+		if data.Processed != processed {
+			return fmt.Errorf("vesting record for %s has processed set to %t", data.Address, data.Processed)
+		}
+		// addresses are compared in their canonical form
+		addr := sdk.MustAccAddressFromBech32(data.Address).String()
+		if _, ok := seen[addr]; ok {
+			return fmt.Errorf("duplicate vesting record for %s", data.Address)
+		}
+		seen[addr] = struct{}{}
+		return nil
+	}
+
+	for _, data := range gs.PendingVestings {
+		if err := checkRecord(data, false); err != nil {
+			return fmt.Errorf("invalid pending vesting record: %w", err)
+		}
+	}
+	for _, data := range gs.ProcessedVestings {
+		if err := checkRecord(data, true); err != nil {
+			return fmt.Errorf("invalid processed vesting record: %w", err)
+		}
+	}
+
+	if gs.SnapshotState != nil {
+		if err := gs.SnapshotState.Validate(); err != nil {
+			return err
+		}
+	}
+
+	versions := make(map[string]map[uint64]struct{})
+	for _, amendment := range gs.Amendments {
+		if err := amendment.Validate(); err != nil {
+			return err
+		}
+		addr := sdk.MustAccAddressFromBech32(amendment.Address).String()
+		if versions[addr] == nil {
+			versions[addr] = make(map[uint64]struct{})
+		}
+		if _, ok := versions[addr][amendment.Version]; ok {
+			return fmt.Errorf("duplicate amendment %d for %s", amendment.Version, amendment.Address)
+		}
+		versions[addr][amendment.Version] = struct{}{}
+	}
+
+	keys := make(map[string]struct{}, len(gs.Mints))
+	for _, record := range gs.Mints {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		if _, ok := keys[record.Key]; ok {
+			return fmt.Errorf("duplicate mint %s", record.Key)
+		}
+		keys[record.Key] = struct{}{}
+	}
+
+	if gs.MintSnapshotState != nil {
+		if err := gs.MintSnapshotState.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if gs.LastBlockTime < 0 {
+		return fmt.Errorf("negative last block time %d", gs.LastBlockTime)
+	}
+
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
 }
+
+// Validate checks the metadata of an accepted snapshot.
+func (s SnapshotState) Validate() error {
+	if _, err := time.Parse(time.RFC3339Nano, s.Timestamp); err != nil {
+		return fmt.Errorf("invalid snapshot timestamp %q: %w", s.Timestamp, err)
+	}
+	if len(s.Hash) != sha256.Size {
+		return fmt.Errorf("snapshot hash must be %d bytes, got %d", sha256.Size, len(s.Hash))
+	}
+	if s.Height < 0 {
+		return fmt.Errorf("negative snapshot height %d", s.Height)
+	}
+	return nil
+}
+
+// Validate checks the record of an executed mint.
+func (r MintRecord) Validate() error {
+	if r.Key == "" {
+		return fmt.Errorf("mint record without key")
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+		return fmt.Errorf("invalid address %q of mint %s: %w", r.Address, r.Key, err)
+	}
+	if err := r.Amount.Validate(); err != nil || !r.Amount.IsPositive() {
+		return fmt.Errorf("invalid amount %s of mint %s", r.Amount, r.Key)
+	}
+	if r.Height < 0 {
+		return fmt.Errorf("negative height %d of mint %s", r.Height, r.Key)
+	}
+	return nil
+}
+
+// Validate checks an entry of the amendment history.
+func (a VestingAmendment) Validate() error {
+	addr, err := sdk.AccAddressFromBech32(a.Address)
+	if err != nil {
+		return fmt.Errorf("invalid amendment address %q: %w", a.Address, err)
+	}
+	if a.Version == 0 {
+		return fmt.Errorf("amendment of %s has version 0", a.Address)
+	}
+	if _, err := sdk.AccAddressFromBech32(a.Signer); err != nil {
+		return fmt.Errorf("invalid signer %q of amendment %d of %s: %w", a.Signer, a.Version, a.Address, err)
+	}
+	for _, data := range []VestingData{a.Previous, a.Amended} {
+		if err := data.Validate(); err != nil {
+			return fmt.Errorf("amendment %d of %s: %w", a.Version, a.Address, err)
+		}
+		if !sdk.MustAccAddressFromBech32(data.Address).Equals(addr) {
+			return fmt.Errorf("amendment %d of %s holds a record of %s", a.Version, a.Address, data.Address)
+		}
+	}
+	return nil
+}
@@ -26,6 +26,26 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the ugdvesting module's genesis state.
 type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// pending_vestings are the vesting records waiting for their block,
+	// including failed ones waiting for their retry.
+	PendingVestings []VestingData `protobuf:"bytes,2,rep,name=pending_vestings,json=pendingVestings,proto3" json:"pending_vestings"`
+	// processed_vestings are the vesting records that were converted, their
+	// addresses are never vested again.
+	ProcessedVestings []VestingData `protobuf:"bytes,3,rep,name=processed_vestings,json=processedVestings,proto3" json:"processed_vestings"`
+	// snapshot_state is the last vesting snapshot accepted, unset when none
+	// was accepted yet.
+	SnapshotState *SnapshotState `protobuf:"bytes,4,opt,name=snapshot_state,json=snapshotState,proto3" json:"snapshot_state,omitempty"`
+	// amendments is the amendment history of the vesting records.
+	Amendments []VestingAmendment `protobuf:"bytes,5,rep,name=amendments,proto3" json:"amendments"`
+	// mints are the hedgehog mints the module executed.
+	Mints []MintRecord `protobuf:"bytes,6,rep,name=mints,proto3" json:"mints"`
+	// mint_snapshot_state is the last mint snapshot accepted, unset when none
+	// was accepted yet.
+	MintSnapshotState *SnapshotState `protobuf:"bytes,7,opt,name=mint_snapshot_state,json=mintSnapshotState,proto3" json:"mint_snapshot_state,omitempty"`
+	// last_block_time is the time of the last block, in Unix nanoseconds,
+	// recorded while hedgehog is polled on a time interval. Zero when it was
+	// not recorded.
+	LastBlockTime int64 `protobuf:"varint,8,opt,name=last_block_time,json=lastBlockTime,proto3" json:"last_block_time,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,6 +88,55 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetPendingVestings() []VestingData {
+	if m != nil {
+		return m.PendingVestings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProcessedVestings() []VestingData {
+	if m != nil {
+		return m.ProcessedVestings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSnapshotState() *SnapshotState {
+	if m != nil {
+		return m.SnapshotState
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAmendments() []VestingAmendment {
+	if m != nil {
+		return m.Amendments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMints() []MintRecord {
+	if m != nil {
+		return m.Mints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintSnapshotState() *SnapshotState {
+	if m != nil {
+		return m.MintSnapshotState
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastBlockTime() int64 {
+	if m != nil {
+		return m.LastBlockTime
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ugdvesting.ugdvesting.GenesisState")
 }
@@ -77,21 +146,34 @@ func init() {
 }
 
 var fileDescriptor_ebfe504462aeaf7a = []byte{
-	// 220 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2e, 0x4d, 0x4f, 0x29,
-	0x4b, 0x2d, 0x2e, 0xc9, 0xcc, 0x4b, 0xd7, 0x47, 0x62, 0xa6, 0xa7, 0xe6, 0xa5, 0x16, 0x67, 0x16,
-	0xeb, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x89, 0x22, 0x64, 0xf4, 0x10, 0x4c, 0x29, 0x91, 0xf4,
-	0xfc, 0xf4, 0x7c, 0xb0, 0x0a, 0x7d, 0x10, 0x0b, 0xa2, 0x58, 0x4a, 0x09, 0xbb, 0x89, 0x05, 0x89,
-	0x45, 0x89, 0xb9, 0x50, 0x03, 0x95, 0xbc, 0xb9, 0x78, 0xdc, 0x21, 0x36, 0x04, 0x97, 0x24, 0x96,
-	0xa4, 0x0a, 0x59, 0x73, 0xb1, 0x41, 0xe4, 0x25, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0x64, 0xf5,
-	0xb0, 0xda, 0xa8, 0x17, 0x00, 0x56, 0xe4, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0x8b,
-	0x53, 0xf6, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1,
-	0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x05, 0xa6, 0x67, 0x96,
-	0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x97, 0xe6, 0x65, 0xa6, 0x17, 0x65, 0xa6, 0xe8,
-	0x16, 0x14, 0xe5, 0x67, 0xa5, 0x26, 0x97, 0xe8, 0x27, 0xe7, 0x17, 0xe7, 0xe6, 0x17, 0xeb, 0x16,
-	0xa7, 0x64, 0xeb, 0xc2, 0xa4, 0x32, 0x52, 0x53, 0xd2, 0x53, 0x33, 0xf2, 0xd3, 0x75, 0x61, 0x6e,
-	0xae, 0x40, 0xf6, 0x40, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x03, 0xc6, 0x80, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x49, 0x54, 0x60, 0x11, 0x38, 0x01, 0x00, 0x00,
+	// 424 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x1b, 0xdb, 0xad, 0x32, 0xeb, 0xba, 0xee, 0xa8, 0x10, 0x0a, 0xc6, 0x5a, 0x45, 0x7b,
+	0x69, 0x0a, 0xeb, 0x51, 0x3c, 0x58, 0x04, 0x0f, 0x52, 0x90, 0x74, 0x51, 0xf0, 0x12, 0xa6, 0xc9,
+	0x63, 0x32, 0xba, 0x33, 0x13, 0xf2, 0xa6, 0xa2, 0xdf, 0xc2, 0x8f, 0xb5, 0xc7, 0xc5, 0x93, 0x27,
+	0x91, 0xf6, 0x8b, 0x48, 0x26, 0x93, 0x6d, 0x94, 0x66, 0xa1, 0xa7, 0xbe, 0xbe, 0xf9, 0xcd, 0x2f,
+	0xff, 0x97, 0x3c, 0xf2, 0x64, 0xc5, 0xd3, 0xaf, 0x80, 0x46, 0x28, 0x3e, 0x6d, 0x94, 0x1c, 0x14,
+	0xa0, 0xc0, 0x30, 0x2f, 0xb4, 0xd1, 0xf4, 0xc1, 0xf6, 0x24, 0xdc, 0x96, 0x83, 0xfb, 0x5c, 0x73,
+	0x6d, 0x89, 0x69, 0x59, 0x55, 0xf0, 0x60, 0xb8, 0xdb, 0x28, 0x85, 0x32, 0x8e, 0x18, 0xed, 0x26,
+	0x72, 0x56, 0x30, 0xe9, 0x1e, 0x39, 0x68, 0xc9, 0x55, 0xa7, 0xb0, 0xd0, 0xe8, 0x67, 0x8f, 0xdc,
+	0x7e, 0x5b, 0x25, 0x5d, 0x18, 0x66, 0x80, 0xbe, 0x24, 0xfd, 0xca, 0xe2, 0x7b, 0x43, 0x6f, 0x7c,
+	0x78, 0xfa, 0x30, 0xdc, 0x99, 0x3c, 0x7c, 0x6f, 0xa1, 0x59, 0xef, 0xe2, 0xf7, 0xa3, 0x4e, 0xe4,
+	0xae, 0xd0, 0x05, 0xb9, 0x9b, 0x83, 0x4a, 0x85, 0xe2, 0xb1, 0xe3, 0xd0, 0xbf, 0x31, 0xec, 0x8e,
+	0x0f, 0x4f, 0x47, 0x2d, 0x9a, 0x0f, 0xd5, 0xef, 0x1b, 0x66, 0x98, 0x73, 0x1d, 0x3b, 0x83, 0x3b,
+	0x41, 0xfa, 0x91, 0xd0, 0xbc, 0xd0, 0x09, 0x20, 0x42, 0xba, 0xd5, 0x76, 0xf7, 0xd4, 0x9e, 0x5c,
+	0x39, 0xae, 0xc4, 0xef, 0xc8, 0x1d, 0x54, 0x2c, 0xc7, 0x4c, 0x9b, 0x18, 0xcb, 0xe1, 0xfd, 0x9e,
+	0x1d, 0xf9, 0x69, 0x8b, 0x74, 0xe1, 0x60, 0xfb, 0xa2, 0xa2, 0x23, 0x6c, 0xfe, 0xa5, 0x73, 0x42,
+	0x98, 0x04, 0x95, 0x4a, 0x50, 0x06, 0xfd, 0x03, 0x9b, 0xee, 0xf9, 0xf5, 0xe9, 0x5e, 0xd7, 0xbc,
+	0x8b, 0xd8, 0x10, 0xd0, 0x57, 0xe4, 0xa0, 0xfc, 0xdc, 0xe8, 0xf7, 0xad, 0xe9, 0x71, 0x8b, 0x69,
+	0x2e, 0x94, 0x89, 0x20, 0xd1, 0x45, 0xea, 0x1c, 0xd5, 0x2d, 0x7a, 0x46, 0xee, 0x95, 0x45, 0xfc,
+	0xdf, 0x7c, 0x37, 0xf7, 0x98, 0xef, 0xa4, 0x14, 0xfc, 0xd3, 0xa2, 0xcf, 0xc8, 0xf1, 0x39, 0x43,
+	0x13, 0x2f, 0xcf, 0x75, 0xf2, 0x25, 0x36, 0x42, 0x82, 0x7f, 0x6b, 0xe8, 0x8d, 0xbb, 0xd1, 0x51,
+	0xd9, 0x9e, 0x95, 0xdd, 0x33, 0x21, 0x61, 0xc6, 0x2f, 0xd6, 0x81, 0x77, 0xb9, 0x0e, 0xbc, 0x3f,
+	0xeb, 0xc0, 0xfb, 0xb1, 0x09, 0x3a, 0x97, 0x9b, 0xa0, 0xf3, 0x6b, 0x13, 0x74, 0x3e, 0xcd, 0xb9,
+	0x30, 0xd9, 0x6a, 0x19, 0x26, 0x5a, 0x4e, 0x57, 0x4a, 0xf0, 0x42, 0xa4, 0x93, 0xbc, 0xd0, 0x9f,
+	0x21, 0x31, 0xd3, 0x44, 0xa3, 0xd4, 0x38, 0xa9, 0xdb, 0x19, 0xa4, 0x1c, 0x32, 0xcd, 0x27, 0xf5,
+	0xe2, 0x7e, 0x6b, 0x6e, 0xb1, 0xf9, 0x9e, 0x03, 0x2e, 0xfb, 0x76, 0x89, 0x5f, 0xfc, 0x1d, 0x00,
+	0x3a, 0x25, 0xa7, 0x4f, 0x83, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -114,6 +196,91 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastBlockTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastBlockTime))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MintSnapshotState != nil {
+		{
+			size, err := m.MintSnapshotState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Mints) > 0 {
+		for iNdEx := len(m.Mints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Amendments) > 0 {
+		for iNdEx := len(m.Amendments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amendments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.SnapshotState != nil {
+		{
+			size, err := m.SnapshotState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ProcessedVestings) > 0 {
+		for iNdEx := len(m.ProcessedVestings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProcessedVestings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PendingVestings) > 0 {
+		for iNdEx := len(m.PendingVestings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingVestings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -146,6 +313,41 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PendingVestings) > 0 {
+		for _, e := range m.PendingVestings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProcessedVestings) > 0 {
+		for _, e := range m.ProcessedVestings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.SnapshotState != nil {
+		l = m.SnapshotState.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Amendments) > 0 {
+		for _, e := range m.Amendments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Mints) > 0 {
+		for _, e := range m.Mints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MintSnapshotState != nil {
+		l = m.MintSnapshotState.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.LastBlockTime != 0 {
+		n += 1 + sovGenesis(uint64(m.LastBlockTime))
+	}
 	return n
 }
 
@@ -217,6 +419,233 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingVestings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingVestings = append(m.PendingVestings, VestingData{})
+			if err := m.PendingVestings[len(m.PendingVestings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedVestings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessedVestings = append(m.ProcessedVestings, VestingData{})
+			if err := m.ProcessedVestings[len(m.ProcessedVestings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SnapshotState == nil {
+				m.SnapshotState = &SnapshotState{}
+			}
+			if err := m.SnapshotState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amendments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amendments = append(m.Amendments, VestingAmendment{})
+			if err := m.Amendments[len(m.Amendments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mints = append(m.Mints, MintRecord{})
+			if err := m.Mints[len(m.Mints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintSnapshotState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MintSnapshotState == nil {
+				m.MintSnapshotState = &SnapshotState{}
+			}
+			if err := m.MintSnapshotState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockTime", wireType)
+			}
+			m.LastBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastBlockTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,13 +1,28 @@
 package types_test
 
 import (
+	"crypto/sha256"
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/sample"
 	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	addr := sample.AccAddress()
+	pending := types.VestingData{Address: addr, Amount: math.NewInt(1000), Duration: 3600, Parts: 4, Block: 100}
+	processed := types.VestingData{Address: sample.AccAddress(), Amount: math.NewInt(1000), Duration: 3600, Parts: 4, Block: 10, Processed: true}
+	hash := sha256.Sum256([]byte("snapshot"))
+	snapshot := &types.SnapshotState{Timestamp: "2024-01-01T00:00:00.5Z", Hash: hash[:], Height: 30}
+	with := func(data types.VestingData, modify func(*types.VestingData)) []types.VestingData {
+		modify(&data)
+		return []types.VestingData{data}
+	}
+
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -26,6 +41,150 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "vesting records and snapshot",
+			genState: &types.GenesisState{
+				Params:            types.DefaultParams(),
+				PendingVestings:   []types.VestingData{pending},
+				ProcessedVestings: []types.VestingData{processed},
+				SnapshotState:     snapshot,
+			},
+			valid: true,
+		},
+		{
+			desc:     "invalid address",
+			genState: &types.GenesisState{PendingVestings: with(pending, func(d *types.VestingData) { d.Address = "invalid" })},
+		},
+		{
+			desc:     "invalid duration",
+			genState: &types.GenesisState{PendingVestings: with(pending, func(d *types.VestingData) { d.Duration = 0 })},
+		},
+		{
+			desc:     "invalid parts",
+			genState: &types.GenesisState{ProcessedVestings: with(processed, func(d *types.VestingData) { d.Parts = -1 })},
+		},
+		{
+			desc:     "invalid percent",
+			genState: &types.GenesisState{PendingVestings: with(pending, func(d *types.VestingData) { d.Percent = 101 })},
+		},
+		{
+			desc:     "invalid cliff",
+			genState: &types.GenesisState{PendingVestings: with(pending, func(d *types.VestingData) { d.Cliff = -1 })},
+		},
+		{
+			desc: "amendments",
+			genState: &types.GenesisState{Amendments: []types.VestingAmendment{
+				{Address: addr, Version: 1, Signer: addr, Previous: pending, Amended: pending},
+				{Address: addr, Version: 2, Signer: addr, Previous: pending, Amended: pending},
+			}},
+			valid: true,
+		},
+		{
+			desc: "duplicate amendment version",
+			genState: &types.GenesisState{Amendments: []types.VestingAmendment{
+				{Address: addr, Version: 1, Signer: addr, Previous: pending, Amended: pending},
+				{Address: addr, Version: 1, Signer: addr, Previous: pending, Amended: pending},
+			}},
+		},
+		{
+			desc: "amendment version zero",
+			genState: &types.GenesisState{Amendments: []types.VestingAmendment{
+				{Address: addr, Signer: addr, Previous: pending, Amended: pending},
+			}},
+		},
+		{
+			desc: "amendment of another address",
+			genState: &types.GenesisState{Amendments: []types.VestingAmendment{
+				{Address: addr, Version: 1, Signer: addr, Previous: processed, Amended: pending},
+			}},
+		},
+		{
+			desc:     "invalid funder",
+			genState: &types.GenesisState{PendingVestings: with(pending, func(d *types.VestingData) { d.Funder = "invalid" })},
+		},
+		{
+			desc: "failed record clawed back",
+			genState: &types.GenesisState{PendingVestings: with(pending, func(d *types.VestingData) {
+				d.FailureReason = "failed"
+				d.ClawedBack = true
+			})},
+		},
+		{
+			desc:     "processed record among pending",
+			genState: &types.GenesisState{PendingVestings: []types.VestingData{processed}},
+		},
+		{
+			desc:     "pending record among processed",
+			genState: &types.GenesisState{ProcessedVestings: []types.VestingData{pending}},
+		},
+		{
+			desc:     "processed record with failure reason",
+			genState: &types.GenesisState{ProcessedVestings: with(processed, func(d *types.VestingData) { d.FailureReason = "failed" })},
+		},
+		{
+			desc: "failed record awaiting its retry",
+			genState: &types.GenesisState{
+				PendingVestings: with(pending, func(d *types.VestingData) { d.FailureReason = "failed" }),
+			},
+			valid: true,
+		},
+		{
+			desc:     "duplicate pending record",
+			genState: &types.GenesisState{PendingVestings: []types.VestingData{pending, pending}},
+		},
+		{
+			desc: "address both pending and processed",
+			genState: &types.GenesisState{
+				PendingVestings:   []types.VestingData{pending},
+				ProcessedVestings: with(processed, func(d *types.VestingData) { d.Address = addr }),
+			},
+		},
+		{
+			desc:     "invalid snapshot timestamp",
+			genState: &types.GenesisState{SnapshotState: &types.SnapshotState{Timestamp: "yesterday", Hash: hash[:]}},
+		},
+		{
+			desc:     "invalid snapshot hash",
+			genState: &types.GenesisState{SnapshotState: &types.SnapshotState{Timestamp: snapshot.Timestamp, Hash: hash[:4]}},
+		},
+		{
+			desc:     "negative last block time",
+			genState: &types.GenesisState{LastBlockTime: -1},
+		},
+		{
+			desc: "mints and mint snapshot",
+			genState: &types.GenesisState{
+				Mints: []types.MintRecord{
+					{Key: "Address(wif=" + addr + ")", Address: addr, Amount: sdk.NewInt64Coin("uugd", 100), Height: 30},
+					{Key: "Address(wif=" + addr + ")/2", Address: addr, Amount: sdk.NewInt64Coin("uugd", 200), Height: 40},
+				},
+				MintSnapshotState: snapshot,
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate mint",
+			genState: &types.GenesisState{Mints: []types.MintRecord{
+				{Key: "a", Address: addr, Amount: sdk.NewInt64Coin("uugd", 100)},
+				{Key: "a", Address: addr, Amount: sdk.NewInt64Coin("uugd", 200)},
+			}},
+		},
+		{
+			desc:     "mint without key",
+			genState: &types.GenesisState{Mints: []types.MintRecord{{Address: addr, Amount: sdk.NewInt64Coin("uugd", 100)}}},
+		},
+		{
+			desc:     "mint to an invalid address",
+			genState: &types.GenesisState{Mints: []types.MintRecord{{Key: "a", Address: "invalid", Amount: sdk.NewInt64Coin("uugd", 100)}}},
+		},
+		{
+			desc:     "mint of nothing",
+			genState: &types.GenesisState{Mints: []types.MintRecord{{Key: "a", Address: addr, Amount: sdk.NewInt64Coin("uugd", 0)}}},
+		},
+		{
+			desc:     "invalid mint snapshot",
+			genState: &types.GenesisState{MintSnapshotState: &types.SnapshotState{Timestamp: "yesterday", Hash: hash[:]}},
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
@@ -0,0 +1,209 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	durationLib "github.com/sosodev/duration"
+)
+
+// VestingStoragePath is the hedgehog endpoint serving the vesting snapshot.
+const VestingStoragePath = "/gridspork/vesting-storage"
+
+// MintStoragePath is the hedgehog endpoint serving the mint snapshot.
+const MintStoragePath = "/gridspork/mint-storage"
+
+// HedgehogVesting is a single vesting entry as served by the hedgehog
+// vesting-storage endpoint.
+type HedgehogVesting struct {
+	Address  string         `json:"address"`
+	Amount   HedgehogAmount `json:"amount"`
+	Start    string         `json:"start"`
+	Duration string         `json:"duration"`
+	Parts    int            `json:"parts"`
+	Block    int64          `json:"block"`
+	Percent  int            `json:"percent"`
+	Cliff    int            `json:"cliff"`
+}
+
+// SnapshotHeader holds the fields the documents of the hedgehog storage
+// endpoints share. Hedgehog signs RawData, the compacted data payload.
+type SnapshotHeader struct {
+	Timestamp         string `json:"timestamp"`
+	PreviousTimeStamp string `json:"previousTimeStamp"`
+	Flags             int    `json:"flags"`
+	Hedgehogtype      string `json:"type"`
+	Signature         string `json:"signature"`
+
+	// RawData is the compacted data payload hedgehog signs.
+	RawData []byte `json:"-"`
+}
+
+// VestingSnapshot is the document served by the hedgehog vesting-storage
+// endpoint.
+type VestingSnapshot struct {
+	SnapshotHeader
+	Data struct {
+		VestingAddresses map[string]HedgehogVesting `json:"vestingAddresses"`
+	} `json:"data"`
+}
+
+// ParseVestingSnapshot decodes a vesting-storage document.
+func ParseVestingSnapshot(snapshot []byte) (VestingSnapshot, error) {
+	var res VestingSnapshot
+	if err := parseSnapshot(snapshot, &res, &res.SnapshotHeader); err != nil {
+		return VestingSnapshot{}, err
+	}
+	return res, nil
+}
+
+// Keys returns the keys of the vesting entries in sorted order, so callers
+// walk them identically on every node.
+func (s VestingSnapshot) Keys() []string {
+	return sortedKeys(s.Data.VestingAddresses)
+}
+
+// MintSnapshot is the document served by the hedgehog mint-storage endpoint.
+// Mints are keyed by the hedgehog address they mint to, optionally followed
+// by a slash and a suffix telling apart several mints to the same address.
+type MintSnapshot struct {
+	SnapshotHeader
+	Data         Mints `json:"data"`
+	PreviousData Mints `json:"previousData"`
+}
+
+// Mints are the mints listed by a mint-storage document.
+type Mints struct {
+	Mints map[string]HedgehogAmount `json:"mints"`
+}
+
+// ParseMintSnapshot decodes a mint-storage document.
+func ParseMintSnapshot(snapshot []byte) (MintSnapshot, error) {
+	var res MintSnapshot
+	if err := parseSnapshot(snapshot, &res, &res.SnapshotHeader); err != nil {
+		return MintSnapshot{}, err
+	}
+	return res, nil
+}
+
+// Keys returns the keys of the mints in sorted order, so callers walk them
+// identically on every node.
+func (s MintSnapshot) Keys() []string {
+	return sortedKeys(s.Data.Mints)
+}
+
+// ParseMintKey returns the account address a mint key mints to and the key
+// in its canonical form, the bech32 address followed by the suffix if any, so
+// the spellings hedgehog accepts for an address name the same mint.
+func ParseMintKey(key string) (sdk.AccAddress, string, error) {
+	address, suffix, hasSuffix := strings.Cut(key, "/")
+	addr, err := ParseHedgehogAddress(address)
+	if err != nil {
+		return nil, "", err
+	}
+	if !hasSuffix {
+		return addr, addr.String(), nil
+	}
+	return addr, addr.String() + "/" + suffix, nil
+}
+
+// parseSnapshot decodes the document snapshot into res and sets the RawData
+// of its header.
+func parseSnapshot(snapshot []byte, res any, header *SnapshotHeader) error {
+	if err := json.Unmarshal(snapshot, res); err != nil {
+		return err
+	}
+
+	var raw struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(snapshot, &raw); err != nil {
+		return err
+	}
+
+	var data bytes.Buffer
+	if len(raw.Data) > 0 {
+		if err := json.Compact(&data, raw.Data); err != nil {
+			return err
+		}
+	}
+	header.RawData = data.Bytes()
+
+	return nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ParseHedgehogAddress returns the account address of a hedgehog key, which
+// has the form Address(wif=<bech32 address>).
+func ParseHedgehogAddress(key string) (sdk.AccAddress, error) {
+	address := strings.TrimPrefix(key, "Address(wif=")
+	address = strings.TrimSuffix(address, ")")
+	return sdk.AccAddressFromBech32(address)
+}
+
+// ToVestingData converts a hedgehog entry into the record persisted by the
+// module, resolving the amount in base units under params, the RFC 3339
+// start time and the ISO 8601 duration. Parts and a cliff beyond the limits
+// of params, and a percent beyond 100, are rejected before they are narrowed
+// to the int32 of the record.
+func (v HedgehogVesting) ToVestingData(addr sdk.AccAddress, params Params) (VestingData, error) {
+	if limit := int(params.PartsLimit()); v.Parts < 1 || v.Parts > limit {
+		return VestingData{}, errorsmod.Wrapf(ErrInvalidVestingData, "parts must be between 1 and %d, got %d", limit, v.Parts)
+	}
+	if v.Percent < 0 || v.Percent > 100 {
+		return VestingData{}, errorsmod.Wrapf(ErrInvalidVestingData, "percent must be between 0 and 100, got %d", v.Percent)
+	}
+	if limit := int(params.CliffLimit()); v.Cliff < 0 || v.Cliff > limit {
+		return VestingData{}, errorsmod.Wrapf(ErrInvalidVestingData, "cliff must be between 0 and %d, got %d", limit, v.Cliff)
+	}
+
+	amount, err := v.Amount.BaseUnits(params)
+	if err != nil {
+		return VestingData{}, err
+	}
+
+	start, err := time.Parse(time.RFC3339, v.Start)
+	if err != nil {
+		return VestingData{}, fmt.Errorf("invalid start time %q: %w", v.Start, err)
+	}
+
+	duration, err := parseISO8601Duration(v.Duration)
+	if err != nil {
+		return VestingData{}, fmt.Errorf("invalid duration %q: %w", v.Duration, err)
+	}
+
+	return VestingData{
+		Address:   addr.String(),
+		Amount:    amount,
+		Start:     start.Unix(),
+		Duration:  duration,
+		Parts:     int32(v.Parts),
+		Block:     v.Block,
+		Percent:   int32(v.Percent),
+		Cliff:     int32(v.Cliff),
+		Processed: false,
+	}, nil
+}
+
+// parseISO8601Duration parses an ISO 8601 duration string and returns the duration in seconds.
+func parseISO8601Duration(durationStr string) (int64, error) {
+	duration, err := durationLib.Parse(durationStr)
+	if err != nil {
+		return 0, err
+	}
+	return int64(duration.ToTimeDuration().Seconds()), nil
+}
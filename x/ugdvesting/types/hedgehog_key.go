@@ -0,0 +1,139 @@
+package types
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// Supported HedgehogKey algorithms.
+const (
+	KeyAlgorithmEd25519   = "ed25519"
+	KeyAlgorithmSecp256k1 = "secp256k1"
+)
+
+// CryptoPubKey returns the public key in the form used for verification.
+func (k HedgehogKey) CryptoPubKey() (cryptotypes.PubKey, error) {
+	switch k.Algorithm {
+	case KeyAlgorithmEd25519:
+		if len(k.PubKey) != ed25519.PubKeySize {
+			return nil, fmt.Errorf("ed25519 public key must be %d bytes, got %d", ed25519.PubKeySize, len(k.PubKey))
+		}
+		return &ed25519.PubKey{Key: k.PubKey}, nil
+	case KeyAlgorithmSecp256k1:
+		if len(k.PubKey) != secp256k1.PubKeySize {
+			return nil, fmt.Errorf("secp256k1 public key must be %d bytes, got %d", secp256k1.PubKeySize, len(k.PubKey))
+		}
+		return &secp256k1.PubKey{Key: k.PubKey}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key algorithm %q", k.Algorithm)
+	}
+}
+
+// Validate checks that the key can be referenced in signatures and decoded.
+func (k HedgehogKey) Validate() error {
+	if strings.TrimSpace(k.Id) == "" {
+		return errorsmod.Wrap(ErrInvalidHedgehogKey, "empty id")
+	}
+	if strings.ContainsAny(k.Id, ":, ") {
+		return errorsmod.Wrapf(ErrInvalidHedgehogKey, "id %q must not contain ':', ',' or spaces", k.Id)
+	}
+	if _, err := k.CryptoPubKey(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidHedgehogKey, "key %s: %s", k.Id, err)
+	}
+	return nil
+}
+
+func validateHedgehogKeys(keys []HedgehogKey, threshold uint32) error {
+	ids := make(map[string]struct{}, len(keys))
+	for i, key := range keys {
+		if err := key.Validate(); err != nil {
+			return err
+		}
+		if _, ok := ids[key.Id]; ok {
+			return errorsmod.Wrapf(ErrInvalidHedgehogKey, "duplicate key id %s", key.Id)
+		}
+		ids[key.Id] = struct{}{}
+		for _, other := range keys[:i] {
+			if bytes.Equal(other.PubKey, key.PubKey) {
+				return errorsmod.Wrapf(ErrInvalidHedgehogKey, "keys %s and %s share the same public key", other.Id, key.Id)
+			}
+		}
+	}
+
+	if int(threshold) > len(keys) {
+		return fmt.Errorf("signature threshold %d exceeds the %d hedgehog keys", threshold, len(keys))
+	}
+	return nil
+}
+
+// VerifySnapshotSignature checks that at least SignatureThreshold distinct
+// hedgehog keys signed the data payload of the snapshot, a vesting or a mint
+// snapshot. The signature field
+// holds comma separated entries of the form <key id>:<base64 signature>; an
+// entry without a key id counts for the first key it verifies against.
+func (p Params) VerifySnapshotSignature(snapshot SnapshotHeader) error {
+	if p.SignatureThreshold == 0 {
+		return nil
+	}
+
+	keys := make(map[string]cryptotypes.PubKey, len(p.HedgehogKeys))
+	for _, key := range p.HedgehogKeys {
+		pubKey, err := key.CryptoPubKey()
+		if err != nil {
+			return err
+		}
+		keys[key.Id] = pubKey
+	}
+
+	signers := make(map[string]struct{})
+	for _, entry := range strings.Split(snapshot.Signature, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		id, encoded, hasID := strings.Cut(entry, ":")
+		if !hasID {
+			id, encoded = "", entry
+		}
+
+		sig, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return errorsmod.Wrapf(ErrInvalidSignature, "signature is not base64: %s", err)
+		}
+
+		if hasID {
+			pubKey, ok := keys[id]
+			if !ok {
+				return errorsmod.Wrapf(ErrInvalidSignature, "unknown hedgehog key %s", id)
+			}
+			if !pubKey.VerifySignature(snapshot.RawData, sig) {
+				return errorsmod.Wrapf(ErrInvalidSignature, "signature of key %s does not verify", id)
+			}
+			signers[id] = struct{}{}
+			continue
+		}
+
+		for _, key := range p.HedgehogKeys {
+			if _, ok := signers[key.Id]; ok {
+				continue
+			}
+			if keys[key.Id].VerifySignature(snapshot.RawData, sig) {
+				signers[key.Id] = struct{}{}
+				break
+			}
+		}
+	}
+
+	if len(signers) < int(p.SignatureThreshold) {
+		return errorsmod.Wrapf(ErrInvalidSignature, "signed by %d hedgehog keys, %d required", len(signers), p.SignatureThreshold)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package types_test
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+const signedSnapshot = `{"timestamp":"2024-01-01T00:00:00Z","data": {"vestingAddresses": {}},"signature":"%s"}`
+
+func sign(t *testing.T, priv cryptotypes.PrivKey, id string) string {
+	sig, err := priv.Sign([]byte(`{"vestingAddresses":{}}`))
+	require.NoError(t, err)
+	if id == "" {
+		return base64.StdEncoding.EncodeToString(sig)
+	}
+	return id + ":" + base64.StdEncoding.EncodeToString(sig)
+}
+
+func TestVerifySnapshotSignature(t *testing.T) {
+	edKey := ed25519.GenPrivKey()
+	secpKey := secp256k1.GenPrivKey()
+	otherKey := ed25519.GenPrivKey()
+
+	params := types.DefaultParams()
+	params.HedgehogKeys = []types.HedgehogKey{
+		{Id: "a", Algorithm: types.KeyAlgorithmEd25519, PubKey: edKey.PubKey().Bytes()},
+		{Id: "b", Algorithm: types.KeyAlgorithmSecp256k1, PubKey: secpKey.PubKey().Bytes()},
+	}
+	params.SignatureThreshold = 2
+	require.NoError(t, params.Validate())
+
+	tests := []struct {
+		name      string
+		signature []string
+		disabled  bool
+		valid     bool
+	}{
+		{name: "both keys", signature: []string{sign(t, edKey, "a"), sign(t, secpKey, "b")}, valid: true},
+		{name: "without key ids", signature: []string{sign(t, secpKey, ""), sign(t, edKey, "")}, valid: true},
+		{name: "below threshold", signature: []string{sign(t, edKey, "a")}},
+		{name: "same key twice", signature: []string{sign(t, edKey, "a"), sign(t, edKey, "")}},
+		{name: "wrong key", signature: []string{sign(t, otherKey, "a"), sign(t, secpKey, "b")}},
+		{name: "unknown key id", signature: []string{sign(t, edKey, "a"), sign(t, secpKey, "c")}},
+		{name: "not base64", signature: []string{"a:???"}},
+		{name: "unsigned"},
+		{name: "verification disabled", disabled: true, valid: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := params
+			if tc.disabled {
+				p.SignatureThreshold = 0
+			}
+
+			snapshot, err := types.ParseVestingSnapshot([]byte(strings.Replace(signedSnapshot, "%s", strings.Join(tc.signature, ","), 1)))
+			require.NoError(t, err)
+
+			err = p.VerifySnapshotSignature(snapshot.SnapshotHeader)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidSignature)
+			}
+		})
+	}
+}
+
+func TestValidateHedgehogKeys(t *testing.T) {
+	pub := ed25519.GenPrivKey().PubKey().Bytes()
+
+	tests := []struct {
+		name      string
+		keys      []types.HedgehogKey
+		threshold uint32
+		valid     bool
+	}{
+		{name: "empty", valid: true},
+		{name: "valid", keys: []types.HedgehogKey{{Id: "a", Algorithm: types.KeyAlgorithmEd25519, PubKey: pub}}, threshold: 1, valid: true},
+		{name: "threshold above keys", keys: []types.HedgehogKey{{Id: "a", Algorithm: types.KeyAlgorithmEd25519, PubKey: pub}}, threshold: 2},
+		{name: "empty id", keys: []types.HedgehogKey{{Algorithm: types.KeyAlgorithmEd25519, PubKey: pub}}},
+		{name: "id with separator", keys: []types.HedgehogKey{{Id: "a:b", Algorithm: types.KeyAlgorithmEd25519, PubKey: pub}}},
+		{name: "unknown algorithm", keys: []types.HedgehogKey{{Id: "a", Algorithm: "rsa", PubKey: pub}}},
+		{name: "wrong key size", keys: []types.HedgehogKey{{Id: "a", Algorithm: types.KeyAlgorithmSecp256k1, PubKey: pub}}},
+		{name: "duplicate id", keys: []types.HedgehogKey{
+			{Id: "a", Algorithm: types.KeyAlgorithmEd25519, PubKey: pub},
+			{Id: "a", Algorithm: types.KeyAlgorithmEd25519, PubKey: ed25519.GenPrivKey().PubKey().Bytes()},
+		}},
+		{name: "duplicate public key", keys: []types.HedgehogKey{
+			{Id: "a", Algorithm: types.KeyAlgorithmEd25519, PubKey: pub},
+			{Id: "b", Algorithm: types.KeyAlgorithmEd25519, PubKey: pub},
+		}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.HedgehogKeys = tc.keys
+			params.SignatureThreshold = tc.threshold
+
+			if tc.valid {
+				require.NoError(t, params.Validate())
+			} else {
+				require.Error(t, params.Validate())
+			}
+		})
+	}
+}
@@ -2,99 +2,109 @@ package types
 
 import (
 	"encoding/json"
-	"fmt"
-	"math"
-	"math/big"
-	"time"
+	"strings"
 
-	sdkmath "cosmossdk.io/math"
-	durationLib "github.com/sosodev/duration"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 )
 
-type Vesting struct {
-	Amount   sdkmath.Int `json:"amount"`
-	Start    string      `json:"start"`
-	Duration string      `json:"duration"`
-	Parts    int64       `json:"parts"`
+// HedgehogAmount is the amount of a hedgehog vesting entry as served. A JSON
+// number is an amount in base units, a JSON string a decimal amount in the
+// unit of Params.CoinPower, "1.5" standing for 1.5 * 10^coinPower base units.
+// The amount is only parsed by BaseUnits, so an invalid amount rejects its
+// entry and not the whole snapshot.
+type HedgehogAmount struct {
+	value   string
+	decimal bool
 }
 
-func GetUnvestedAmount(vesting Vesting) sdkmath.Int {
-	timeStart, _ := time.Parse(time.RFC3339, vesting.Start)
-	timeNow := time.Now()
-	timePassed := timeNow.Sub(timeStart)
-
-	vestingDurationLib, err := durationLib.Parse(vesting.Duration)
-	if err != nil {
-		panic(err)
-	}
-
-	vestingDuration := vestingDurationLib.ToTimeDuration()
-	timeEnd := timeStart.Add(vestingDuration)
+// NewBaseAmount returns the amount of amount base units.
+func NewBaseAmount(amount math.Int) HedgehogAmount {
+	return HedgehogAmount{value: amount.String()}
+}
 
-	// if vesting has started and not done
-	if timePassed.Seconds() > 0 && timeEnd.After(timeNow) {
-		partDuration := vestingDuration.Seconds() / float64(vesting.Parts)
-		partAmount := vesting.Amount.Quo(sdkmath.NewInt(vesting.Parts))
-		// round down, to get current part
-		partNow := int64(timePassed.Seconds() / partDuration)
-		vested := partAmount.Mul(sdkmath.NewInt(partNow))
-		unvested := vesting.Amount.Sub(vested)
+// NewDecimalAmount returns the decimal amount s, in the unit of
+// Params.CoinPower.
+func NewDecimalAmount(s string) HedgehogAmount {
+	return HedgehogAmount{value: s, decimal: true}
+}
 
-		return unvested
+// UnmarshalJSON keeps the amount as served, a string being a decimal amount.
+func (a *HedgehogAmount) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		a.decimal = true
+		return json.Unmarshal(data, &a.value)
 	}
-
-	return sdkmath.NewInt(0)
+	a.decimal = false
+	a.value = string(data)
+	return nil
 }
 
-func SdkIntToFloat(amount sdkmath.Int, precision uint, coinPowerValue float64) *big.Float {
-	var float big.Float
-	float.SetPrec(precision)
-	float.SetInt(amount.BigInt())
-	result := float.Quo(&float, big.NewFloat(coinPowerValue))
-	return result
+// MarshalJSON returns the amount as it was served.
+func (a HedgehogAmount) MarshalJSON() ([]byte, error) {
+	if a.decimal {
+		return json.Marshal(a.value)
+	}
+	if a.value == "" {
+		return []byte("0"), nil
+	}
+	return []byte(a.value), nil
 }
 
-func SdkIntToString(amount sdkmath.Int, precision uint, coinPowerValue float64, coinPower int) string {
-	float := SdkIntToFloat(amount, precision, coinPowerValue)
-	return float.Text('f', coinPower)
+// String returns the amount as served.
+func (a HedgehogAmount) String() string {
+	return a.value
 }
 
-func (v *Vesting) UnmarshalJSON(data []byte) error {
-	// define an alias to avoid infinite recursion
-	type vestingAlias Vesting
-
-	// define a struct to handle the raw JSON data
-	aux := struct {
-		Amount   string `json:"amount"`
-		Start    string `json:"start"`
-		Duration string `json:"duration"`
-		Parts    int64  `json:"parts"`
-		*vestingAlias
-	}{
-		vestingAlias: (*vestingAlias)(v),
+// BaseUnits returns the amount in base units under params, see
+// ParseDecimalAmount. Decimal amounts are refused while Params.CoinPower is
+// not set, rather than read as base units.
+func (a HedgehogAmount) BaseUnits(params Params) (math.Int, error) {
+	if !a.decimal {
+		return ParseDecimalAmount(a.value, 0, 0)
 	}
-
-	// unmarshal the raw JSON data into the auxiliary struct
-	if err := json.Unmarshal(data, &aux); err != nil {
-		return err
+	if params.CoinPower == 0 {
+		return math.Int{}, errorsmod.Wrapf(ErrInvalidVestingData, "decimal amount %q while the coin power param is not set", a.value)
 	}
+	return ParseDecimalAmount(a.value, params.CoinPower, params.Precision)
+}
 
-	// convert the "amount" string to a big.Float
-	amountFloat, _, err := big.ParseFloat(aux.Amount, 10, 256, big.ToNearestEven)
-	if err != nil {
-		return fmt.Errorf("invalid amount value: %s", aux.Amount)
+// ParseDecimalAmount parses the non-negative decimal amount s in a unit of
+// exponent decimals and returns it in base units, "1.5" being 15 followed by
+// exponent-1 zeros. The amount may carry at most precision decimals, or
+// exponent decimals when precision is zero, trailing zeros aside. Amounts
+// with more decimals are rejected rather than rounded.
+func ParseDecimalAmount(s string, exponent, precision uint32) (math.Int, error) {
+	integer, fraction, hasFraction := strings.Cut(s, ".")
+	if !isDigits(integer) || (hasFraction && !isDigits(fraction)) {
+		return math.Int{}, errorsmod.Wrapf(ErrInvalidVestingData, "invalid amount %q", s)
 	}
 
-	// Multiply the float by 10^18 to shift the decimal point 18 places to the right
-	amountFloatMul := new(big.Float).Mul(amountFloat, big.NewFloat(math.Pow10(18)))
+	decimals := exponent
+	if precision != 0 && precision < exponent {
+		decimals = precision
+	}
+	fraction = strings.TrimRight(fraction, "0")
+	if len(fraction) > int(decimals) {
+		return math.Int{}, errorsmod.Wrapf(ErrInvalidVestingData, "amount %q has more than %d decimals", s, decimals)
+	}
 
-	// Convert the scaled float to a big.Int
-	amountInt := new(big.Int)
-	amountFloatMul.Int(amountInt)
-	v.Amount = sdkmath.NewIntFromBigInt(amountInt)
-	v.Duration = aux.Duration
-	v.Start = aux.Start
-	v.Parts = aux.Parts
+	amount, ok := math.NewIntFromString(integer + fraction + strings.Repeat("0", int(exponent)-len(fraction)))
+	if !ok {
+		return math.Int{}, errorsmod.Wrapf(ErrInvalidVestingData, "amount %q out of range", s)
+	}
+	return amount, nil
+}
 
-	return nil
+// isDigits returns whether s is a non-empty string of decimal digits.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
 }
@@ -2,130 +2,170 @@ package types_test
 
 import (
 	"encoding/json"
-	"math"
-	"math/big"
+	stdmath "math"
 	"testing"
-	"time"
 
-	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
 
-	sdkmath "cosmossdk.io/math"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/sample"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
 )
 
-func TestGetUnvestedAmount(t *testing.T) {
-	// unvested should be 0, if vesting not started
-	timeNow := time.Now()
-	timeNow = timeNow.In(time.FixedZone("CET", 0))
-	amount := sdkmath.NewInt(1000)
-	duration := "PT10M"
-	part := int64(10)
-	timeStart := timeNow.Add(10 * time.Minute)
-	formattedTimeStart := timeStart.UTC().Format("2006-01-02T15:04:05.999999Z")
-
-	vesting := types.Vesting{
-		Amount:   amount,
-		Start:    formattedTimeStart,
-		Duration: duration,
-		Parts:    part,
-	}
-	unvested := types.GetUnvestedAmount(vesting)
-
-	expected := big.NewInt(0)
-	if unvested.BigInt().Cmp(expected) != 0 {
-		t.Errorf("unvested = %v, expected = %v", unvested, expected)
-	}
-
-	// unvested should be 0, if vesting is done
-	timeNow = time.Now()
-	timeNow = timeNow.In(time.FixedZone("CET", 0))
-	timeStart = timeNow.Add(-11 * time.Minute)
-	formattedTimeStart = timeStart.UTC().Format("2006-01-02T15:04:05.999999Z")
-
-	vesting = types.Vesting{
-		Amount:   amount,
-		Start:    formattedTimeStart,
-		Duration: duration,
-		Parts:    part,
-	}
-	unvested = types.GetUnvestedAmount(vesting)
+func TestUnmarshalJSON(t *testing.T) {
+	var vesting types.HedgehogVesting
+	jsonStr := `{"amount":"7000.707070707070707070","start":"2023-03-14T18:41:20Z","duration":"PT168H29M58S","parts":7}`
+	require.NoError(t, json.Unmarshal([]byte(jsonStr), &vesting))
+	require.Equal(t, "2023-03-14T18:41:20Z", vesting.Start)
+	require.Equal(t, "PT168H29M58S", vesting.Duration)
+	require.Equal(t, 7, vesting.Parts)
+
+	// scaling through a float64 loses the last digits of this amount
+	amount, err := vesting.Amount.BaseUnits(types.Params{CoinPower: 18, CoinPowerValue: 1e18})
+	require.NoError(t, err)
+	expected, ok := math.NewIntFromString("7000707070707070707070")
+	require.True(t, ok)
+	require.Equal(t, expected, amount)
+
+	bz, err := json.Marshal(vesting.Amount)
+	require.NoError(t, err)
+	require.Equal(t, `"7000.707070707070707070"`, string(bz))
+
+	// numbers are base units, whatever the coin power
+	require.NoError(t, json.Unmarshal([]byte(`{"amount":1000}`), &vesting))
+	amount, err = vesting.Amount.BaseUnits(types.Params{CoinPower: 8, CoinPowerValue: 1e8})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1000), amount)
+
+	bz, err = json.Marshal(vesting.Amount)
+	require.NoError(t, err)
+	require.Equal(t, `1000`, string(bz))
+
+	require.NoError(t, json.Unmarshal([]byte(`{"amount":1000.5}`), &vesting))
+	_, err = vesting.Amount.BaseUnits(types.DefaultParams())
+	require.ErrorIs(t, err, types.ErrInvalidVestingData)
+
+	// decimal amounts are not read as base units without coin power
+	require.NoError(t, json.Unmarshal([]byte(`{"amount":"1000"}`), &vesting))
+	_, err = vesting.Amount.BaseUnits(types.Params{})
+	require.ErrorIs(t, err, types.ErrInvalidVestingData)
+	amount, err = vesting.Amount.BaseUnits(types.DefaultParams())
+	require.NoError(t, err)
+	require.Equal(t, math.NewIntWithDecimal(1000, 18), amount)
+}
 
-	expected = big.NewInt(0)
-	if unvested.BigInt().Cmp(expected) != 0 {
-		t.Errorf("unvested = %v, expected = %v", unvested, expected)
+func TestToVestingData(t *testing.T) {
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	params := types.DefaultParams()
+	entry := func(parts, percent, cliff int) types.HedgehogVesting {
+		return types.HedgehogVesting{
+			Amount: types.NewBaseAmount(math.NewInt(1000)), Start: "2024-01-01T00:00:00Z", Duration: "PT1H",
+			Parts: parts, Block: 100, Percent: percent, Cliff: cliff,
+		}
 	}
 
-	// unvested should be 600, if vesting progress is 4/10
-	timeNow = time.Now()
-	timeNow = timeNow.In(time.FixedZone("CET", 0))
-	timeStart = timeNow.Add(-4 * time.Minute)
-	formattedTimeStart = timeStart.UTC().Format("2006-01-02T15:04:05.999999Z")
-
-	vesting = types.Vesting{
-		Amount:   amount,
-		Start:    formattedTimeStart,
-		Duration: duration,
-		Parts:    part,
+	data, err := entry(4, 10, 1).ToVestingData(addr, params)
+	require.NoError(t, err)
+	require.Equal(t, types.VestingData{
+		Address: addr.String(), Amount: math.NewInt(1000), Start: 1704067200, Duration: 3600,
+		Parts: 4, Block: 100, Percent: 10, Cliff: 1,
+	}, data)
+
+	tests := []struct {
+		name  string
+		entry types.HedgehogVesting
+	}{
+		{"no parts", entry(0, 10, 0)},
+		{"parts beyond the limit", entry(types.DefaultMaxParts+1, 10, 0)},
+		{"parts beyond int32", entry(stdmath.MaxInt32+5, 10, 0)},
+		{"negative percent", entry(4, -1, 0)},
+		{"percent beyond int32", entry(4, stdmath.MaxInt32+101, 0)},
+		{"negative cliff", entry(4, 10, -1)},
+		{"cliff beyond the limit", entry(4, 10, types.DefaultMaxCliff+1)},
+		{"cliff beyond int32", entry(4, 10, stdmath.MaxInt32+1)},
 	}
-	unvested = types.GetUnvestedAmount(vesting)
-
-	expected = big.NewInt(600)
-	if unvested.BigInt().Cmp(expected) != 0 {
-		t.Errorf("unvested = %v, expected = %v", unvested, expected)
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.entry.ToVestingData(addr, params)
+			require.ErrorIs(t, err, types.ErrInvalidVestingData)
+		})
 	}
 }
 
-func TestSdkIntToFloat(t *testing.T) {
-	var expected big.Float
-	var bigInt big.Int
-
-	expected.SetPrec(256)
-	expected.SetString("7000.707070707070707070")
-
-	bigInt.SetString("7000707070707070707070", 10)
-	result := types.SdkIntToFloat(sdkmath.NewIntFromBigInt(&bigInt), 256, math.Pow10(18))
-
-	if result.Cmp(&expected) != 0 {
-		t.Errorf("Unexpected response. Expected %+v, but got %+v", expected, result)
+func TestParseDecimalAmount(t *testing.T) {
+	tests := []struct {
+		name      string
+		amount    string
+		exponent  uint32
+		precision uint32
+		expected  string
+		err       bool
+	}{
+		{name: "integer", amount: "12", exponent: 8, expected: "1200000000"},
+		{name: "decimals", amount: "1.5", exponent: 8, expected: "150000000"},
+		{name: "all decimals", amount: "0.00000001", exponent: 8, expected: "1"},
+		{name: "zero exponent", amount: "1000", expected: "1000"},
+		{name: "trailing zeros", amount: "1.50000000000", exponent: 8, precision: 2, expected: "150000000"},
+		{name: "within precision", amount: "1.25", exponent: 8, precision: 2, expected: "125000000"},
+		{name: "beyond precision", amount: "1.255", exponent: 8, precision: 2, err: true},
+		{name: "beyond exponent", amount: "0.000000001", exponent: 8, err: true},
+		{name: "decimals without exponent", amount: "1.5", err: true},
+		{name: "large", amount: "123456789012345678901234567890.123456789012345678", exponent: 18, expected: "123456789012345678901234567890123456789012345678"},
+		{name: "out of range", amount: "10000000000000000000000000000000000000000000000000000000000000000000000000000000", exponent: 18, err: true},
+		{name: "negative", amount: "-1", exponent: 8, err: true},
+		{name: "sign", amount: "+1", exponent: 8, err: true},
+		{name: "exponent notation", amount: "1e8", exponent: 8, err: true},
+		{name: "no integer part", amount: ".5", exponent: 8, err: true},
+		{name: "no decimals", amount: "1.", exponent: 8, err: true},
+		{name: "empty", amount: "", exponent: 8, err: true},
 	}
-}
-
-func TestSdkIntToString(t *testing.T) {
-	expected := "7000.707070707070707070"
-
-	var bigInt big.Int
-	bigInt.SetString("7000707070707070707070", 10)
-	result := types.SdkIntToString(sdkmath.NewIntFromBigInt(&bigInt), 256, math.Pow10(18), 18)
 
-	if result != expected {
-		t.Errorf("Unexpected response. Expected %+v, but got %+v", expected, result)
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			amount, err := types.ParseDecimalAmount(tc.amount, tc.exponent, tc.precision)
+			if tc.err {
+				require.ErrorIs(t, err, types.ErrInvalidVestingData)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, amount.String())
+		})
 	}
-
 }
 
-func TestUnmarshalJSON(t *testing.T) {
-	var vesting types.Vesting
-	var bigInt big.Int
-
-	jsonStr := `{"amount":"7000.707070707070707070","start":"2023-03-14T18:41:20Z","duration":"PT168H29M58S","parts":7}`
-
-	err := json.Unmarshal([]byte(jsonStr), &vesting)
-	if err != nil {
-		panic(err)
-	}
-
-	bigInt.SetString("7000707070707070707070", 10)
-
-	expected := types.Vesting{
-		Amount:   sdkmath.NewIntFromBigInt(&bigInt),
-		Start:    "2023-03-14T18:41:20Z",
-		Duration: "PT168H29M58S",
-		Parts:    7,
+func TestParseMintSnapshot(t *testing.T) {
+	addr := sample.AccAddress()
+	raw := `{"timestamp":"2024-01-01T00:00:00Z","signature":"sig","data": {"mints": {"Address(wif=` + addr + `)/2":"1.5",` +
+		`"Address(wif=` + addr + `)":100}},"previousData":{"mints":{}}}`
+
+	snapshot, err := types.ParseMintSnapshot([]byte(raw))
+	require.NoError(t, err)
+	require.Equal(t, "2024-01-01T00:00:00Z", snapshot.Timestamp)
+	require.Equal(t, "sig", snapshot.Signature)
+	// hedgehog signs the compacted data payload
+	require.Equal(t, `{"mints":{"Address(wif=`+addr+`)/2":"1.5","Address(wif=`+addr+`)":100}}`, string(snapshot.RawData))
+	require.Equal(t, []string{"Address(wif=" + addr + ")", "Address(wif=" + addr + ")/2"}, snapshot.Keys())
+
+	amount, err := snapshot.Data.Mints["Address(wif="+addr+")/2"].BaseUnits(types.Params{CoinPower: 8, CoinPowerValue: 1e8})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(150_000_000), amount)
+
+	for _, key := range snapshot.Keys() {
+		mintAddr, _, err := types.ParseMintKey(key)
+		require.NoError(t, err)
+		require.Equal(t, addr, mintAddr.String())
 	}
-
-	if vesting.Amount.Neg().Equal(expected.Amount) &&
-		vesting.Start != expected.Start &&
-		vesting.Duration != expected.Duration &&
-		vesting.Parts != expected.Parts {
-		t.Errorf("Unexpected response. Expected %+v, but got %+v", expected, vesting)
+	for key, canonical := range map[string]string{
+		"Address(wif=" + addr + ")/2": addr + "/2",
+		addr + "/2":                   addr + "/2",
+		"Address(wif=" + addr + ")":   addr,
+		addr:                          addr,
+	} {
+		_, canonicalKey, err := types.ParseMintKey(key)
+		require.NoError(t, err)
+		require.Equal(t, canonical, canonicalKey)
 	}
+	_, _, err = types.ParseMintKey("Address(wif=invalid)/2")
+	require.Error(t, err)
 }
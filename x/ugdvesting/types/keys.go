@@ -1,5 +1,7 @@
 package types
 
+import "cosmossdk.io/collections"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "ugdvesting"
@@ -13,6 +15,38 @@ const (
 
 var (
 	ParamsKey = []byte("p_ugdvesting")
+
+	// VestingDataKey is the prefix under which hedgehog vesting records are
+	// stored, keyed by account address.
+	VestingDataKey = collections.NewPrefix(1)
+
+	// SnapshotStateKey is the key of the last accepted vesting snapshot.
+	SnapshotStateKey = collections.NewPrefix(2)
+
+	// LastBlockTimeKey is the key of the time of the last block.
+	LastBlockTimeKey = collections.NewPrefix(3)
+
+	// AmendmentsKey is the prefix under which the amendment history of the
+	// vesting records is stored, keyed by account address and version.
+	AmendmentsKey = collections.NewPrefix(4)
+
+	// MintsKey is the prefix under which the records of the executed
+	// hedgehog mints are stored, keyed by mint key.
+	MintsKey = collections.NewPrefix(5)
+
+	// MintSnapshotStateKey is the key of the last accepted mint snapshot.
+	MintSnapshotStateKey = collections.NewPrefix(6)
+
+	// MintedTotalKey is the key of the amount the module minted so far.
+	MintedTotalKey = collections.NewPrefix(7)
+
+	// PendingQueueKey is the prefix of the queue of the pending vesting
+	// records, keyed by activation block and account address.
+	PendingQueueKey = collections.NewPrefix(8)
+
+	// DeferredEventsKey is the key of the events of the PreBlocker, kept
+	// until BeginBlock emits them.
+	DeferredEventsKey = collections.NewPrefix(9)
 )
 
 func KeyPrefix(p string) []byte {
@@ -0,0 +1,519 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ugdvesting/ugdvesting/mint.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MintRecord is a hedgehog mint the module executed. A mint is executed once
+// per key, however many snapshots list it.
+type MintRecord struct {
+	Key               string     `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Address           string     `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Amount            types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	Height            int64      `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	SnapshotTimestamp string     `protobuf:"bytes,5,opt,name=snapshot_timestamp,json=snapshotTimestamp,proto3" json:"snapshot_timestamp,omitempty"`
+}
+
+func (m *MintRecord) Reset()         { *m = MintRecord{} }
+func (m *MintRecord) String() string { return proto.CompactTextString(m) }
+func (*MintRecord) ProtoMessage()    {}
+func (*MintRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bfd210206285ba9f, []int{0}
+}
+func (m *MintRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintRecord.Merge(m, src)
+}
+func (m *MintRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintRecord proto.InternalMessageInfo
+
+func (m *MintRecord) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *MintRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MintRecord) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *MintRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MintRecord) GetSnapshotTimestamp() string {
+	if m != nil {
+		return m.SnapshotTimestamp
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*MintRecord)(nil), "ugdvesting.ugdvesting.MintRecord")
+}
+
+func init() { proto.RegisterFile("ugdvesting/ugdvesting/mint.proto", fileDescriptor_bfd210206285ba9f) }
+
+var fileDescriptor_bfd210206285ba9f = []byte{
+	// 320 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xbd, 0x4e, 0xc3, 0x30,
+	0x14, 0x85, 0x63, 0x0a, 0x45, 0x35, 0x0b, 0x8d, 0x00, 0x85, 0x0e, 0x26, 0x62, 0xaa, 0x90, 0x62,
+	0xab, 0xb0, 0x32, 0x95, 0xb9, 0x4b, 0xc4, 0xc4, 0x82, 0x9c, 0xc4, 0x72, 0x0c, 0xb2, 0x6f, 0x14,
+	0x3b, 0x15, 0x7d, 0x0b, 0x1e, 0x83, 0x91, 0x17, 0x60, 0xef, 0xd8, 0x91, 0x09, 0xa1, 0x76, 0xe0,
+	0x35, 0x50, 0xf3, 0xa3, 0x76, 0xb1, 0xbe, 0x7b, 0x8e, 0x2d, 0x1f, 0xdd, 0x83, 0xc3, 0x4a, 0x66,
+	0x73, 0x61, 0x9d, 0x32, 0x92, 0xed, 0xa1, 0x56, 0xc6, 0xd1, 0xa2, 0x04, 0x07, 0xfe, 0xf9, 0x4e,
+	0xa6, 0x3b, 0x1c, 0x0d, 0xb9, 0x56, 0x06, 0x58, 0x7d, 0x36, 0x37, 0x47, 0x24, 0x05, 0xab, 0xc1,
+	0xb2, 0x84, 0x5b, 0xc1, 0xe6, 0x93, 0x44, 0x38, 0x3e, 0x61, 0x29, 0x28, 0xd3, 0xfa, 0x67, 0x12,
+	0x24, 0xd4, 0xc8, 0xb6, 0xd4, 0xa8, 0xd7, 0x5f, 0x08, 0xe3, 0x99, 0x32, 0x2e, 0x16, 0x29, 0x94,
+	0x99, 0x7f, 0x8a, 0x7b, 0xaf, 0x62, 0x11, 0xa0, 0x10, 0x8d, 0x07, 0xf1, 0x16, 0xfd, 0x00, 0x1f,
+	0xf3, 0x2c, 0x2b, 0x85, 0xb5, 0xc1, 0x41, 0xad, 0x76, 0xa3, 0x7f, 0x8f, 0xfb, 0x5c, 0x43, 0x65,
+	0x5c, 0xd0, 0x0b, 0xd1, 0xf8, 0xe4, 0xf6, 0x92, 0x36, 0x09, 0xe8, 0x36, 0x01, 0x6d, 0x13, 0xd0,
+	0x07, 0x50, 0x66, 0x3a, 0x58, 0xfe, 0x5c, 0x79, 0x1f, 0x7f, 0x9f, 0x37, 0x28, 0x6e, 0xdf, 0xf8,
+	0x17, 0xb8, 0x9f, 0x0b, 0x25, 0x73, 0x17, 0x1c, 0x86, 0x68, 0xdc, 0x8b, 0xdb, 0xc9, 0x8f, 0xb0,
+	0x6f, 0x0d, 0x2f, 0x6c, 0x0e, 0xee, 0xd9, 0x29, 0x2d, 0xac, 0xe3, 0xba, 0x08, 0x8e, 0xea, 0xaf,
+	0x87, 0x9d, 0xf3, 0xd8, 0x19, 0x53, 0xb9, 0x5c, 0x13, 0xb4, 0x5a, 0x13, 0xf4, 0xbb, 0x26, 0xe8,
+	0x7d, 0x43, 0xbc, 0xd5, 0x86, 0x78, 0xdf, 0x1b, 0xe2, 0x3d, 0xcd, 0xa4, 0x72, 0x79, 0x95, 0xd0,
+	0x14, 0x34, 0xab, 0x8c, 0x92, 0xa5, 0xca, 0xa2, 0xa2, 0x84, 0x17, 0x91, 0x3a, 0xd6, 0x04, 0x8d,
+	0x3a, 0x39, 0x17, 0x99, 0x14, 0x39, 0xc8, 0xa8, 0x2b, 0xe0, 0x6d, 0xbf, 0x0d, 0xb7, 0x28, 0x84,
+	0x4d, 0xfa, 0xf5, 0xbe, 0xee, 0xfe, 0x07, 0x00, 0x1c, 0xcb, 0xae, 0x9b, 0xb3, 0x01, 0x00, 0x00,
+}
+
+func (m *MintRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SnapshotTimestamp) > 0 {
+		i -= len(m.SnapshotTimestamp)
+		copy(dAtA[i:], m.SnapshotTimestamp)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.SnapshotTimestamp)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Height != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMint(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MintRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovMint(uint64(m.Height))
+	}
+	l = len(m.SnapshotTimestamp)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	return n
+}
+
+func sovMint(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMint(x uint64) (n int) {
+	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MintRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTimestamp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SnapshotTimestamp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMint(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMint
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMint
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMint
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMint        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMint          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMint = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"crypto/sha256"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgAmendPendingVesting{}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgAmendPendingVesting) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(err, "invalid signer address")
+	}
+	if len(m.ExpectedHash) != 0 && len(m.ExpectedHash) != sha256.Size {
+		return errorsmod.Wrapf(ErrInvalidVestingData, "expected hash must be %d bytes, got %d", sha256.Size, len(m.ExpectedHash))
+	}
+
+	return m.Amend(VestingData{Address: m.Address}).Validate()
+}
+
+// Amend returns data with the terms of the amendment, pinned so hedgehog
+// snapshots do not replace it. The failure of an earlier conversion attempt
+// is cleared, the amended terms are attempted afresh.
+func (m *MsgAmendPendingVesting) Amend(data VestingData) VestingData {
+	data.Amount = m.Amount
+	data.Start = m.Start
+	data.Duration = m.Duration
+	data.Parts = m.Parts
+	data.Percent = m.Percent
+	data.Cliff = m.Cliff
+	data.Block = m.Block
+	data.Pinned = true
+	data.FailureReason = ""
+	return data
+}
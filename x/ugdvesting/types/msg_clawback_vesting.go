@@ -0,0 +1,26 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgClawbackVesting{}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgClawbackVesting) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return errorsmod.Wrap(err, "invalid vesting account address")
+	}
+
+	switch m.Destination {
+	case ClawbackDestination_CLAWBACK_DESTINATION_COMMUNITY_POOL, ClawbackDestination_CLAWBACK_DESTINATION_FUNDER:
+		return nil
+	default:
+		return errorsmod.Wrapf(ErrClawbackFailed, "unknown destination %s", m.Destination)
+	}
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgCreateVestingSchedule{}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgCreateVestingSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if m.Schedule.Processed || m.Schedule.FailureReason != "" {
+		return errorsmod.Wrap(ErrInvalidVestingData, "a new schedule cannot be processed")
+	}
+
+	return m.Schedule.Validate()
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgAddHedgehogKey{}
+	_ sdk.Msg = &MsgRotateHedgehogKey{}
+	_ sdk.Msg = &MsgRetireHedgehogKey{}
+)
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgAddHedgehogKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Key.Validate()
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRotateHedgehogKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Key.Validate()
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRetireHedgehogKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if m.Id == "" {
+		return errorsmod.Wrap(ErrInvalidHedgehogKey, "empty id")
+	}
+
+	return nil
+}
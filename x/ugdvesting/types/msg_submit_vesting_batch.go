@@ -0,0 +1,24 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgSubmitVestingBatch{}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSubmitVestingBatch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Relayer); err != nil {
+		return errorsmod.Wrap(err, "invalid relayer address")
+	}
+
+	if len(m.Snapshot) == 0 {
+		return errorsmod.Wrap(ErrInvalidVestingData, "empty snapshot")
+	}
+	if _, err := ParseVestingSnapshot(m.Snapshot); err != nil {
+		return errorsmod.Wrapf(ErrInvalidVestingData, "invalid snapshot: %s", err)
+	}
+
+	return nil
+}
@@ -1,11 +1,52 @@
 package types
 
 import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+const (
+	// DefaultDenom is the denom vested when Params.Denom is not set.
+	DefaultDenom = "uugd"
+
+	// DefaultPollIntervalBlocks is the number of blocks between two hedgehog
+	// polls in DefaultParams.
+	DefaultPollIntervalBlocks = 10
+
+	// DefaultMaxSnapshotBytes is the size limit of the snapshots validators
+	// vote on when Params.MaxSnapshotBytes is not set.
+	DefaultMaxSnapshotBytes = 1 << 20
+
+	// DefaultMaxParts and DefaultMaxCliff bound the parts and the cliff of
+	// ingested vesting schedules when Params.MaxParts or Params.MaxCliff is
+	// not set.
+	DefaultMaxParts = 1000
+	DefaultMaxCliff = 1000
+
+	// DefaultRetryIntervalBlocks is the number of blocks after which a failed
+	// conversion is attempted again when Params.RetryIntervalBlocks is not
+	// set.
+	DefaultRetryIntervalBlocks = 100
+
+	// DefaultCoinPower is the exponent of the display unit of hedgehog
+	// amounts in DefaultParams, DefaultCoinPowerValue its power of ten.
+	DefaultCoinPower      = 18
+	DefaultCoinPowerValue = 1e18
+
+	// MaxScheduleParts bounds the parts and the cliff of every vesting
+	// schedule, and the MaxParts and MaxCliff params.
+	MaxScheduleParts = 10_000
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -16,9 +57,25 @@ func NewParams() Params {
 	return Params{}
 }
 
-// DefaultParams returns a default set of parameters
+// DefaultParams returns a default set of parameters. Ingestion starts
+// disabled, as it may only be enabled together with a hedgehog signer set.
 func DefaultParams() Params {
-	return NewParams()
+	params := NewParams()
+	params.Denom = DefaultDenom
+	params.CoinPower = DefaultCoinPower
+	params.CoinPowerValue = DefaultCoinPowerValue
+	params.PollIntervalBlocks = DefaultPollIntervalBlocks
+	params.EndpointPath = VestingStoragePath
+	params.MintEndpointPath = MintStoragePath
+	params.MaxMintAmount = math.ZeroInt()
+	params.MaxMintPerBlock = math.ZeroInt()
+	params.MaxTotalMinted = math.ZeroInt()
+	params.LockPendingFunds = true
+	params.MaxSnapshotBytes = DefaultMaxSnapshotBytes
+	params.MaxParts = DefaultMaxParts
+	params.MaxCliff = DefaultMaxCliff
+	params.RetryIntervalBlocks = DefaultRetryIntervalBlocks
+	return params
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -28,5 +85,260 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validateDenoms(p.Denom, p.AdditionalDenoms); err != nil {
+		return err
+	}
+	if err := p.validateCoinPower(); err != nil {
+		return err
+	}
+	if err := validateAddresses("relayer", p.Relayers); err != nil {
+		return err
+	}
+	if err := validateAddresses("amendment operator", p.AmendmentOperators); err != nil {
+		return err
+	}
+	if err := p.validateIngestion(); err != nil {
+		return err
+	}
+	if err := p.validateMint(); err != nil {
+		return err
+	}
+	if p.MaxParts > MaxScheduleParts {
+		return fmt.Errorf("max parts must be at most %d, got %d", MaxScheduleParts, p.MaxParts)
+	}
+	if p.MaxCliff > MaxScheduleParts {
+		return fmt.Errorf("max cliff must be at most %d, got %d", MaxScheduleParts, p.MaxCliff)
+	}
+	return validateHedgehogKeys(p.HedgehogKeys, p.SignatureThreshold)
+}
+
+func (p Params) validateCoinPower() error {
+	if p.CoinPower > math.LegacyPrecision {
+		return fmt.Errorf("coin power must be at most %d, got %d", math.LegacyPrecision, p.CoinPower)
+	}
+	if p.CoinPower != 0 || p.CoinPowerValue != 0 {
+		if expected := math.NewIntWithDecimal(1, int(p.CoinPower)).Uint64(); p.CoinPowerValue != expected {
+			return fmt.Errorf("coin power value must be 10^%d = %d, got %d", p.CoinPower, expected, p.CoinPowerValue)
+		}
+	}
+	if p.Precision > p.CoinPower {
+		return fmt.Errorf("precision %d exceeds coin power %d", p.Precision, p.CoinPower)
+	}
+	return nil
+}
+
+func (p Params) validateIngestion() error {
+	if p.ActivationHeight < 0 {
+		return fmt.Errorf("activation height must not be negative: %d", p.ActivationHeight)
+	}
+	if p.PollIntervalTime < 0 {
+		return fmt.Errorf("poll interval time must not be negative: %s", p.PollIntervalTime)
+	}
+	if p.PollIntervalBlocks > 0 && p.PollIntervalTime > 0 {
+		return fmt.Errorf("poll interval must be set in blocks or in time, not both")
+	}
+	if p.EndpointPath != "" {
+		if err := validateEndpointPath(p.EndpointPath); err != nil {
+			return err
+		}
+	}
+
+	if !p.Enabled {
+		return nil
+	}
+	if p.PollIntervalBlocks == 0 && p.PollIntervalTime == 0 {
+		return fmt.Errorf("poll interval must be set while ingestion is enabled")
+	}
+	if p.EndpointPath == "" {
+		return fmt.Errorf("endpoint path must be set while ingestion is enabled")
+	}
+	if p.SignatureThreshold == 0 {
+		return fmt.Errorf("signature threshold must be set while ingestion is enabled")
+	}
+	return nil
+}
+
+func (p Params) validateMint() error {
+	limits := []struct {
+		name  string
+		limit math.Int
+	}{
+		{"max mint amount", p.MaxMintAmount},
+		{"max mint per block", p.MaxMintPerBlock},
+		{"max total minted", p.MaxTotalMinted},
+	}
+	for _, l := range limits {
+		if !l.limit.IsNil() && l.limit.IsNegative() {
+			return fmt.Errorf("%s must not be negative: %s", l.name, l.limit)
+		}
+	}
+	if p.MintEndpointPath != "" {
+		if err := validateEndpointPath(p.MintEndpointPath); err != nil {
+			return err
+		}
+	}
+	if !p.MintEnabled {
+		return nil
+	}
+	if p.MintEndpointPath == "" {
+		return fmt.Errorf("mint endpoint path must be set while minting is enabled")
+	}
+	for _, l := range limits {
+		if l.limit.IsNil() || l.limit.IsZero() {
+			return fmt.Errorf("%s must be set while minting is enabled", l.name)
+		}
+	}
+	if p.SignatureThreshold == 0 {
+		return fmt.Errorf("signature threshold must be set while minting is enabled")
+	}
+	return nil
+}
+
+func validateEndpointPath(path string) error {
+	u, err := url.Parse(path)
+	if err != nil {
+		return fmt.Errorf("invalid endpoint path %q: %w", path, err)
+	}
+	if !strings.HasPrefix(path, "/") || u.Scheme != "" || u.Host != "" || u.RawQuery != "" || u.Fragment != "" || u.Path != path {
+		return fmt.Errorf("invalid endpoint path %q: must be an absolute path without host or query", path)
+	}
+	return nil
+}
+
+// IsActive reports whether hedgehog ingestion runs at height.
+func (p Params) IsActive(height int64) bool {
+	return p.Enabled && p.IsActivationHeight(height)
+}
+
+// IsActivationHeight reports whether pending vesting records are activated at
+// height. Activation does not depend on Enabled, so schedules created by
+// governance also activate on chains that do not ingest from hedgehog.
+func (p Params) IsActivationHeight(height int64) bool {
+	return height >= p.ActivationHeight
+}
+
+// IsMintActive reports whether hedgehog mints are ingested and executed at
+// height.
+func (p Params) IsMintActive(height int64) bool {
+	return p.MintEnabled && p.IsActive(height)
+}
+
+// WithinMintLimit reports whether amount stays within limit, a nil or zero
+// limit setting no limit.
+func WithinMintLimit(amount, limit math.Int) bool {
+	return limit.IsNil() || limit.IsZero() || amount.LTE(limit)
+}
+
+// IsPollHeight reports whether validators poll hedgehog at height, whose
+// block time is blockTime. lastBlockTime is the time of the previous block,
+// nil when unknown; with a time interval hedgehog is polled in the first block
+// of every interval.
+func (p Params) IsPollHeight(height int64, blockTime time.Time, lastBlockTime *time.Time) bool {
+	if !p.IsActive(height) {
+		return false
+	}
+	if p.PollIntervalBlocks > 0 {
+		return uint64(height)%p.PollIntervalBlocks == 0
+	}
+	if p.PollIntervalTime <= 0 {
+		return false
+	}
+	if lastBlockTime == nil {
+		return true
+	}
+	interval := int64(p.PollIntervalTime)
+	return blockTime.UnixNano()/interval != lastBlockTime.UnixNano()/interval
+}
+
+// validateAddresses validates an allow list of addresses, kind names its
+// entries in errors.
+func validateAddresses(kind string, addrs []string) error {
+	seen := make(map[string]struct{}, len(addrs))
+	for _, addr := range addrs {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid %s address %q: %w", kind, addr, err)
+		}
+		if _, ok := seen[addr]; ok {
+			return fmt.Errorf("duplicate %s address %s", kind, addr)
+		}
+		seen[addr] = struct{}{}
+	}
+	return nil
+}
+
+// SnapshotSizeLimit returns the size of the largest snapshot document
+// validators vote on, MaxSnapshotBytes or its default.
+func (p Params) SnapshotSizeLimit() uint64 {
+	if p.MaxSnapshotBytes == 0 {
+		return DefaultMaxSnapshotBytes
+	}
+	return p.MaxSnapshotBytes
+}
+
+// PartsLimit returns the largest number of parts of an ingested vesting
+// schedule, MaxParts or its default.
+func (p Params) PartsLimit() int32 {
+	if p.MaxParts == 0 {
+		return DefaultMaxParts
+	}
+	return int32(p.MaxParts)
+}
+
+// CliffLimit returns the largest cliff of an ingested vesting schedule,
+// MaxCliff or its default.
+func (p Params) CliffLimit() int32 {
+	if p.MaxCliff == 0 {
+		return DefaultMaxCliff
+	}
+	return int32(p.MaxCliff)
+}
+
+// RetryInterval returns the number of blocks after which a failed conversion
+// is attempted again, RetryIntervalBlocks or its default.
+func (p Params) RetryInterval() int64 {
+	if p.RetryIntervalBlocks == 0 {
+		return DefaultRetryIntervalBlocks
+	}
+	return int64(p.RetryIntervalBlocks)
+}
+
+// ValidateScheduleLimits checks that the parts and the cliff of data are
+// within PartsLimit and CliffLimit.
+func (p Params) ValidateScheduleLimits(data VestingData) error {
+	if limit := p.PartsLimit(); data.Parts > limit {
+		return errorsmod.Wrapf(ErrInvalidVestingData, "parts must be at most %d, got %d", limit, data.Parts)
+	}
+	if limit := p.CliffLimit(); data.Cliff > limit {
+		return errorsmod.Wrapf(ErrInvalidVestingData, "cliff must be at most %d, got %d", limit, data.Cliff)
+	}
+	return nil
+}
+
+// VestingDenoms returns the denoms hedgehog schedules vest, Denom first.
+func (p Params) VestingDenoms() []string {
+	denom := p.Denom
+	if denom == "" {
+		denom = DefaultDenom
+	}
+	return append([]string{denom}, p.AdditionalDenoms...)
+}
+
+func validateDenoms(denom string, additional []string) error {
+	if denom != "" {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid denom: %w", err)
+		}
+	}
+
+	seen := map[string]struct{}{denom: {}}
+	for _, d := range additional {
+		if err := sdk.ValidateDenom(d); err != nil {
+			return fmt.Errorf("invalid additional denom: %w", err)
+		}
+		if _, ok := seen[d]; ok {
+			return fmt.Errorf("duplicate vesting denom %s", d)
+		}
+		seen[d] = struct{}{}
+	}
 	return nil
 }
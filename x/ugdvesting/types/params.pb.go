@@ -4,19 +4,26 @@
 package types
 
 import (
+	bytes "bytes"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,10 +33,75 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// coinPower is the exponent of the display unit of denom, coinPowerValue
+	// must equal 10^coinPower. Both zero leave them unset.
 	CoinPower      uint32 `protobuf:"varint,1,opt,name=coinPower,proto3" json:"coinPower,omitempty"`
 	CoinPowerValue uint64 `protobuf:"varint,2,opt,name=coinPowerValue,proto3" json:"coinPowerValue,omitempty"`
-	Precision      uint32 `protobuf:"varint,3,opt,name=precision,proto3" json:"precision,omitempty"`
-	Denom          string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	// precision is the number of decimals hedgehog amounts may carry, at most
+	// coinPower. Zero allows coinPower decimals.
+	Precision uint32 `protobuf:"varint,3,opt,name=precision,proto3" json:"precision,omitempty"`
+	Denom     string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	// relayers are the addresses allowed to submit vesting batches through
+	// MsgSubmitVestingBatch.
+	Relayers []string `protobuf:"bytes,5,rep,name=relayers,proto3" json:"relayers,omitempty"`
+	// hedgehog_keys are the keys hedgehog signs vesting snapshots with.
+	HedgehogKeys []HedgehogKey `protobuf:"bytes,6,rep,name=hedgehog_keys,json=hedgehogKeys,proto3" json:"hedgehog_keys"`
+	// signature_threshold is the number of distinct hedgehog_keys that must
+	// sign a vesting snapshot before its entries are accepted. Zero disables
+	// signature verification.
+	SignatureThreshold uint32 `protobuf:"varint,7,opt,name=signature_threshold,json=signatureThreshold,proto3" json:"signature_threshold,omitempty"`
+	// additional_denoms are the denoms besides denom a vesting record may vest
+	// through its additional_amounts.
+	AdditionalDenoms []string `protobuf:"bytes,8,rep,name=additional_denoms,json=additionalDenoms,proto3" json:"additional_denoms,omitempty"`
+	// enabled switches hedgehog ingestion on and off. Pending vesting records
+	// are activated either way.
+	Enabled bool `protobuf:"varint,9,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// activation_height is the first height at which hedgehog is polled and
+	// pending vesting records are activated.
+	ActivationHeight int64 `protobuf:"varint,10,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// poll_interval_blocks is the number of blocks between two hedgehog polls.
+	PollIntervalBlocks uint64 `protobuf:"varint,11,opt,name=poll_interval_blocks,json=pollIntervalBlocks,proto3" json:"poll_interval_blocks,omitempty"`
+	// poll_interval_time is the block time between two hedgehog polls. Exactly
+	// one of poll_interval_blocks and poll_interval_time is set while enabled.
+	PollIntervalTime time.Duration `protobuf:"bytes,12,opt,name=poll_interval_time,json=pollIntervalTime,proto3,stdduration" json:"poll_interval_time"`
+	// endpoint_path is the path of the hedgehog endpoint serving the vesting
+	// snapshot.
+	EndpointPath string `protobuf:"bytes,13,opt,name=endpoint_path,json=endpointPath,proto3" json:"endpoint_path,omitempty"`
+	// amendment_operators are the addresses allowed, besides the authority, to
+	// amend pending vesting records through MsgAmendPendingVesting.
+	AmendmentOperators []string `protobuf:"bytes,14,rep,name=amendment_operators,json=amendmentOperators,proto3" json:"amendment_operators,omitempty"`
+	// mint_enabled switches the ingestion of hedgehog mint snapshots on and
+	// off. Mints are only ingested while enabled is set as well.
+	MintEnabled bool `protobuf:"varint,15,opt,name=mint_enabled,json=mintEnabled,proto3" json:"mint_enabled,omitempty"`
+	// mint_endpoint_path is the path of the hedgehog endpoint serving the mint
+	// snapshot.
+	MintEndpointPath string `protobuf:"bytes,16,opt,name=mint_endpoint_path,json=mintEndpointPath,proto3" json:"mint_endpoint_path,omitempty"`
+	// max_mint_amount is the largest amount of denom a single mint may mint.
+	// Zero sets no limit.
+	MaxMintAmount cosmossdk_io_math.Int `protobuf:"bytes,17,opt,name=max_mint_amount,json=maxMintAmount,proto3,customtype=cosmossdk.io/math.Int" json:"max_mint_amount"`
+	// max_mint_per_block is the largest amount of denom minted in a block.
+	// Zero sets no limit.
+	MaxMintPerBlock cosmossdk_io_math.Int `protobuf:"bytes,18,opt,name=max_mint_per_block,json=maxMintPerBlock,proto3,customtype=cosmossdk.io/math.Int" json:"max_mint_per_block"`
+	// max_total_minted is the largest amount of denom the module mints over
+	// its lifetime. Zero sets no limit.
+	MaxTotalMinted cosmossdk_io_math.Int `protobuf:"bytes,19,opt,name=max_total_minted,json=maxTotalMinted,proto3,customtype=cosmossdk.io/math.Int" json:"max_total_minted"`
+	// lock_pending_funds blocks sends of the coins a pending vesting record
+	// schedules from its address until the record is processed. Coins above
+	// the scheduled amounts stay spendable.
+	LockPendingFunds bool `protobuf:"varint,20,opt,name=lock_pending_funds,json=lockPendingFunds,proto3" json:"lock_pending_funds,omitempty"`
+	// max_snapshot_bytes is the size of the largest vesting or mint snapshot
+	// validators vote on. Zero applies the default of 1 MiB.
+	MaxSnapshotBytes uint64 `protobuf:"varint,21,opt,name=max_snapshot_bytes,json=maxSnapshotBytes,proto3" json:"max_snapshot_bytes,omitempty"`
+	// max_parts is the largest number of parts of a vesting schedule that is
+	// ingested. Zero applies the default of 1000.
+	MaxParts uint32 `protobuf:"varint,22,opt,name=max_parts,json=maxParts,proto3" json:"max_parts,omitempty"`
+	// max_cliff is the largest cliff, in periods, of a vesting schedule that is
+	// ingested. Zero applies the default of 1000.
+	MaxCliff uint32 `protobuf:"varint,23,opt,name=max_cliff,json=maxCliff,proto3" json:"max_cliff,omitempty"`
+	// retry_interval_blocks is the number of blocks after which the conversion
+	// of a vesting record that failed is attempted again. Zero applies the
+	// default of 100.
+	RetryIntervalBlocks uint32 `protobuf:"varint,24,opt,name=retry_interval_blocks,json=retryIntervalBlocks,proto3" json:"retry_interval_blocks,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -93,8 +165,193 @@ func (m *Params) GetDenom() string {
 	return ""
 }
 
+func (m *Params) GetRelayers() []string {
+	if m != nil {
+		return m.Relayers
+	}
+	return nil
+}
+
+func (m *Params) GetHedgehogKeys() []HedgehogKey {
+	if m != nil {
+		return m.HedgehogKeys
+	}
+	return nil
+}
+
+func (m *Params) GetSignatureThreshold() uint32 {
+	if m != nil {
+		return m.SignatureThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetAdditionalDenoms() []string {
+	if m != nil {
+		return m.AdditionalDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *Params) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *Params) GetPollIntervalBlocks() uint64 {
+	if m != nil {
+		return m.PollIntervalBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetPollIntervalTime() time.Duration {
+	if m != nil {
+		return m.PollIntervalTime
+	}
+	return 0
+}
+
+func (m *Params) GetEndpointPath() string {
+	if m != nil {
+		return m.EndpointPath
+	}
+	return ""
+}
+
+func (m *Params) GetAmendmentOperators() []string {
+	if m != nil {
+		return m.AmendmentOperators
+	}
+	return nil
+}
+
+func (m *Params) GetMintEnabled() bool {
+	if m != nil {
+		return m.MintEnabled
+	}
+	return false
+}
+
+func (m *Params) GetMintEndpointPath() string {
+	if m != nil {
+		return m.MintEndpointPath
+	}
+	return ""
+}
+
+func (m *Params) GetLockPendingFunds() bool {
+	if m != nil {
+		return m.LockPendingFunds
+	}
+	return false
+}
+
+func (m *Params) GetMaxSnapshotBytes() uint64 {
+	if m != nil {
+		return m.MaxSnapshotBytes
+	}
+	return 0
+}
+
+func (m *Params) GetMaxParts() uint32 {
+	if m != nil {
+		return m.MaxParts
+	}
+	return 0
+}
+
+func (m *Params) GetMaxCliff() uint32 {
+	if m != nil {
+		return m.MaxCliff
+	}
+	return 0
+}
+
+func (m *Params) GetRetryIntervalBlocks() uint32 {
+	if m != nil {
+		return m.RetryIntervalBlocks
+	}
+	return 0
+}
+
+// HedgehogKey is a public key hedgehog signs vesting snapshots with.
+type HedgehogKey struct {
+	// id names the key in the signatures of a snapshot.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// algorithm is the key type, either "ed25519" or "secp256k1".
+	Algorithm string `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	// pub_key is the raw public key, 32 bytes for ed25519 and 33 bytes
+	// (compressed) for secp256k1.
+	PubKey []byte `protobuf:"bytes,3,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+}
+
+func (m *HedgehogKey) Reset()         { *m = HedgehogKey{} }
+func (m *HedgehogKey) String() string { return proto.CompactTextString(m) }
+func (*HedgehogKey) ProtoMessage()    {}
+func (*HedgehogKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c93445ea431edee, []int{1}
+}
+func (m *HedgehogKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HedgehogKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HedgehogKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HedgehogKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HedgehogKey.Merge(m, src)
+}
+func (m *HedgehogKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *HedgehogKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_HedgehogKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HedgehogKey proto.InternalMessageInfo
+
+func (m *HedgehogKey) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *HedgehogKey) GetAlgorithm() string {
+	if m != nil {
+		return m.Algorithm
+	}
+	return ""
+}
+
+func (m *HedgehogKey) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ugdvesting.ugdvesting.Params")
+	proto.RegisterType((*HedgehogKey)(nil), "ugdvesting.ugdvesting.HedgehogKey")
 }
 
 func init() {
@@ -102,25 +359,63 @@ func init() {
 }
 
 var fileDescriptor_8c93445ea431edee = []byte{
-	// 277 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2a, 0x4d, 0x4f, 0x29,
-	0x4b, 0x2d, 0x2e, 0xc9, 0xcc, 0x4b, 0xd7, 0x47, 0x62, 0x16, 0x24, 0x16, 0x25, 0xe6, 0x16, 0xeb,
-	0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x89, 0x22, 0x24, 0xf4, 0x10, 0x4c, 0x29, 0xc1, 0xc4, 0xdc,
-	0xcc, 0xbc, 0x7c, 0x7d, 0x30, 0x09, 0x51, 0x29, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x66, 0xea,
-	0x83, 0x58, 0x10, 0x51, 0xa5, 0xd5, 0x8c, 0x5c, 0x6c, 0x01, 0x60, 0x03, 0x85, 0x64, 0xb8, 0x38,
-	0x93, 0xf3, 0x33, 0xf3, 0x02, 0xf2, 0xcb, 0x53, 0x8b, 0x24, 0x18, 0x15, 0x18, 0x35, 0x78, 0x83,
-	0x10, 0x02, 0x42, 0x6a, 0x5c, 0x7c, 0x70, 0x4e, 0x58, 0x62, 0x4e, 0x69, 0xaa, 0x04, 0x93, 0x02,
-	0xa3, 0x06, 0x4b, 0x10, 0x9a, 0x28, 0xc8, 0x94, 0x82, 0xa2, 0xd4, 0xe4, 0xcc, 0xe2, 0xcc, 0xfc,
-	0x3c, 0x09, 0x66, 0x88, 0x29, 0x70, 0x01, 0x21, 0x11, 0x2e, 0xd6, 0x94, 0xd4, 0xbc, 0xfc, 0x5c,
-	0x09, 0x16, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x08, 0xc7, 0x4a, 0xfd, 0xc5, 0x02, 0x79, 0xc6, 0xae,
-	0xe7, 0x1b, 0xb4, 0xe4, 0x90, 0xbc, 0x59, 0x81, 0xec, 0x67, 0x88, 0x13, 0x9d, 0xb2, 0x4f, 0x3c,
-	0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e,
-	0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x2a, 0x30, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49,
-	0x2f, 0x39, 0x3f, 0x57, 0xbf, 0x34, 0x2f, 0x33, 0xbd, 0x28, 0x33, 0x45, 0xb7, 0xa0, 0x28, 0x3f,
-	0x2b, 0x35, 0xb9, 0x44, 0x3f, 0x39, 0xbf, 0x38, 0x37, 0xbf, 0x58, 0xb7, 0x38, 0x25, 0x5b, 0x17,
-	0x26, 0x95, 0x91, 0x9a, 0x92, 0x9e, 0x9a, 0x91, 0x9f, 0xae, 0x8b, 0xd5, 0xb6, 0x92, 0xca, 0x82,
-	0xd4, 0xe2, 0x24, 0x36, 0x70, 0x08, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x5d, 0x4e, 0x57,
-	0x88, 0x87, 0x01, 0x00, 0x00,
+	// 888 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x26, 0x69, 0x12, 0x8f, 0xed, 0xc4, 0x99, 0x38, 0x74, 0x5a, 0x90, 0x63, 0x82, 0x04,
+	0x56, 0xc1, 0xeb, 0x2a, 0x70, 0xea, 0xad, 0xa6, 0xa0, 0x46, 0x55, 0x84, 0xb5, 0x8d, 0x2a, 0x14,
+	0x09, 0xad, 0xc6, 0x9e, 0x97, 0xdd, 0x21, 0xbb, 0x33, 0xab, 0x99, 0xd9, 0x60, 0xdf, 0x39, 0x71,
+	0xe2, 0xc8, 0x91, 0x23, 0xc7, 0x1e, 0xfa, 0x47, 0xf4, 0x58, 0xf5, 0x84, 0x38, 0x14, 0x94, 0x1c,
+	0xca, 0x9f, 0x81, 0x66, 0x76, 0xd7, 0x76, 0xa1, 0x12, 0x52, 0x2f, 0xab, 0x79, 0xdf, 0xf7, 0xe6,
+	0x9b, 0xf7, 0x6b, 0x1f, 0x3a, 0xcc, 0x23, 0x76, 0x09, 0xda, 0x70, 0x11, 0x0d, 0x96, 0x8e, 0x19,
+	0x55, 0x34, 0xd5, 0x7e, 0xa6, 0xa4, 0x91, 0x78, 0x7f, 0x41, 0xf8, 0x8b, 0xe3, 0xed, 0x5d, 0x9a,
+	0x72, 0x21, 0x07, 0xee, 0x5b, 0x78, 0xde, 0xbe, 0x35, 0x91, 0x3a, 0x95, 0x3a, 0x74, 0xd6, 0xa0,
+	0x30, 0x4a, 0xaa, 0x1d, 0xc9, 0x48, 0x16, 0xb8, 0x3d, 0x95, 0x68, 0x27, 0x92, 0x32, 0x4a, 0x60,
+	0xe0, 0xac, 0x71, 0x7e, 0x3e, 0x60, 0xb9, 0xa2, 0x86, 0x4b, 0x51, 0xf0, 0x87, 0x3f, 0x22, 0xb4,
+	0x31, 0x72, 0xb1, 0xe0, 0x0f, 0x50, 0x6d, 0x22, 0xb9, 0x18, 0xc9, 0x1f, 0x40, 0x11, 0xaf, 0xeb,
+	0xf5, 0x9a, 0xc1, 0x02, 0xc0, 0x1f, 0xa3, 0xed, 0xb9, 0xf1, 0x84, 0x26, 0x39, 0x90, 0xd5, 0xae,
+	0xd7, 0x5b, 0x0f, 0xfe, 0x85, 0x5a, 0x95, 0x4c, 0xc1, 0x84, 0x6b, 0x2e, 0x05, 0x59, 0x2b, 0x54,
+	0xe6, 0x00, 0x6e, 0xa3, 0x1b, 0x0c, 0x84, 0x4c, 0xc9, 0x7a, 0xd7, 0xeb, 0xd5, 0x82, 0xc2, 0xc0,
+	0x5f, 0xa0, 0x2d, 0x05, 0x09, 0x9d, 0x81, 0xd2, 0xe4, 0x46, 0x77, 0xad, 0x57, 0x1b, 0x92, 0x97,
+	0xcf, 0xfa, 0xed, 0x32, 0xbd, 0xfb, 0x8c, 0x29, 0xd0, 0xfa, 0xb1, 0x51, 0x5c, 0x44, 0xc1, 0xdc,
+	0x13, 0x07, 0xa8, 0x19, 0x03, 0x8b, 0x20, 0x96, 0x51, 0x78, 0x01, 0x33, 0x4d, 0x36, 0xba, 0x6b,
+	0xbd, 0xfa, 0xd1, 0xa1, 0xff, 0xd6, 0x6a, 0xfa, 0x0f, 0x4b, 0xdf, 0x47, 0x30, 0x1b, 0xd6, 0x9e,
+	0xbf, 0x3a, 0x58, 0xf9, 0xed, 0xf5, 0xd3, 0x3b, 0x5e, 0xd0, 0x88, 0x17, 0xb8, 0xc6, 0x03, 0xb4,
+	0xa7, 0x79, 0x24, 0xa8, 0xc9, 0x15, 0x84, 0x26, 0x56, 0xa0, 0x63, 0x99, 0x30, 0xb2, 0xe9, 0xf2,
+	0xc0, 0x73, 0xea, 0xb4, 0x62, 0xf0, 0xa7, 0x68, 0x97, 0x32, 0xc6, 0x6d, 0x45, 0x69, 0x12, 0xba,
+	0x74, 0x34, 0xd9, 0xb2, 0x39, 0x04, 0xad, 0x05, 0xf1, 0xc0, 0xe1, 0x98, 0xa0, 0x4d, 0x10, 0x74,
+	0x9c, 0x00, 0x23, 0xb5, 0xae, 0xd7, 0xdb, 0x0a, 0x2a, 0xd3, 0xc9, 0x4c, 0x0c, 0xbf, 0x74, 0xad,
+	0x09, 0x63, 0xe0, 0x51, 0x6c, 0x08, 0xea, 0x7a, 0xbd, 0xb5, 0xa0, 0xb5, 0x20, 0x1e, 0x3a, 0x1c,
+	0xdf, 0x45, 0xed, 0x4c, 0x26, 0x49, 0xc8, 0x85, 0x01, 0x75, 0x49, 0x93, 0x70, 0x9c, 0xc8, 0xc9,
+	0x85, 0x26, 0x75, 0xd7, 0x10, 0x6c, 0xb9, 0xe3, 0x92, 0x1a, 0x3a, 0x06, 0x3f, 0x41, 0xf8, 0xcd,
+	0x1b, 0x86, 0xa7, 0x40, 0x1a, 0x5d, 0xaf, 0x57, 0x3f, 0xba, 0xe5, 0x17, 0x23, 0xe2, 0x57, 0x23,
+	0xe2, 0x3f, 0x28, 0x47, 0x64, 0xd8, 0xb4, 0x65, 0xfa, 0xe5, 0xcf, 0x03, 0xaf, 0x28, 0x55, 0x6b,
+	0x59, 0xf9, 0x94, 0xa7, 0x80, 0x3f, 0x42, 0x4d, 0x10, 0x2c, 0x93, 0x5c, 0x98, 0x30, 0xa3, 0x26,
+	0x26, 0x4d, 0xd7, 0xd6, 0x46, 0x05, 0x8e, 0xa8, 0x89, 0xf1, 0x31, 0xda, 0xa3, 0x29, 0x08, 0x96,
+	0x82, 0x30, 0xa1, 0xcc, 0x40, 0x51, 0x23, 0x95, 0x26, 0xdb, 0xff, 0xd3, 0x68, 0x3c, 0xbf, 0xf4,
+	0x4d, 0x75, 0x07, 0x7f, 0x88, 0x1a, 0xa9, 0x7d, 0xab, 0xaa, 0xe2, 0x8e, 0xab, 0x62, 0xdd, 0x62,
+	0x5f, 0x95, 0x95, 0xfc, 0x0c, 0xe1, 0xd2, 0x65, 0x39, 0xae, 0x96, 0x8b, 0xab, 0x55, 0x38, 0x2e,
+	0xc5, 0xf6, 0x2d, 0xda, 0x49, 0xe9, 0x34, 0x74, 0x37, 0x68, 0x2a, 0x73, 0x61, 0xc8, 0xae, 0x75,
+	0x1d, 0xde, 0xb5, 0xa9, 0xff, 0xf1, 0xea, 0x60, 0xbf, 0x88, 0x4d, 0xb3, 0x0b, 0x9f, 0xcb, 0x41,
+	0x4a, 0x4d, 0xec, 0x1f, 0x0b, 0xf3, 0xf2, 0x59, 0x1f, 0x95, 0x41, 0x1f, 0x0b, 0x53, 0x54, 0xa7,
+	0x99, 0xd2, 0xe9, 0x09, 0x17, 0xe6, 0xbe, 0x93, 0xc1, 0xdf, 0x21, 0x3c, 0x57, 0xce, 0x40, 0x15,
+	0x3d, 0x22, 0xf8, 0x1d, 0xc5, 0x77, 0x4a, 0xf1, 0x11, 0x28, 0xd7, 0x52, 0x7c, 0x86, 0x5a, 0x56,
+	0xde, 0x48, 0x43, 0x13, 0xf7, 0x08, 0x30, 0xb2, 0xf7, 0x8e, 0xe2, 0xdb, 0x29, 0x9d, 0x9e, 0x5a,
+	0xa1, 0x13, 0xa7, 0x63, 0x4b, 0x68, 0xdf, 0x08, 0x33, 0x10, 0x8c, 0x8b, 0x28, 0x3c, 0xcf, 0x05,
+	0xd3, 0xa4, 0xed, 0x6a, 0xdd, 0xb2, 0xcc, 0xa8, 0x20, 0xbe, 0xb6, 0xb8, 0x2b, 0x38, 0x9d, 0x86,
+	0x5a, 0xd0, 0x4c, 0xc7, 0xd2, 0x84, 0xe3, 0x99, 0x01, 0x4d, 0xf6, 0xdd, 0x2c, 0xda, 0x18, 0x1f,
+	0x97, 0xc4, 0xd0, 0xe2, 0xf8, 0x7d, 0x54, 0xb3, 0xde, 0x19, 0x55, 0x46, 0x93, 0xf7, 0xdc, 0x6f,
+	0xb5, 0x95, 0xd2, 0xe9, 0xc8, 0xda, 0x15, 0x39, 0x49, 0xf8, 0xf9, 0x39, 0xb9, 0x39, 0x27, 0xbf,
+	0xb4, 0x36, 0x3e, 0x42, 0xfb, 0x0a, 0x8c, 0x9a, 0xfd, 0x67, 0xec, 0x89, 0x73, 0xdc, 0x73, 0xe4,
+	0x9b, 0x73, 0x7f, 0xef, 0x93, 0xbf, 0x7f, 0x3d, 0xf0, 0x7e, 0x7a, 0xfd, 0xf4, 0x4e, 0x67, 0x69,
+	0xf5, 0x4e, 0x97, 0xf7, 0x70, 0xb1, 0xfb, 0x0e, 0xcf, 0x50, 0x7d, 0x69, 0x3f, 0xe0, 0x6d, 0xb4,
+	0xca, 0x99, 0xdb, 0x81, 0xb5, 0x60, 0x95, 0x33, 0xbb, 0xd4, 0x68, 0x12, 0x49, 0xc5, 0x4d, 0x9c,
+	0xba, 0xbd, 0x57, 0x0b, 0x16, 0x00, 0xbe, 0x89, 0x36, 0xb3, 0x7c, 0x6c, 0x77, 0x90, 0x5b, 0x78,
+	0x8d, 0x60, 0x23, 0xcb, 0xc7, 0x8f, 0x60, 0x76, 0x6f, 0xdd, 0x3e, 0x3f, 0x8c, 0x9e, 0x5f, 0x75,
+	0xbc, 0x17, 0x57, 0x1d, 0xef, 0xaf, 0xab, 0x8e, 0xf7, 0xf3, 0x75, 0x67, 0xe5, 0xc5, 0x75, 0x67,
+	0xe5, 0xf7, 0xeb, 0xce, 0xca, 0xd9, 0x49, 0xc4, 0x4d, 0x9c, 0x8f, 0xfd, 0x89, 0x4c, 0x07, 0xb9,
+	0xe0, 0x91, 0xe2, 0xac, 0x9f, 0x29, 0xf9, 0x3d, 0x4c, 0x4c, 0xb9, 0xdb, 0xfb, 0x15, 0x5c, 0x6d,
+	0xa9, 0xfe, 0x5b, 0xb3, 0x30, 0xb3, 0x0c, 0xf4, 0x78, 0xc3, 0xfd, 0xc1, 0x9f, 0xff, 0x33, 0x00,
+	0x3c, 0x9d, 0xfe, 0x1a, 0x73, 0x06, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -154,6 +449,116 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.Denom != that1.Denom {
 		return false
 	}
+	if len(this.Relayers) != len(that1.Relayers) {
+		return false
+	}
+	for i := range this.Relayers {
+		if this.Relayers[i] != that1.Relayers[i] {
+			return false
+		}
+	}
+	if len(this.HedgehogKeys) != len(that1.HedgehogKeys) {
+		return false
+	}
+	for i := range this.HedgehogKeys {
+		if !this.HedgehogKeys[i].Equal(&that1.HedgehogKeys[i]) {
+			return false
+		}
+	}
+	if this.SignatureThreshold != that1.SignatureThreshold {
+		return false
+	}
+	if len(this.AdditionalDenoms) != len(that1.AdditionalDenoms) {
+		return false
+	}
+	for i := range this.AdditionalDenoms {
+		if this.AdditionalDenoms[i] != that1.AdditionalDenoms[i] {
+			return false
+		}
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.ActivationHeight != that1.ActivationHeight {
+		return false
+	}
+	if this.PollIntervalBlocks != that1.PollIntervalBlocks {
+		return false
+	}
+	if this.PollIntervalTime != that1.PollIntervalTime {
+		return false
+	}
+	if this.EndpointPath != that1.EndpointPath {
+		return false
+	}
+	if len(this.AmendmentOperators) != len(that1.AmendmentOperators) {
+		return false
+	}
+	for i := range this.AmendmentOperators {
+		if this.AmendmentOperators[i] != that1.AmendmentOperators[i] {
+			return false
+		}
+	}
+	if this.MintEnabled != that1.MintEnabled {
+		return false
+	}
+	if this.MintEndpointPath != that1.MintEndpointPath {
+		return false
+	}
+	if !this.MaxMintAmount.Equal(that1.MaxMintAmount) {
+		return false
+	}
+	if !this.MaxMintPerBlock.Equal(that1.MaxMintPerBlock) {
+		return false
+	}
+	if !this.MaxTotalMinted.Equal(that1.MaxTotalMinted) {
+		return false
+	}
+	if this.LockPendingFunds != that1.LockPendingFunds {
+		return false
+	}
+	if this.MaxSnapshotBytes != that1.MaxSnapshotBytes {
+		return false
+	}
+	if this.MaxParts != that1.MaxParts {
+		return false
+	}
+	if this.MaxCliff != that1.MaxCliff {
+		return false
+	}
+	if this.RetryIntervalBlocks != that1.RetryIntervalBlocks {
+		return false
+	}
+	return true
+}
+func (this *HedgehogKey) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HedgehogKey)
+	if !ok {
+		that2, ok := that.(HedgehogKey)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Algorithm != that1.Algorithm {
+		return false
+	}
+	if !bytes.Equal(this.PubKey, that1.PubKey) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -176,6 +581,182 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RetryIntervalBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RetryIntervalBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.MaxCliff != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxCliff))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.MaxParts != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxParts))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.MaxSnapshotBytes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxSnapshotBytes))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.LockPendingFunds {
+		i--
+		if m.LockPendingFunds {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	{
+		size := m.MaxTotalMinted.Size()
+		i -= size
+		if _, err := m.MaxTotalMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	{
+		size := m.MaxMintPerBlock.Size()
+		i -= size
+		if _, err := m.MaxMintPerBlock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size := m.MaxMintAmount.Size()
+		i -= size
+		if _, err := m.MaxMintAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if len(m.MintEndpointPath) > 0 {
+		i -= len(m.MintEndpointPath)
+		copy(dAtA[i:], m.MintEndpointPath)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MintEndpointPath)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.MintEnabled {
+		i--
+		if m.MintEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.AmendmentOperators) > 0 {
+		for iNdEx := len(m.AmendmentOperators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AmendmentOperators[iNdEx])
+			copy(dAtA[i:], m.AmendmentOperators[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AmendmentOperators[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.EndpointPath) > 0 {
+		i -= len(m.EndpointPath)
+		copy(dAtA[i:], m.EndpointPath)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.EndpointPath)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.PollIntervalTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PollIntervalTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x62
+	if m.PollIntervalBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PollIntervalBlocks))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.AdditionalDenoms) > 0 {
+		for iNdEx := len(m.AdditionalDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AdditionalDenoms[iNdEx])
+			copy(dAtA[i:], m.AdditionalDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AdditionalDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.SignatureThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SignatureThreshold))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.HedgehogKeys) > 0 {
+		for iNdEx := len(m.HedgehogKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HedgehogKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Relayers) > 0 {
+		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Relayers[iNdEx])
+			copy(dAtA[i:], m.Relayers[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Relayers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Denom) > 0 {
 		i -= len(m.Denom)
 		copy(dAtA[i:], m.Denom)
@@ -201,12 +782,56 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
+func (m *HedgehogKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HedgehogKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HedgehogKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Algorithm) > 0 {
+		i -= len(m.Algorithm)
+		copy(dAtA[i:], m.Algorithm)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Algorithm)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
 		offset++
 	}
 	dAtA[offset] = uint8(v)
@@ -231,6 +856,97 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if len(m.Relayers) > 0 {
+		for _, s := range m.Relayers {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.HedgehogKeys) > 0 {
+		for _, e := range m.HedgehogKeys {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.SignatureThreshold != 0 {
+		n += 1 + sovParams(uint64(m.SignatureThreshold))
+	}
+	if len(m.AdditionalDenoms) > 0 {
+		for _, s := range m.AdditionalDenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovParams(uint64(m.ActivationHeight))
+	}
+	if m.PollIntervalBlocks != 0 {
+		n += 1 + sovParams(uint64(m.PollIntervalBlocks))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PollIntervalTime)
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.EndpointPath)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.AmendmentOperators) > 0 {
+		for _, s := range m.AmendmentOperators {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MintEnabled {
+		n += 2
+	}
+	l = len(m.MintEndpointPath)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	l = m.MaxMintAmount.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.MaxMintPerBlock.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.MaxTotalMinted.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.LockPendingFunds {
+		n += 3
+	}
+	if m.MaxSnapshotBytes != 0 {
+		n += 2 + sovParams(uint64(m.MaxSnapshotBytes))
+	}
+	if m.MaxParts != 0 {
+		n += 2 + sovParams(uint64(m.MaxParts))
+	}
+	if m.MaxCliff != 0 {
+		n += 2 + sovParams(uint64(m.MaxCliff))
+	}
+	if m.RetryIntervalBlocks != 0 {
+		n += 2 + sovParams(uint64(m.RetryIntervalBlocks))
+	}
+	return n
+}
+
+func (m *HedgehogKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Algorithm)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -358,6 +1074,676 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HedgehogKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HedgehogKeys = append(m.HedgehogKeys, HedgehogKey{})
+			if err := m.HedgehogKeys[len(m.HedgehogKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureThreshold", wireType)
+			}
+			m.SignatureThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignatureThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalDenoms = append(m.AdditionalDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollIntervalBlocks", wireType)
+			}
+			m.PollIntervalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PollIntervalBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollIntervalTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.PollIntervalTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndpointPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndpointPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmendmentOperators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmendmentOperators = append(m.AmendmentOperators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MintEnabled = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintEndpointPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintEndpointPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMintAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxMintAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMintPerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxMintPerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTotalMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockPendingFunds", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LockPendingFunds = bool(v != 0)
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSnapshotBytes", wireType)
+			}
+			m.MaxSnapshotBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSnapshotBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxParts", wireType)
+			}
+			m.MaxParts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxParts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCliff", wireType)
+			}
+			m.MaxCliff = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCliff |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryIntervalBlocks", wireType)
+			}
+			m.RetryIntervalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryIntervalBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HedgehogKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HedgehogKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HedgehogKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Algorithm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
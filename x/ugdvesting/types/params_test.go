@@ -0,0 +1,271 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/stretchr/testify/require"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/sample"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+func TestParamsDenoms(t *testing.T) {
+	tests := []struct {
+		name       string
+		denom      string
+		additional []string
+		denoms     []string
+		valid      bool
+	}{
+		{name: "default denom", denoms: []string{types.DefaultDenom}, valid: true},
+		{name: "configured denom", denom: "utest", denoms: []string{"utest"}, valid: true},
+		{name: "additional denoms", denom: "utest", additional: []string{"uatom", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"}, valid: true,
+			denoms: []string{"utest", "uatom", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"}},
+		{name: "invalid denom", denom: "1x"},
+		{name: "invalid additional denom", denom: "utest", additional: []string{"!"}},
+		{name: "duplicate denom", denom: "utest", additional: []string{"utest"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.Params{Denom: tc.denom, AdditionalDenoms: tc.additional}
+			if !tc.valid {
+				require.Error(t, params.Validate())
+				return
+			}
+			require.NoError(t, params.Validate())
+			require.Equal(t, tc.denoms, params.VestingDenoms())
+		})
+	}
+}
+
+// enabledParams returns the default params with ingestion enabled and a
+// signer set of one key.
+func enabledParams() types.Params {
+	params := types.DefaultParams()
+	params.Enabled = true
+	params.HedgehogKeys = []types.HedgehogKey{{Id: "a", Algorithm: types.KeyAlgorithmEd25519, PubKey: ed25519.GenPrivKey().PubKey().Bytes()}}
+	params.SignatureThreshold = 1
+	return params
+}
+
+func TestParamsIngestion(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*types.Params)
+		valid  bool
+	}{
+		{name: "default", modify: func(p *types.Params) { *p = types.DefaultParams() }, valid: true},
+		{name: "enabled", modify: func(*types.Params) {}, valid: true},
+		{name: "enabled without signature threshold", modify: func(p *types.Params) { p.SignatureThreshold = 0 }},
+		{name: "enabled without signer set", modify: func(p *types.Params) {
+			p.HedgehogKeys = nil
+			p.SignatureThreshold = 0
+		}},
+		{name: "disabled without signer set", modify: func(p *types.Params) {
+			p.Enabled = false
+			p.HedgehogKeys = nil
+			p.SignatureThreshold = 0
+		}, valid: true},
+		{name: "disabled without interval and path", modify: func(p *types.Params) {
+			*p = types.Params{}
+		}, valid: true},
+		{name: "time interval", modify: func(p *types.Params) {
+			p.PollIntervalBlocks = 0
+			p.PollIntervalTime = time.Minute
+		}, valid: true},
+		{name: "activation height", modify: func(p *types.Params) { p.ActivationHeight = 50 }, valid: true},
+		{name: "negative activation height", modify: func(p *types.Params) { p.ActivationHeight = -1 }},
+		{name: "both intervals", modify: func(p *types.Params) { p.PollIntervalTime = time.Minute }},
+		{name: "negative time interval", modify: func(p *types.Params) {
+			p.PollIntervalBlocks = 0
+			p.PollIntervalTime = -time.Minute
+		}},
+		{name: "enabled without interval", modify: func(p *types.Params) { p.PollIntervalBlocks = 0 }},
+		{name: "enabled without path", modify: func(p *types.Params) { p.EndpointPath = "" }},
+		{name: "relative path", modify: func(p *types.Params) { p.EndpointPath = "gridspork/vesting-storage" }},
+		{name: "path with host", modify: func(p *types.Params) { p.EndpointPath = "https://example.com/vesting" }},
+		{name: "path with query", modify: func(p *types.Params) { p.EndpointPath = "/vesting?all=true" }},
+		{name: "invalid path while disabled", modify: func(p *types.Params) {
+			p.Enabled = false
+			p.EndpointPath = "//example.com"
+		}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := enabledParams()
+			tc.modify(&params)
+			if tc.valid {
+				require.NoError(t, params.Validate())
+			} else {
+				require.Error(t, params.Validate())
+			}
+		})
+	}
+}
+
+func TestParamsMint(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*types.Params)
+		valid  bool
+	}{
+		{name: "default", modify: func(*types.Params) {}, valid: true},
+		{name: "enabled with caps", modify: func(p *types.Params) {
+			*p = enabledParams()
+			p.MintEnabled = true
+			p.MaxMintAmount = math.NewInt(100)
+			p.MaxMintPerBlock = math.NewInt(1000)
+			p.MaxTotalMinted = math.NewInt(10000)
+		}, valid: true},
+		{name: "unset caps", modify: func(p *types.Params) {
+			p.MaxMintAmount = math.Int{}
+			p.MaxMintPerBlock = math.Int{}
+			p.MaxTotalMinted = math.Int{}
+		}, valid: true},
+		{name: "negative mint cap", modify: func(p *types.Params) { p.MaxMintAmount = math.NewInt(-1) }},
+		{name: "negative block cap", modify: func(p *types.Params) { p.MaxMintPerBlock = math.NewInt(-1) }},
+		{name: "negative total cap", modify: func(p *types.Params) { p.MaxTotalMinted = math.NewInt(-1) }},
+		{name: "enabled without path", modify: func(p *types.Params) {
+			*p = enabledParams()
+			p.MintEnabled = true
+			p.MintEndpointPath = ""
+		}},
+		{name: "enabled without signature threshold", modify: func(p *types.Params) { p.MintEnabled = true }},
+		{name: "enabled without caps", modify: func(p *types.Params) {
+			*p = enabledParams()
+			p.MintEnabled = true
+		}},
+		{name: "enabled without total cap", modify: func(p *types.Params) {
+			*p = enabledParams()
+			p.MintEnabled = true
+			p.MaxMintAmount = math.NewInt(100)
+			p.MaxMintPerBlock = math.NewInt(1000)
+		}},
+		{name: "disabled without path", modify: func(p *types.Params) { p.MintEndpointPath = "" }, valid: true},
+		{name: "invalid path", modify: func(p *types.Params) { p.MintEndpointPath = "gridspork/mint-storage" }},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.modify(&params)
+			if tc.valid {
+				require.NoError(t, params.Validate())
+			} else {
+				require.Error(t, params.Validate())
+			}
+		})
+	}
+}
+
+func TestParamsCoinPower(t *testing.T) {
+	tests := []struct {
+		name      string
+		power     uint32
+		value     uint64
+		precision uint32
+		valid     bool
+	}{
+		{name: "unset", valid: true},
+		{name: "zero power", value: 1, valid: true},
+		{name: "8 decimals", power: 8, value: 100_000_000, precision: 8, valid: true},
+		{name: "18 decimals", power: 18, value: 1_000_000_000_000_000_000, valid: true},
+		{name: "value without power", value: 10},
+		{name: "power without value", power: 8},
+		{name: "mismatched value", power: 8, value: 10_000_000},
+		{name: "power too large", power: 19, value: 10_000_000_000_000_000_000},
+		{name: "precision above power", power: 6, value: 1_000_000, precision: 8},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.CoinPower = tc.power
+			params.CoinPowerValue = tc.value
+			params.Precision = tc.precision
+			if tc.valid {
+				require.NoError(t, params.Validate())
+			} else {
+				require.Error(t, params.Validate())
+			}
+		})
+	}
+}
+
+func TestParamsAllowLists(t *testing.T) {
+	addr := sample.AccAddress()
+	tests := []struct {
+		name   string
+		modify func(*types.Params)
+		valid  bool
+	}{
+		{name: "relayers and operators", modify: func(p *types.Params) {
+			p.Relayers = []string{addr}
+			p.AmendmentOperators = []string{addr, sample.AccAddress()}
+		}, valid: true},
+		{name: "invalid relayer", modify: func(p *types.Params) { p.Relayers = []string{"invalid"} }},
+		{name: "invalid operator", modify: func(p *types.Params) { p.AmendmentOperators = []string{"invalid"} }},
+		{name: "duplicate operator", modify: func(p *types.Params) { p.AmendmentOperators = []string{addr, addr} }},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.modify(&params)
+			if tc.valid {
+				require.NoError(t, params.Validate())
+			} else {
+				require.Error(t, params.Validate())
+			}
+		})
+	}
+}
+
+func TestParamsScheduleLimits(t *testing.T) {
+	params := types.DefaultParams()
+	require.EqualValues(t, types.DefaultMaxParts, params.PartsLimit())
+	require.EqualValues(t, types.DefaultMaxCliff, params.CliffLimit())
+
+	// unset limits apply the defaults
+	params.MaxParts = 0
+	params.MaxCliff = 0
+	require.NoError(t, params.Validate())
+	require.EqualValues(t, types.DefaultMaxParts, params.PartsLimit())
+	require.EqualValues(t, types.DefaultMaxCliff, params.CliffLimit())
+
+	params.MaxParts = types.MaxScheduleParts
+	params.MaxCliff = types.MaxScheduleParts
+	require.NoError(t, params.Validate())
+	params.MaxParts = types.MaxScheduleParts + 1
+	require.Error(t, params.Validate())
+	params.MaxParts = types.MaxScheduleParts
+	params.MaxCliff = types.MaxScheduleParts + 1
+	require.Error(t, params.Validate())
+}
+
+func TestParamsIsPollHeight(t *testing.T) {
+	params := enabledParams()
+	params.ActivationHeight = 20
+	now := time.Date(2024, 1, 1, 0, 0, 30, 0, time.UTC)
+
+	require.False(t, params.IsPollHeight(10, now, nil), "before activation")
+	require.True(t, params.IsPollHeight(20, now, nil))
+	require.False(t, params.IsPollHeight(21, now, nil))
+
+	params.Enabled = false
+	require.False(t, params.IsPollHeight(30, now, nil), "disabled")
+
+	params.Enabled = true
+	params.PollIntervalBlocks = 0
+	params.PollIntervalTime = time.Minute
+	last := now.Add(-5 * time.Second)
+	require.True(t, params.IsPollHeight(25, now, nil), "unknown last block time")
+	require.False(t, params.IsPollHeight(25, now, &last), "same interval")
+	next := now.Add(30 * time.Second)
+	require.True(t, params.IsPollHeight(26, next, &now), "first block of the next interval")
+}
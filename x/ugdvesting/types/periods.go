@@ -0,0 +1,105 @@
+package types
+
+import (
+	"math"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// BuildPeriods splits vested into the periods of the hedgehog schedule of
+// data and returns them with the time the schedule starts at. Every period
+// lasts data.Duration seconds and every denom is split the same way:
+//
+//   - data.Percent of vested is released by the first period, the TGE. Without
+//     TGE the schedule starts one period after start instead.
+//   - The rest is split in data.Parts equal parts, one per period. With a
+//     cliff, the first part is released over data.Cliff periods.
+//   - Rounding leftovers are released by the last period.
+//
+// The periods always add up to vested.
+func BuildPeriods(data VestingData, vested sdk.Coins, start time.Time) (vestingtypes.Periods, time.Time, error) {
+	if err := validateSchedule(data); err != nil {
+		return nil, time.Time{}, err
+	}
+	if !vested.IsValid() || vested.IsZero() {
+		return nil, time.Time{}, errorsmod.Wrapf(ErrInvalidSchedule, "invalid vested coins %s", vested)
+	}
+
+	startUnix := start.Unix()
+	if data.Percent == 0 {
+		if startUnix > math.MaxInt64-data.Duration {
+			return nil, time.Time{}, errorsmod.Wrap(ErrInvalidSchedule, "start time overflows")
+		}
+		startUnix += data.Duration
+	}
+
+	// TGE amount and the amount left for the parts
+	tgeAmount := sdk.NewCoins()
+	remainingAmount := sdk.NewCoins()
+	amountPerPart := sdk.NewCoins()
+	for _, coin := range vested {
+		tge := coin.Amount.MulRaw(int64(data.Percent)).QuoRaw(100)
+		remaining := coin.Amount.Sub(tge)
+		tgeAmount = tgeAmount.Add(sdk.NewCoin(coin.Denom, tge))
+		remainingAmount = remainingAmount.Add(sdk.NewCoin(coin.Denom, remaining))
+		amountPerPart = amountPerPart.Add(sdk.NewCoin(coin.Denom, remaining.QuoRaw(int64(data.Parts))))
+	}
+
+	periods := vestingtypes.Periods{}
+	addPeriod := func(amount sdk.Coins) {
+		periods = append(periods, vestingtypes.Period{Length: data.Duration, Amount: amount})
+	}
+
+	if data.Percent > 0 {
+		addPeriod(tgeAmount)
+	}
+
+	parts := int(data.Parts)
+	if data.Cliff > 0 {
+		// the first part ramps up over the cliff periods
+		rampUpAmountPerCliffPeriod := sdk.NewCoins()
+		for _, coin := range amountPerPart {
+			rampUpAmountPerCliffPeriod = rampUpAmountPerCliffPeriod.Add(sdk.NewCoin(coin.Denom, coin.Amount.QuoRaw(int64(data.Cliff))))
+		}
+		for i := 0; i < int(data.Cliff); i++ {
+			addPeriod(rampUpAmountPerCliffPeriod)
+		}
+		parts--
+	}
+
+	for i := 0; i < parts; i++ {
+		addPeriod(amountPerPart)
+	}
+
+	// the last period releases the rounding leftovers
+	total := sdk.NewCoins()
+	for _, period := range periods {
+		total = total.Add(period.Amount...)
+	}
+	leftover, hasNeg := vested.SafeSub(total...)
+	if hasNeg {
+		return nil, time.Time{}, errorsmod.Wrapf(ErrInvalidSchedule, "vesting periods add up to %s, more than %s", total, vested)
+	}
+	last := len(periods) - 1
+	periods[last].Amount = periods[last].Amount.Add(leftover...)
+
+	return periods, time.Unix(startUnix, 0).UTC(), nil
+}
+
+// validateSchedule checks the fields of data BuildPeriods depends on.
+func validateSchedule(data VestingData) error {
+	switch {
+	case data.Duration <= 0:
+		return errorsmod.Wrapf(ErrInvalidSchedule, "duration must be positive, got %d", data.Duration)
+	case data.Parts <= 0 || data.Parts > MaxScheduleParts:
+		return errorsmod.Wrapf(ErrInvalidSchedule, "parts must be between 1 and %d, got %d", MaxScheduleParts, data.Parts)
+	case data.Percent < 0 || data.Percent > 100:
+		return errorsmod.Wrapf(ErrInvalidSchedule, "percent must be between 0 and 100, got %d", data.Percent)
+	case data.Cliff < 0 || data.Cliff > MaxScheduleParts:
+		return errorsmod.Wrapf(ErrInvalidSchedule, "cliff must be between 0 and %d, got %d", MaxScheduleParts, data.Cliff)
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+func periodsOf(length int64, amounts ...sdk.Coins) vestingtypes.Periods {
+	periods := vestingtypes.Periods{}
+	for _, amount := range amounts {
+		periods = append(periods, vestingtypes.Period{Length: length, Amount: amount})
+	}
+	return periods
+}
+
+func TestBuildPeriods(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("uugd", amount)) }
+
+	tests := []struct {
+		name    string
+		data    types.VestingData
+		vested  sdk.Coins
+		periods vestingtypes.Periods
+		start   time.Time
+		err     bool
+	}{
+		{
+			name:    "parts without tge start one period later",
+			data:    types.VestingData{Duration: 60, Parts: 4},
+			vested:  coins(1000),
+			periods: periodsOf(60, coins(250), coins(250), coins(250), coins(250)),
+			start:   start.Add(time.Minute),
+		},
+		{
+			name:    "tge",
+			data:    types.VestingData{Duration: 60, Parts: 4, Percent: 10},
+			vested:  coins(1000),
+			periods: periodsOf(60, coins(100), coins(225), coins(225), coins(225), coins(225)),
+			start:   start,
+		},
+		{
+			name:    "rounding leftovers go to the last period",
+			data:    types.VestingData{Duration: 60, Parts: 4},
+			vested:  coins(1003),
+			periods: periodsOf(60, coins(250), coins(250), coins(250), coins(253)),
+			start:   start.Add(time.Minute),
+		},
+		{
+			name:    "cliff ramps up the first part",
+			data:    types.VestingData{Duration: 60, Parts: 4, Cliff: 3},
+			vested:  coins(1200),
+			periods: periodsOf(60, coins(100), coins(100), coins(100), coins(300), coins(300), coins(300)),
+			start:   start.Add(time.Minute),
+		},
+		{
+			name:    "cliff with tge and rounding",
+			data:    types.VestingData{Duration: 60, Parts: 3, Cliff: 2, Percent: 1},
+			vested:  coins(1010),
+			periods: periodsOf(60, coins(10), coins(166), coins(166), coins(333), coins(335)),
+			start:   start,
+		},
+		{
+			name:    "everything at tge",
+			data:    types.VestingData{Duration: 60, Parts: 2, Percent: 100},
+			vested:  coins(1000),
+			periods: periodsOf(60, coins(1000), sdk.NewCoins(), sdk.NewCoins()),
+			start:   start,
+		},
+		{
+			name:   "several denoms",
+			data:   types.VestingData{Duration: 60, Parts: 2},
+			vested: sdk.NewCoins(sdk.NewInt64Coin("uatom", 11), sdk.NewInt64Coin("uugd", 1000)),
+			periods: periodsOf(60,
+				sdk.NewCoins(sdk.NewInt64Coin("uatom", 5), sdk.NewInt64Coin("uugd", 500)),
+				sdk.NewCoins(sdk.NewInt64Coin("uatom", 6), sdk.NewInt64Coin("uugd", 500)),
+			),
+			start: start.Add(time.Minute),
+		},
+		{name: "zero parts", data: types.VestingData{Duration: 60}, vested: coins(1000), err: true},
+		{name: "zero duration", data: types.VestingData{Parts: 1}, vested: coins(1000), err: true},
+		{name: "percent above 100", data: types.VestingData{Duration: 60, Parts: 1, Percent: 101}, vested: coins(1000), err: true},
+		{name: "negative percent", data: types.VestingData{Duration: 60, Parts: 1, Percent: -1}, vested: coins(1000), err: true},
+		{name: "negative cliff", data: types.VestingData{Duration: 60, Parts: 1, Cliff: -1}, vested: coins(1000), err: true},
+		{name: "too many parts", data: types.VestingData{Duration: 60, Parts: types.MaxScheduleParts + 1}, vested: coins(1000), err: true},
+		{name: "cliff too long", data: types.VestingData{Duration: 60, Parts: 1, Cliff: types.MaxScheduleParts + 1}, vested: coins(1000), err: true},
+		{name: "nothing to vest", data: types.VestingData{Duration: 60, Parts: 1}, vested: sdk.NewCoins(), err: true},
+		{name: "invalid coins", data: types.VestingData{Duration: 60, Parts: 1}, vested: sdk.Coins{sdk.Coin{Denom: "uugd", Amount: sdkmath.NewInt(-1)}}, err: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			periods, gotStart, err := types.BuildPeriods(tc.data, tc.vested, start)
+			if tc.err {
+				require.ErrorIs(t, err, types.ErrInvalidSchedule)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.start, gotStart)
+			require.Equal(t, len(tc.periods), len(periods))
+			for i := range periods {
+				require.Equal(t, tc.periods[i].Length, periods[i].Length, "period %d", i)
+				require.True(t, tc.periods[i].Amount.Equal(periods[i].Amount), "period %d: %s", i, periods[i].Amount)
+			}
+		})
+	}
+}
+
+func TestBuildPeriodsProperties(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		data := types.VestingData{
+			Duration: rapid.Int64Range(1, 10*365*24*3600).Draw(t, "duration"),
+			Parts:    rapid.Int32Range(1, 100).Draw(t, "parts"),
+			Percent:  rapid.Int32Range(0, 100).Draw(t, "percent"),
+			Cliff:    rapid.Int32Range(0, 24).Draw(t, "cliff"),
+		}
+
+		vested := sdk.NewCoins()
+		for i := 0; i < rapid.IntRange(1, 3).Draw(t, "denoms"); i++ {
+			amount := rapid.Int64Range(1, 1<<62).Draw(t, fmt.Sprintf("amount%d", i))
+			vested = vested.Add(sdk.NewInt64Coin(fmt.Sprintf("denom%d", i), amount))
+		}
+
+		start := time.Unix(rapid.Int64Range(0, 1<<40).Draw(t, "start"), 0).UTC()
+
+		periods, gotStart, err := types.BuildPeriods(data, vested, start)
+		require.NoError(t, err)
+
+		total := sdk.NewCoins()
+		for _, period := range periods {
+			require.Equal(t, data.Duration, period.Length)
+			require.False(t, period.Amount.IsAnyNegative(), "negative period amount %s", period.Amount)
+			require.True(t, period.Amount.IsValid() || period.Amount.Empty(), "invalid period amount %s", period.Amount)
+			total = total.Add(period.Amount...)
+		}
+		require.True(t, total.Equal(vested), "periods add up to %s instead of %s", total, vested)
+
+		expected := int(data.Parts)
+		if data.Cliff > 0 {
+			expected += int(data.Cliff) - 1
+		}
+		if data.Percent > 0 {
+			expected++
+			require.Equal(t, start, gotStart)
+		} else {
+			require.Equal(t, start.Add(time.Duration(data.Duration)*time.Second), gotStart)
+		}
+		require.Len(t, periods, expected)
+	})
+}
@@ -5,25 +5,33 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -114,214 +122,4573 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "ugdvesting.ugdvesting.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "ugdvesting.ugdvesting.QueryParamsResponse")
+// QueryVestingRecordRequest is request type for the Query/VestingRecord RPC method.
+type QueryVestingRecordRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func init() { proto.RegisterFile("ugdvesting/ugdvesting/query.proto", fileDescriptor_68c0faff669c8b47) }
-
-var fileDescriptor_68c0faff669c8b47 = []byte{
-	// 331 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0x41, 0x4f, 0xf2, 0x40,
-	0x14, 0xec, 0x7e, 0xf9, 0xe4, 0x50, 0x4f, 0x56, 0x4c, 0x0c, 0x91, 0xa2, 0x4d, 0x4c, 0x94, 0xa4,
-	0xdd, 0x80, 0x47, 0x6f, 0xfc, 0x02, 0xe1, 0xe8, 0x6d, 0x4b, 0x5f, 0x96, 0x15, 0xbb, 0x6f, 0xe9,
-	0x6e, 0x89, 0x5c, 0x3d, 0x78, 0x26, 0xf1, 0x4f, 0x71, 0x24, 0xf1, 0xe2, 0xc9, 0x18, 0xf0, 0x87,
-	0x18, 0xba, 0x25, 0x60, 0x44, 0xe3, 0xa5, 0x99, 0xcc, 0x9b, 0x37, 0x33, 0x7d, 0xeb, 0x9e, 0xe5,
-	0x3c, 0x19, 0x83, 0x36, 0x42, 0x72, 0xba, 0x05, 0x47, 0x39, 0x64, 0x93, 0x48, 0x65, 0x68, 0xd0,
-	0x3b, 0xda, 0xf0, 0xd1, 0x06, 0xd6, 0x0e, 0x58, 0x2a, 0x24, 0xd2, 0xe2, 0x6b, 0x95, 0xb5, 0x2a,
-	0x47, 0x8e, 0x05, 0xa4, 0x2b, 0x54, 0xb2, 0x27, 0x1c, 0x91, 0xdf, 0x03, 0x65, 0x4a, 0x50, 0x26,
-	0x25, 0x1a, 0x66, 0x04, 0x4a, 0x5d, 0x4e, 0x9b, 0x7d, 0xd4, 0x29, 0x6a, 0x1a, 0x33, 0x0d, 0x36,
-	0x96, 0x8e, 0x5b, 0x31, 0x18, 0xd6, 0xa2, 0x8a, 0x71, 0x21, 0x0b, 0x71, 0xa9, 0x0d, 0x76, 0x97,
-	0x55, 0x2c, 0x63, 0x69, 0xe9, 0x17, 0x54, 0x5d, 0xaf, 0xbb, 0x72, 0xb9, 0x29, 0xc8, 0x1e, 0x8c,
-	0x72, 0xd0, 0x26, 0xe8, 0xb9, 0x87, 0x5f, 0x58, 0xad, 0x50, 0x6a, 0xf0, 0xae, 0xdd, 0x8a, 0x5d,
-	0x3e, 0x26, 0xa7, 0xe4, 0x62, 0xbf, 0x5d, 0x8f, 0x76, 0xfe, 0x6b, 0x64, 0xd7, 0x3a, 0xff, 0x67,
-	0x6f, 0x0d, 0xa7, 0x57, 0xae, 0xb4, 0xa7, 0xc4, 0xdd, 0x2b, 0x4c, 0xbd, 0x27, 0xe2, 0x56, 0xac,
-	0xc4, 0xbb, 0xfc, 0xc1, 0xe1, 0x7b, 0xa7, 0x5a, 0xf3, 0x2f, 0x52, 0x5b, 0x34, 0x38, 0x7f, 0x7c,
-	0xf9, 0x78, 0xfe, 0xd7, 0xf0, 0xea, 0xf4, 0xb7, 0x13, 0x74, 0x86, 0xb3, 0x85, 0x4f, 0xe6, 0x0b,
-	0x9f, 0xbc, 0x2f, 0x7c, 0x32, 0x5d, 0xfa, 0xce, 0x7c, 0xe9, 0x3b, 0xaf, 0x4b, 0xdf, 0xb9, 0xed,
-	0x72, 0x61, 0x06, 0x79, 0x1c, 0xf5, 0x31, 0xa5, 0xb9, 0x14, 0x3c, 0x13, 0x49, 0xa8, 0x32, 0xbc,
-	0x83, 0xbe, 0xa1, 0xf6, 0x05, 0x42, 0x9d, 0x0c, 0xc3, 0xf5, 0x68, 0x00, 0x09, 0x87, 0x01, 0xf2,
-	0x70, 0x1d, 0xf0, 0xb0, 0x9d, 0x66, 0x26, 0x0a, 0x74, 0x5c, 0x29, 0x0e, 0x7e, 0xf5, 0x19, 0x00,
-	0x00, 0xff, 0xff, 0xb2, 0xb1, 0x1b, 0xb9, 0x43, 0x02, 0x00, 0x00,
+func (m *QueryVestingRecordRequest) Reset()         { *m = QueryVestingRecordRequest{} }
+func (m *QueryVestingRecordRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingRecordRequest) ProtoMessage()    {}
+func (*QueryVestingRecordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68c0faff669c8b47, []int{2}
+}
+func (m *QueryVestingRecordRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingRecordRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingRecordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingRecordRequest.Merge(m, src)
+}
+func (m *QueryVestingRecordRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingRecordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingRecordRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryVestingRecordRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryVestingRecordRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+// QueryVestingRecordResponse is response type for the Query/VestingRecord RPC method.
+type QueryVestingRecordResponse struct {
+	Record VestingData   `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
+	Status VestingStatus `protobuf:"varint,2,opt,name=status,proto3,enum=ugdvesting.ugdvesting.VestingStatus" json:"status,omitempty"`
+	// display_amount are the coins the record vests in display units.
+	DisplayAmount []DisplayCoin `protobuf:"bytes,3,rep,name=display_amount,json=displayAmount,proto3" json:"display_amount"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryVestingRecordResponse) Reset()         { *m = QueryVestingRecordResponse{} }
+func (m *QueryVestingRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingRecordResponse) ProtoMessage()    {}
+func (*QueryVestingRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68c0faff669c8b47, []int{3}
+}
+func (m *QueryVestingRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingRecordResponse.Merge(m, src)
+}
+func (m *QueryVestingRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingRecordResponse.DiscardUnknown(m)
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+var xxx_messageInfo_QueryVestingRecordResponse proto.InternalMessageInfo
+
+func (m *QueryVestingRecordResponse) GetRecord() VestingData {
+	if m != nil {
+		return m.Record
+	}
+	return VestingData{}
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/ugdvesting.ugdvesting.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVestingRecordResponse) GetStatus() VestingStatus {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return VestingStatus_VESTING_STATUS_UNSPECIFIED
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+func (m *QueryVestingRecordResponse) GetDisplayAmount() []DisplayCoin {
+	if m != nil {
+		return m.DisplayAmount
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryPendingVestingsRequest is request type for the Query/PendingVestings RPC method.
+type QueryPendingVestingsRequest struct {
+	// min_block and max_block bound the activation height of the returned
+	// records, inclusively. Zero leaves the bound open.
+	MinBlock   int64              `protobuf:"varint,1,opt,name=min_block,json=minBlock,proto3" json:"min_block,omitempty"`
+	MaxBlock   int64              `protobuf:"varint,2,opt,name=max_block,json=maxBlock,proto3" json:"max_block,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryPendingVestingsRequest) Reset()         { *m = QueryPendingVestingsRequest{} }
+func (m *QueryPendingVestingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingVestingsRequest) ProtoMessage()    {}
+func (*QueryPendingVestingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68c0faff669c8b47, []int{4}
+}
+func (m *QueryPendingVestingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingVestingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingVestingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingVestingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingVestingsRequest.Merge(m, src)
+}
+func (m *QueryPendingVestingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingVestingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingVestingsRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryPendingVestingsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingVestingsRequest) GetMinBlock() int64 {
+	if m != nil {
+		return m.MinBlock
+	}
+	return 0
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryPendingVestingsRequest) GetMaxBlock() int64 {
+	if m != nil {
+		return m.MaxBlock
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+	return 0
+}
+
+func (m *QueryPendingVestingsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ugdvesting.ugdvesting.Query/Params",
+	return nil
+}
+
+// QueryPendingVestingsResponse is response type for the Query/PendingVestings RPC method.
+type QueryPendingVestingsResponse struct {
+	Records    []VestingRecord     `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingVestingsResponse) Reset()         { *m = QueryPendingVestingsResponse{} }
+func (m *QueryPendingVestingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingVestingsResponse) ProtoMessage()    {}
+func (*QueryPendingVestingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68c0faff669c8b47, []int{5}
+}
+func (m *QueryPendingVestingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingVestingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingVestingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+}
+func (m *QueryPendingVestingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingVestingsResponse.Merge(m, src)
+}
+func (m *QueryPendingVestingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingVestingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingVestingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingVestingsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingVestingsResponse) GetRecords() []VestingRecord {
+	if m != nil {
+		return m.Records
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ugdvesting.ugdvesting.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ugdvesting/ugdvesting/query.proto",
+func (m *QueryPendingVestingsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// QueryProcessedVestingsRequest is request type for the Query/ProcessedVestings RPC method.
+type QueryProcessedVestingsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProcessedVestingsRequest) Reset()         { *m = QueryProcessedVestingsRequest{} }
+func (m *QueryProcessedVestingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProcessedVestingsRequest) ProtoMessage()    {}
+func (*QueryProcessedVestingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68c0faff669c8b47, []int{6}
+}
+func (m *QueryProcessedVestingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProcessedVestingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProcessedVestingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryProcessedVestingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProcessedVestingsRequest.Merge(m, src)
+}
+func (m *QueryProcessedVestingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProcessedVestingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProcessedVestingsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryProcessedVestingsRequest proto.InternalMessageInfo
+
+func (m *QueryProcessedVestingsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryProcessedVestingsResponse is response type for the Query/ProcessedVestings RPC method.
+type QueryProcessedVestingsResponse struct {
+	Records    []VestingRecord     `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProcessedVestingsResponse) Reset()         { *m = QueryProcessedVestingsResponse{} }
+func (m *QueryProcessedVestingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProcessedVestingsResponse) ProtoMessage()    {}
+func (*QueryProcessedVestingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68c0faff669c8b47, []int{7}
+}
+func (m *QueryProcessedVestingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProcessedVestingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProcessedVestingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryProcessedVestingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProcessedVestingsResponse.Merge(m, src)
+}
+func (m *QueryProcessedVestingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProcessedVestingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProcessedVestingsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryProcessedVestingsResponse proto.InternalMessageInfo
+
+func (m *QueryProcessedVestingsResponse) GetRecords() []VestingRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryProcessedVestingsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryVestingBalanceRequest is request type for the Query/VestingBalance RPC method.
+type QueryVestingBalanceRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// time the balance is computed at, the current block time when unset.
+	Time *time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time,omitempty"`
+}
+
+func (m *QueryVestingBalanceRequest) Reset()         { *m = QueryVestingBalanceRequest{} }
+func (m *QueryVestingBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingBalanceRequest) ProtoMessage()    {}
+func (*QueryVestingBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68c0faff669c8b47, []int{8}
+}
+func (m *QueryVestingBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingBalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingBalanceRequest.Merge(m, src)
+}
+func (m *QueryVestingBalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingBalanceRequest proto.InternalMessageInfo
+
+func (m *QueryVestingBalanceRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryVestingBalanceRequest) GetTime() *time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+// QueryVestingBalanceResponse is response type for the Query/VestingBalance RPC method.
+type QueryVestingBalanceResponse struct {
+	// time the balance was computed at.
+	Time     time.Time                                `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	Vested   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=vested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vested"`
+	Unvested github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=unvested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unvested"`
+	// spendable are the coins of the current balance that are not locked by
+	// the schedule at time. Balances and delegations are always the current
+	// ones.
+	Spendable github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=spendable,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spendable"`
+	// next_unlock_time is unset once everything vested.
+	NextUnlockTime   *time.Time                               `protobuf:"bytes,5,opt,name=next_unlock_time,json=nextUnlockTime,proto3,stdtime" json:"next_unlock_time,omitempty"`
+	NextUnlockAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=next_unlock_amount,json=nextUnlockAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"next_unlock_amount"`
+	// the amounts above in display units.
+	DisplayVested           []DisplayCoin `protobuf:"bytes,7,rep,name=display_vested,json=displayVested,proto3" json:"display_vested"`
+	DisplayUnvested         []DisplayCoin `protobuf:"bytes,8,rep,name=display_unvested,json=displayUnvested,proto3" json:"display_unvested"`
+	DisplaySpendable        []DisplayCoin `protobuf:"bytes,9,rep,name=display_spendable,json=displaySpendable,proto3" json:"display_spendable"`
+	DisplayNextUnlockAmount []DisplayCoin `protobuf:"bytes,10,rep,name=display_next_unlock_amount,json=displayNextUnlockAmount,proto3" json:"display_next_unlock_amount"`
+}
+
+func (m *QueryVestingBalanceResponse) Reset()         { *m = QueryVestingBalanceResponse{} }
+func (m *QueryVestingBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingBalanceResponse) ProtoMessage()    {}
+func (*QueryVestingBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68c0faff669c8b47, []int{9}
+}
+func (m *QueryVestingBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingBalanceResponse.Merge(m, src)
+}
+func (m *QueryVestingBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingBalanceResponse proto.InternalMessageInfo
+
+func (m *QueryVestingBalanceResponse) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *QueryVestingBalanceResponse) GetVested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Vested
+	}
+	return nil
+}
+
+func (m *QueryVestingBalanceResponse) GetUnvested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Unvested
+	}
+	return nil
+}
+
+func (m *QueryVestingBalanceResponse) GetSpendable() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spendable
+	}
+	return nil
+}
+
+func (m *QueryVestingBalanceResponse) GetNextUnlockTime() *time.Time {
+	if m != nil {
+		return m.NextUnlockTime
+	}
+	return nil
+}
+
+func (m *QueryVestingBalanceResponse) GetNextUnlockAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.NextUnlockAmount
+	}
+	return nil
+}
+
+func (m *QueryVestingBalanceResponse) GetDisplayVested() []DisplayCoin {
+	if m != nil {
+		return m.DisplayVested
+	}
+	return nil
+}
+
+func (m *QueryVestingBalanceResponse) GetDisplayUnvested() []DisplayCoin {
+	if m != nil {
+		return m.DisplayUnvested
+	}
+	return nil
+}
+
+func (m *QueryVestingBalanceResponse) GetDisplaySpendable() []DisplayCoin {
+	if m != nil {
+		return m.DisplaySpendable
+	}
+	return nil
+}
+
+func (m *QueryVestingBalanceResponse) GetDisplayNextUnlockAmount() []DisplayCoin {
+	if m != nil {
+		return m.DisplayNextUnlockAmount
+	}
+	return nil
+}
+
+// QueryVestingAmendmentsRequest is request type for the Query/VestingAmendments RPC method.
+type QueryVestingAmendmentsRequest struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVestingAmendmentsRequest) Reset()         { *m = QueryVestingAmendmentsRequest{} }
+func (m *QueryVestingAmendmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingAmendmentsRequest) ProtoMessage()    {}
+func (*QueryVestingAmendmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68c0faff669c8b47, []int{10}
+}
+func (m *QueryVestingAmendmentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingAmendmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingAmendmentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingAmendmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingAmendmentsRequest.Merge(m, src)
+}
+func (m *QueryVestingAmendmentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingAmendmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingAmendmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingAmendmentsRequest proto.InternalMessageInfo
+
+func (m *QueryVestingAmendmentsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryVestingAmendmentsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryVestingAmendmentsResponse is response type for the Query/VestingAmendments RPC method.
+type QueryVestingAmendmentsResponse struct {
+	Amendments []VestingAmendment  `protobuf:"bytes,1,rep,name=amendments,proto3" json:"amendments"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVestingAmendmentsResponse) Reset()         { *m = QueryVestingAmendmentsResponse{} }
+func (m *QueryVestingAmendmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingAmendmentsResponse) ProtoMessage()    {}
+func (*QueryVestingAmendmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68c0faff669c8b47, []int{11}
+}
+func (m *QueryVestingAmendmentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingAmendmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingAmendmentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingAmendmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingAmendmentsResponse.Merge(m, src)
+}
+func (m *QueryVestingAmendmentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingAmendmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingAmendmentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingAmendmentsResponse proto.InternalMessageInfo
+
+func (m *QueryVestingAmendmentsResponse) GetAmendments() []VestingAmendment {
+	if m != nil {
+		return m.Amendments
+	}
+	return nil
+}
+
+func (m *QueryVestingAmendmentsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMintRequest is request type for the Query/Mint RPC method.
+type QueryMintRequest struct {
+	// key is the key of the mint in the mint-storage document.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (m *QueryMintRequest) Reset()         { *m = QueryMintRequest{} }
+func (m *QueryMintRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintRequest) ProtoMessage()    {}
+func (*QueryMintRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68c0faff669c8b47, []int{12}
+}
+func (m *QueryMintRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintRequest.Merge(m, src)
+}
+func (m *QueryMintRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintRequest proto.InternalMessageInfo
+
+func (m *QueryMintRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+// QueryMintResponse is response type for the Query/Mint RPC method.
+type QueryMintResponse struct {
+	Record MintRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
+}
+
+func (m *QueryMintResponse) Reset()         { *m = QueryMintResponse{} }
+func (m *QueryMintResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintResponse) ProtoMessage()    {}
+func (*QueryMintResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68c0faff669c8b47, []int{13}
+}
+func (m *QueryMintResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintResponse.Merge(m, src)
+}
+func (m *QueryMintResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintResponse proto.InternalMessageInfo
+
+func (m *QueryMintResponse) GetRecord() MintRecord {
+	if m != nil {
+		return m.Record
+	}
+	return MintRecord{}
+}
+
+// QueryMintsRequest is request type for the Query/Mints RPC method.
+type QueryMintsRequest struct {
+	// address restricts the records to the mints of an address when set.
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMintsRequest) Reset()         { *m = QueryMintsRequest{} }
+func (m *QueryMintsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintsRequest) ProtoMessage()    {}
+func (*QueryMintsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68c0faff669c8b47, []int{14}
+}
+func (m *QueryMintsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintsRequest.Merge(m, src)
+}
+func (m *QueryMintsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintsRequest proto.InternalMessageInfo
+
+func (m *QueryMintsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryMintsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMintsResponse is response type for the Query/Mints RPC method.
+type QueryMintsResponse struct {
+	Mints      []MintRecord        `protobuf:"bytes,1,rep,name=mints,proto3" json:"mints"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// total_minted is the amount of denom the module minted so far.
+	TotalMinted cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=total_minted,json=totalMinted,proto3,customtype=cosmossdk.io/math.Int" json:"total_minted"`
+}
+
+func (m *QueryMintsResponse) Reset()         { *m = QueryMintsResponse{} }
+func (m *QueryMintsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintsResponse) ProtoMessage()    {}
+func (*QueryMintsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68c0faff669c8b47, []int{15}
+}
+func (m *QueryMintsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintsResponse.Merge(m, src)
+}
+func (m *QueryMintsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintsResponse proto.InternalMessageInfo
+
+func (m *QueryMintsResponse) GetMints() []MintRecord {
+	if m != nil {
+		return m.Mints
+	}
+	return nil
+}
+
+func (m *QueryMintsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryLastSnapshotRequest is request type for the Query/LastSnapshot RPC method.
+type QueryLastSnapshotRequest struct {
+	// kind is the kind of snapshot, "vesting" or "mint", vesting when empty.
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+}
+
+func (m *QueryLastSnapshotRequest) Reset()         { *m = QueryLastSnapshotRequest{} }
+func (m *QueryLastSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLastSnapshotRequest) ProtoMessage()    {}
+func (*QueryLastSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68c0faff669c8b47, []int{16}
+}
+func (m *QueryLastSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLastSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastSnapshotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLastSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastSnapshotRequest.Merge(m, src)
+}
+func (m *QueryLastSnapshotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastSnapshotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLastSnapshotRequest proto.InternalMessageInfo
+
+func (m *QueryLastSnapshotRequest) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+// QueryLastSnapshotResponse is response type for the Query/LastSnapshot RPC method.
+type QueryLastSnapshotResponse struct {
+	// state is the last accepted snapshot, unset before the first one.
+	State *SnapshotState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (m *QueryLastSnapshotResponse) Reset()         { *m = QueryLastSnapshotResponse{} }
+func (m *QueryLastSnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLastSnapshotResponse) ProtoMessage()    {}
+func (*QueryLastSnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68c0faff669c8b47, []int{17}
+}
+func (m *QueryLastSnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLastSnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastSnapshotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLastSnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastSnapshotResponse.Merge(m, src)
+}
+func (m *QueryLastSnapshotResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastSnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastSnapshotResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLastSnapshotResponse proto.InternalMessageInfo
+
+func (m *QueryLastSnapshotResponse) GetState() *SnapshotState {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "ugdvesting.ugdvesting.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "ugdvesting.ugdvesting.QueryParamsResponse")
+	proto.RegisterType((*QueryVestingRecordRequest)(nil), "ugdvesting.ugdvesting.QueryVestingRecordRequest")
+	proto.RegisterType((*QueryVestingRecordResponse)(nil), "ugdvesting.ugdvesting.QueryVestingRecordResponse")
+	proto.RegisterType((*QueryPendingVestingsRequest)(nil), "ugdvesting.ugdvesting.QueryPendingVestingsRequest")
+	proto.RegisterType((*QueryPendingVestingsResponse)(nil), "ugdvesting.ugdvesting.QueryPendingVestingsResponse")
+	proto.RegisterType((*QueryProcessedVestingsRequest)(nil), "ugdvesting.ugdvesting.QueryProcessedVestingsRequest")
+	proto.RegisterType((*QueryProcessedVestingsResponse)(nil), "ugdvesting.ugdvesting.QueryProcessedVestingsResponse")
+	proto.RegisterType((*QueryVestingBalanceRequest)(nil), "ugdvesting.ugdvesting.QueryVestingBalanceRequest")
+	proto.RegisterType((*QueryVestingBalanceResponse)(nil), "ugdvesting.ugdvesting.QueryVestingBalanceResponse")
+	proto.RegisterType((*QueryVestingAmendmentsRequest)(nil), "ugdvesting.ugdvesting.QueryVestingAmendmentsRequest")
+	proto.RegisterType((*QueryVestingAmendmentsResponse)(nil), "ugdvesting.ugdvesting.QueryVestingAmendmentsResponse")
+	proto.RegisterType((*QueryMintRequest)(nil), "ugdvesting.ugdvesting.QueryMintRequest")
+	proto.RegisterType((*QueryMintResponse)(nil), "ugdvesting.ugdvesting.QueryMintResponse")
+	proto.RegisterType((*QueryMintsRequest)(nil), "ugdvesting.ugdvesting.QueryMintsRequest")
+	proto.RegisterType((*QueryMintsResponse)(nil), "ugdvesting.ugdvesting.QueryMintsResponse")
+	proto.RegisterType((*QueryLastSnapshotRequest)(nil), "ugdvesting.ugdvesting.QueryLastSnapshotRequest")
+	proto.RegisterType((*QueryLastSnapshotResponse)(nil), "ugdvesting.ugdvesting.QueryLastSnapshotResponse")
+}
+
+func init() { proto.RegisterFile("ugdvesting/ugdvesting/query.proto", fileDescriptor_68c0faff669c8b47) }
+
+var fileDescriptor_68c0faff669c8b47 = []byte{
+	// 1391 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x13, 0x47,
+	0x14, 0xce, 0xe6, 0x87, 0x93, 0x3c, 0x20, 0x24, 0x53, 0x50, 0x1d, 0x87, 0x38, 0x61, 0x49, 0x49,
+	0x02, 0xcd, 0x2e, 0x31, 0x44, 0x45, 0xa5, 0x55, 0x4b, 0x8a, 0xa8, 0xa8, 0x1a, 0xa0, 0x0e, 0x50,
+	0xa9, 0x17, 0x6b, 0xec, 0x9d, 0x6e, 0xb6, 0xf1, 0xce, 0x1a, 0xcf, 0x18, 0x25, 0xaa, 0xca, 0x81,
+	0x03, 0xd7, 0x22, 0xf5, 0xd8, 0x03, 0xd7, 0xaa, 0x15, 0x6a, 0xab, 0x72, 0xe8, 0xa5, 0xea, 0x95,
+	0x5b, 0x11, 0xbd, 0x54, 0x3d, 0x40, 0x05, 0x95, 0x7a, 0x69, 0xff, 0x87, 0x6a, 0x67, 0xde, 0xda,
+	0xeb, 0xb0, 0xb6, 0x63, 0x20, 0x12, 0x97, 0x78, 0x77, 0xe7, 0x7d, 0xef, 0x7d, 0xf3, 0xbd, 0x99,
+	0x37, 0x6f, 0x02, 0x07, 0x6b, 0xae, 0x73, 0x8d, 0x09, 0xe9, 0x71, 0xd7, 0x8e, 0x3d, 0x5e, 0xad,
+	0xb1, 0xea, 0xa6, 0x55, 0xa9, 0x06, 0x32, 0x20, 0xfb, 0x1b, 0xdf, 0xad, 0xc6, 0x63, 0x66, 0x8c,
+	0xfa, 0x1e, 0x0f, 0x6c, 0xf5, 0x57, 0x5b, 0x66, 0xc6, 0x4b, 0x81, 0xf0, 0x03, 0x51, 0x50, 0x6f,
+	0xb6, 0x7e, 0xc1, 0xa1, 0x7d, 0x6e, 0xe0, 0x06, 0xfa, 0x7b, 0xf8, 0x84, 0x5f, 0x0f, 0xb8, 0x41,
+	0xe0, 0x96, 0x99, 0x4d, 0x2b, 0x9e, 0x4d, 0x39, 0x0f, 0x24, 0x95, 0x5e, 0xc0, 0x23, 0xcc, 0x14,
+	0x8e, 0xaa, 0xb7, 0x62, 0xed, 0x53, 0x5b, 0x7a, 0x3e, 0x13, 0x92, 0xfa, 0x15, 0x34, 0xc8, 0xea,
+	0x10, 0x76, 0x91, 0x0a, 0x66, 0x5f, 0x5b, 0x2c, 0x32, 0x49, 0x17, 0xed, 0x52, 0xe0, 0x71, 0x1c,
+	0x3f, 0x12, 0x1f, 0x57, 0x53, 0xaa, 0x5b, 0x55, 0xa8, 0xeb, 0x71, 0x15, 0x0d, 0x6d, 0xa7, 0x93,
+	0x85, 0xf0, 0x3d, 0x2e, 0xd1, 0xc2, 0x4c, 0xb6, 0xa8, 0xd0, 0x2a, 0xf5, 0x23, 0xca, 0x87, 0x92,
+	0x6d, 0xf0, 0x57, 0x1b, 0x99, 0xfb, 0x80, 0x7c, 0x14, 0x92, 0xb9, 0xa8, 0x90, 0x79, 0x76, 0xb5,
+	0xc6, 0x84, 0x34, 0xf3, 0xf0, 0x4a, 0xd3, 0x57, 0x51, 0x09, 0xb8, 0x60, 0xe4, 0x14, 0xa4, 0x74,
+	0x84, 0xb4, 0x31, 0x6d, 0xcc, 0xed, 0xca, 0x4d, 0x5a, 0x89, 0xe9, 0xb0, 0x34, 0x6c, 0xb9, 0xff,
+	0xde, 0xc3, 0xa9, 0x9e, 0x3c, 0x42, 0xcc, 0x0b, 0x30, 0xae, 0x7c, 0x5e, 0xd1, 0x46, 0x79, 0x56,
+	0x0a, 0xaa, 0x0e, 0x06, 0x24, 0x39, 0x18, 0xa4, 0x8e, 0x53, 0x65, 0x42, 0xbb, 0x1e, 0x5e, 0x4e,
+	0x3f, 0xb8, 0xbb, 0xb0, 0x0f, 0xb3, 0x76, 0x5a, 0x8f, 0xac, 0xca, 0x6a, 0x88, 0x8b, 0x0c, 0xcd,
+	0xff, 0x0c, 0xc8, 0x24, 0x79, 0x44, 0xb2, 0xef, 0x42, 0xaa, 0xaa, 0xbe, 0x20, 0x59, 0xb3, 0x05,
+	0x59, 0x44, 0x9f, 0xa1, 0x92, 0x46, 0x8c, 0x35, 0x8e, 0xbc, 0x05, 0x29, 0x21, 0xa9, 0xac, 0x89,
+	0x74, 0xef, 0xb4, 0x31, 0x37, 0x92, 0x9b, 0x69, 0xef, 0x61, 0x55, 0xd9, 0xe6, 0x11, 0x43, 0x2e,
+	0xc0, 0x88, 0xe3, 0x89, 0x4a, 0x99, 0x6e, 0x16, 0xa8, 0x1f, 0xd4, 0xb8, 0x4c, 0xf7, 0x4d, 0xf7,
+	0xb5, 0xe1, 0x71, 0x46, 0x1b, 0xbf, 0x17, 0x78, 0x1c, 0x79, 0xec, 0x41, 0xfc, 0x69, 0x05, 0x37,
+	0x6f, 0x1b, 0x30, 0xa1, 0xb3, 0xc2, 0xb8, 0xe3, 0x71, 0x17, 0xc3, 0x46, 0x49, 0x23, 0x13, 0x30,
+	0xec, 0x7b, 0xbc, 0x50, 0x2c, 0x07, 0xa5, 0x75, 0x35, 0xe7, 0xbe, 0xfc, 0x90, 0xef, 0xf1, 0xe5,
+	0xf0, 0x5d, 0x0d, 0xd2, 0x0d, 0x1c, 0xec, 0xc5, 0x41, 0xba, 0xa1, 0x07, 0xcf, 0x02, 0x34, 0xd6,
+	0x60, 0xba, 0x4f, 0xc9, 0x75, 0xd8, 0x42, 0xf5, 0xc3, 0x05, 0x6b, 0xe9, 0x3d, 0x88, 0x0b, 0xd6,
+	0xba, 0x48, 0x5d, 0x86, 0x51, 0xf3, 0x31, 0xa4, 0x79, 0xc7, 0x80, 0x03, 0xc9, 0x0c, 0x31, 0x27,
+	0x67, 0x60, 0x50, 0x6b, 0x1b, 0xa6, 0x39, 0x14, 0xa3, 0x83, 0xa4, 0x3a, 0xa5, 0x28, 0x47, 0x04,
+	0x25, 0xef, 0x37, 0xd1, 0xed, 0x55, 0x74, 0x67, 0x3b, 0xd2, 0xd5, 0x14, 0x9a, 0xf8, 0xba, 0x30,
+	0xa9, 0xe9, 0x56, 0x83, 0x12, 0x13, 0x82, 0x39, 0x5b, 0x25, 0x6d, 0x16, 0xc6, 0x78, 0x66, 0x61,
+	0xbe, 0x37, 0x20, 0xdb, 0x2a, 0xd2, 0xcb, 0x29, 0xcd, 0xcd, 0x2d, 0x9b, 0x6b, 0x99, 0x96, 0x29,
+	0x2f, 0xb1, 0xe7, 0xd8, 0xaf, 0xe4, 0x04, 0xf4, 0x87, 0x45, 0x13, 0x59, 0x65, 0x2c, 0x5d, 0x51,
+	0xad, 0xa8, 0xa2, 0x5a, 0x97, 0xa2, 0x8a, 0xba, 0xdc, 0x7f, 0xeb, 0xd1, 0x94, 0x91, 0x57, 0xd6,
+	0xe6, 0x6f, 0x83, 0x30, 0x91, 0x48, 0x04, 0x75, 0x3b, 0x89, 0x5e, 0x8d, 0x8e, 0x5e, 0x87, 0x42,
+	0xa9, 0x1a, 0x9e, 0xc9, 0x1a, 0xa4, 0x42, 0x4d, 0x99, 0x93, 0xee, 0x55, 0x82, 0x8f, 0x37, 0xe9,
+	0x14, 0x29, 0xa4, 0xf6, 0xe3, 0x52, 0x08, 0xfd, 0xf6, 0xd1, 0xd4, 0x9c, 0xeb, 0xc9, 0xb5, 0x5a,
+	0xd1, 0x2a, 0x05, 0x3e, 0x1e, 0x29, 0xf8, 0xb3, 0x20, 0x9c, 0x75, 0x5b, 0x6e, 0x56, 0x98, 0x50,
+	0x00, 0xf1, 0xcd, 0x3f, 0x3f, 0x1c, 0x31, 0xf2, 0xe8, 0x9f, 0x94, 0x61, 0xa8, 0xc6, 0x31, 0x56,
+	0xdf, 0x0e, 0xc5, 0xaa, 0x47, 0x20, 0x1c, 0x86, 0x45, 0x85, 0x71, 0x87, 0x16, 0xcb, 0x2c, 0xdd,
+	0xbf, 0x43, 0xe1, 0x1a, 0x21, 0xc8, 0x07, 0x30, 0xca, 0xd9, 0x86, 0x2c, 0xd4, 0x78, 0x58, 0x4c,
+	0x0a, 0x2a, 0x1b, 0x03, 0xdb, 0xcc, 0xf1, 0x48, 0x88, 0xbc, 0xac, 0x80, 0xe1, 0x10, 0xb9, 0x0e,
+	0x24, 0xee, 0x0b, 0x0b, 0x67, 0x6a, 0x87, 0x26, 0x31, 0xda, 0x88, 0xae, 0x6b, 0x6c, 0xbc, 0x68,
+	0x63, 0xbe, 0x06, 0x9f, 0xb1, 0x68, 0x5f, 0xd1, 0xc9, 0x58, 0x85, 0xd1, 0xc8, 0x61, 0x7d, 0x09,
+	0x0c, 0x75, 0xe9, 0x72, 0x2f, 0x7a, 0xb8, 0x1c, 0x65, 0xf8, 0x32, 0x8c, 0x45, 0x4e, 0x1b, 0x99,
+	0x1e, 0xee, 0xd2, 0x6b, 0xc4, 0x6b, 0xb5, 0x9e, 0x48, 0x06, 0x99, 0xc8, 0x6d, 0x42, 0x12, 0xa0,
+	0x4b, 0xff, 0xaf, 0xa2, 0xaf, 0xf3, 0x5b, 0x34, 0x36, 0xbf, 0x36, 0xb0, 0xec, 0xe2, 0x8e, 0x3e,
+	0xed, 0x33, 0xee, 0xf8, 0x8c, 0x4b, 0xf1, 0x3c, 0xd5, 0xe5, 0x6c, 0x42, 0xe5, 0x7b, 0x96, 0x52,
+	0xfd, 0x73, 0x54, 0xaa, 0x13, 0xd8, 0x61, 0xc9, 0x59, 0x01, 0xa0, 0xf5, 0xaf, 0x58, 0xad, 0x67,
+	0xdb, 0x57, 0xeb, 0xba, 0x17, 0x14, 0x27, 0xe6, 0xe0, 0xc5, 0xd5, 0xec, 0x19, 0x18, 0x55, 0xcc,
+	0x57, 0x3c, 0x2e, 0x23, 0x29, 0x47, 0xa1, 0x6f, 0x9d, 0x6d, 0x6a, 0x19, 0xf3, 0xe1, 0xa3, 0x79,
+	0x09, 0xc6, 0x62, 0x56, 0x38, 0xa5, 0x77, 0xb6, 0x34, 0x4b, 0x07, 0x5b, 0x4c, 0x47, 0x83, 0x62,
+	0x27, 0x0f, 0xc2, 0xcc, 0x2f, 0x8d, 0x98, 0xdb, 0x97, 0x22, 0x91, 0xff, 0x1a, 0x40, 0xe2, 0x8c,
+	0x70, 0xa6, 0x6f, 0xc3, 0x40, 0xd8, 0x47, 0x47, 0x79, 0xdb, 0xf6, 0x44, 0x35, 0xea, 0x85, 0x25,
+	0x8b, 0x9c, 0x87, 0xdd, 0x32, 0x90, 0xb4, 0x5c, 0x08, 0xfd, 0xaa, 0x73, 0x21, 0xd4, 0xe7, 0x68,
+	0x18, 0xeb, 0xcf, 0x87, 0x53, 0xfb, 0xb5, 0x47, 0xe1, 0xac, 0x5b, 0x5e, 0x60, 0xfb, 0x54, 0xae,
+	0x59, 0xe7, 0xb8, 0x7c, 0x70, 0x77, 0x01, 0x30, 0xd4, 0x39, 0x2e, 0xf3, 0xbb, 0x94, 0x83, 0x15,
+	0x85, 0x37, 0x2d, 0x48, 0xab, 0xd9, 0x7e, 0x48, 0x85, 0x5c, 0xe5, 0xb4, 0x22, 0xd6, 0x82, 0xfa,
+	0x22, 0x20, 0xd0, 0xbf, 0xee, 0x71, 0x07, 0x57, 0x81, 0x7a, 0x36, 0x3f, 0x86, 0xf1, 0x04, 0x7b,
+	0x14, 0xe9, 0x4d, 0x18, 0x10, 0x92, 0xca, 0xe8, 0x54, 0x6d, 0xd5, 0x8a, 0x44, 0xb8, 0xb0, 0xf3,
+	0x65, 0x79, 0x0d, 0xc9, 0xfd, 0xba, 0x0b, 0x06, 0x94, 0x67, 0x72, 0xd3, 0x80, 0x94, 0xbe, 0x0a,
+	0x90, 0xf9, 0x16, 0x1e, 0x9e, 0xbe, 0x7b, 0x64, 0x8e, 0x6c, 0xc7, 0x54, 0xf3, 0x34, 0x5f, 0xbb,
+	0xf1, 0xfb, 0xdf, 0x5f, 0xf5, 0x4e, 0x91, 0x49, 0xbb, 0xdd, 0x7d, 0x88, 0xdc, 0x31, 0x60, 0x4f,
+	0x53, 0xdb, 0x44, 0x8e, 0xb5, 0x0b, 0x92, 0x74, 0x43, 0xc9, 0x2c, 0x76, 0x81, 0x40, 0x76, 0x6f,
+	0x28, 0x76, 0x8b, 0xc4, 0xb6, 0xdb, 0xde, 0xc4, 0x0a, 0x7a, 0x0b, 0xd9, 0x9f, 0xe3, 0x0e, 0xf8,
+	0x82, 0x7c, 0x67, 0xc0, 0xde, 0x2d, 0x2d, 0x34, 0xc9, 0xb5, 0x95, 0x25, 0xf1, 0x46, 0x90, 0x39,
+	0xde, 0x15, 0x06, 0x59, 0xdb, 0x8a, 0xf5, 0x3c, 0x99, 0x6d, 0xa5, 0xa9, 0xc6, 0x15, 0xae, 0x45,
+	0xcc, 0x7e, 0x32, 0x60, 0xec, 0xa9, 0xbe, 0x96, 0x9c, 0x68, 0x1b, 0xbb, 0x45, 0xc3, 0x9d, 0x59,
+	0xea, 0x12, 0x85, 0x9c, 0x17, 0x15, 0xe7, 0xa3, 0x64, 0xbe, 0x15, 0xe7, 0x08, 0xd9, 0x60, 0xfd,
+	0xa3, 0x01, 0x23, 0xcd, 0x2d, 0x25, 0xd9, 0x4e, 0x8a, 0x9b, 0xfb, 0xe0, 0x4c, 0xae, 0x1b, 0x08,
+	0x92, 0x3d, 0xa9, 0xc8, 0xe6, 0xc8, 0xb1, 0x0e, 0xcb, 0xa2, 0xa8, 0x71, 0xb1, 0x75, 0xf1, 0x8b,
+	0x01, 0x63, 0x4f, 0x1d, 0x4b, 0xed, 0x95, 0x6e, 0x75, 0xc6, 0x66, 0x96, 0xba, 0x44, 0x21, 0xf9,
+	0x53, 0x8a, 0xfc, 0x12, 0x39, 0xde, 0x81, 0x7c, 0xe3, 0x7c, 0x8b, 0xf1, 0xbf, 0x0e, 0xfd, 0x61,
+	0xb5, 0x22, 0xb3, 0xed, 0x62, 0xc7, 0x4e, 0xaf, 0xcc, 0x5c, 0x67, 0x43, 0xe4, 0x75, 0x48, 0xf1,
+	0x9a, 0x24, 0x13, 0x76, 0xeb, 0xff, 0x9d, 0x90, 0x1b, 0x06, 0x0c, 0xac, 0xa8, 0x32, 0xde, 0xd1,
+	0x71, 0x5d, 0xa7, 0xf9, 0x6d, 0x58, 0x22, 0x87, 0x19, 0xc5, 0x21, 0x4b, 0x0e, 0xb4, 0xe1, 0x20,
+	0xc8, 0x6d, 0x03, 0x76, 0xc7, 0x8b, 0x2e, 0xb1, 0xdb, 0x45, 0x48, 0x28, 0xe7, 0x99, 0x63, 0xdb,
+	0x07, 0x20, 0xb3, 0xd7, 0x15, 0xb3, 0xc3, 0x64, 0xa6, 0x05, 0xb3, 0x32, 0x15, 0xb2, 0x20, 0x10,
+	0xb5, 0xec, 0xde, 0x7b, 0x9c, 0x35, 0xee, 0x3f, 0xce, 0x1a, 0x7f, 0x3d, 0xce, 0x1a, 0xb7, 0x9e,
+	0x64, 0x7b, 0xee, 0x3f, 0xc9, 0xf6, 0xfc, 0xf1, 0x24, 0xdb, 0xf3, 0xc9, 0x4a, 0xac, 0xbf, 0xae,
+	0x71, 0xcf, 0xad, 0x7a, 0xce, 0x42, 0xa5, 0x1a, 0x7c, 0xc6, 0x4a, 0x32, 0x6a, 0xb4, 0xa3, 0xcf,
+	0x6b, 0xcc, 0x71, 0xd9, 0x5a, 0xe0, 0x2e, 0x44, 0x31, 0x36, 0xe2, 0x01, 0x55, 0x2b, 0x5e, 0x4c,
+	0xa9, 0x7b, 0xc1, 0xf1, 0xff, 0x07, 0x00, 0x0d, 0xf0, 0x30, 0xdd, 0xf9, 0x13, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// VestingRecord queries the hedgehog vesting record stored for an address.
+	VestingRecord(ctx context.Context, in *QueryVestingRecordRequest, opts ...grpc.CallOption) (*QueryVestingRecordResponse, error)
+	// PendingVestings queries the vesting records waiting for their block,
+	// including failed ones waiting for their retry, in block order, optionally
+	// restricted to a range of activation heights.
+	PendingVestings(ctx context.Context, in *QueryPendingVestingsRequest, opts ...grpc.CallOption) (*QueryPendingVestingsResponse, error)
+	// ProcessedVestings queries the vesting records that were converted.
+	ProcessedVestings(ctx context.Context, in *QueryProcessedVestingsRequest, opts ...grpc.CallOption) (*QueryProcessedVestingsResponse, error)
+	// VestingBalance queries the vested, unvested and spendable coins of an
+	// account and its next unlock at a given time.
+	VestingBalance(ctx context.Context, in *QueryVestingBalanceRequest, opts ...grpc.CallOption) (*QueryVestingBalanceResponse, error)
+	// VestingAmendments queries the amendment history of the vesting record of
+	// an address, oldest first.
+	VestingAmendments(ctx context.Context, in *QueryVestingAmendmentsRequest, opts ...grpc.CallOption) (*QueryVestingAmendmentsResponse, error)
+	// Mint queries the record of an executed hedgehog mint by its key.
+	Mint(ctx context.Context, in *QueryMintRequest, opts ...grpc.CallOption) (*QueryMintResponse, error)
+	// Mints queries the records of the executed hedgehog mints, optionally
+	// restricted to an address, and the total minted.
+	Mints(ctx context.Context, in *QueryMintsRequest, opts ...grpc.CallOption) (*QueryMintsResponse, error)
+	// LastSnapshot queries the last accepted hedgehog snapshot of a kind, the
+	// one the next snapshot has to chain to.
+	LastSnapshot(ctx context.Context, in *QueryLastSnapshotRequest, opts ...grpc.CallOption) (*QueryLastSnapshotResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/ugdvesting.ugdvesting.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestingRecord(ctx context.Context, in *QueryVestingRecordRequest, opts ...grpc.CallOption) (*QueryVestingRecordResponse, error) {
+	out := new(QueryVestingRecordResponse)
+	err := c.cc.Invoke(ctx, "/ugdvesting.ugdvesting.Query/VestingRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingVestings(ctx context.Context, in *QueryPendingVestingsRequest, opts ...grpc.CallOption) (*QueryPendingVestingsResponse, error) {
+	out := new(QueryPendingVestingsResponse)
+	err := c.cc.Invoke(ctx, "/ugdvesting.ugdvesting.Query/PendingVestings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProcessedVestings(ctx context.Context, in *QueryProcessedVestingsRequest, opts ...grpc.CallOption) (*QueryProcessedVestingsResponse, error) {
+	out := new(QueryProcessedVestingsResponse)
+	err := c.cc.Invoke(ctx, "/ugdvesting.ugdvesting.Query/ProcessedVestings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestingBalance(ctx context.Context, in *QueryVestingBalanceRequest, opts ...grpc.CallOption) (*QueryVestingBalanceResponse, error) {
+	out := new(QueryVestingBalanceResponse)
+	err := c.cc.Invoke(ctx, "/ugdvesting.ugdvesting.Query/VestingBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestingAmendments(ctx context.Context, in *QueryVestingAmendmentsRequest, opts ...grpc.CallOption) (*QueryVestingAmendmentsResponse, error) {
+	out := new(QueryVestingAmendmentsResponse)
+	err := c.cc.Invoke(ctx, "/ugdvesting.ugdvesting.Query/VestingAmendments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Mint(ctx context.Context, in *QueryMintRequest, opts ...grpc.CallOption) (*QueryMintResponse, error) {
+	out := new(QueryMintResponse)
+	err := c.cc.Invoke(ctx, "/ugdvesting.ugdvesting.Query/Mint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Mints(ctx context.Context, in *QueryMintsRequest, opts ...grpc.CallOption) (*QueryMintsResponse, error) {
+	out := new(QueryMintsResponse)
+	err := c.cc.Invoke(ctx, "/ugdvesting.ugdvesting.Query/Mints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LastSnapshot(ctx context.Context, in *QueryLastSnapshotRequest, opts ...grpc.CallOption) (*QueryLastSnapshotResponse, error) {
+	out := new(QueryLastSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/ugdvesting.ugdvesting.Query/LastSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// VestingRecord queries the hedgehog vesting record stored for an address.
+	VestingRecord(context.Context, *QueryVestingRecordRequest) (*QueryVestingRecordResponse, error)
+	// PendingVestings queries the vesting records waiting for their block,
+	// including failed ones waiting for their retry, in block order, optionally
+	// restricted to a range of activation heights.
+	PendingVestings(context.Context, *QueryPendingVestingsRequest) (*QueryPendingVestingsResponse, error)
+	// ProcessedVestings queries the vesting records that were converted.
+	ProcessedVestings(context.Context, *QueryProcessedVestingsRequest) (*QueryProcessedVestingsResponse, error)
+	// VestingBalance queries the vested, unvested and spendable coins of an
+	// account and its next unlock at a given time.
+	VestingBalance(context.Context, *QueryVestingBalanceRequest) (*QueryVestingBalanceResponse, error)
+	// VestingAmendments queries the amendment history of the vesting record of
+	// an address, oldest first.
+	VestingAmendments(context.Context, *QueryVestingAmendmentsRequest) (*QueryVestingAmendmentsResponse, error)
+	// Mint queries the record of an executed hedgehog mint by its key.
+	Mint(context.Context, *QueryMintRequest) (*QueryMintResponse, error)
+	// Mints queries the records of the executed hedgehog mints, optionally
+	// restricted to an address, and the total minted.
+	Mints(context.Context, *QueryMintsRequest) (*QueryMintsResponse, error)
+	// LastSnapshot queries the last accepted hedgehog snapshot of a kind, the
+	// one the next snapshot has to chain to.
+	LastSnapshot(context.Context, *QueryLastSnapshotRequest) (*QueryLastSnapshotResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) VestingRecord(ctx context.Context, req *QueryVestingRecordRequest) (*QueryVestingRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingRecord not implemented")
+}
+func (*UnimplementedQueryServer) PendingVestings(ctx context.Context, req *QueryPendingVestingsRequest) (*QueryPendingVestingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingVestings not implemented")
+}
+func (*UnimplementedQueryServer) ProcessedVestings(ctx context.Context, req *QueryProcessedVestingsRequest) (*QueryProcessedVestingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessedVestings not implemented")
+}
+func (*UnimplementedQueryServer) VestingBalance(ctx context.Context, req *QueryVestingBalanceRequest) (*QueryVestingBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingBalance not implemented")
+}
+func (*UnimplementedQueryServer) VestingAmendments(ctx context.Context, req *QueryVestingAmendmentsRequest) (*QueryVestingAmendmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingAmendments not implemented")
+}
+func (*UnimplementedQueryServer) Mint(ctx context.Context, req *QueryMintRequest) (*QueryMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mint not implemented")
+}
+func (*UnimplementedQueryServer) Mints(ctx context.Context, req *QueryMintsRequest) (*QueryMintsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mints not implemented")
+}
+func (*UnimplementedQueryServer) LastSnapshot(ctx context.Context, req *QueryLastSnapshotRequest) (*QueryLastSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastSnapshot not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ugdvesting.ugdvesting.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ugdvesting.ugdvesting.Query/VestingRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingRecord(ctx, req.(*QueryVestingRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingVestings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingVestingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingVestings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ugdvesting.ugdvesting.Query/PendingVestings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingVestings(ctx, req.(*QueryPendingVestingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProcessedVestings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProcessedVestingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProcessedVestings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ugdvesting.ugdvesting.Query/ProcessedVestings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProcessedVestings(ctx, req.(*QueryProcessedVestingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ugdvesting.ugdvesting.Query/VestingBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingBalance(ctx, req.(*QueryVestingBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingAmendments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingAmendmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingAmendments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ugdvesting.ugdvesting.Query/VestingAmendments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingAmendments(ctx, req.(*QueryVestingAmendmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Mint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Mint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ugdvesting.ugdvesting.Query/Mint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Mint(ctx, req.(*QueryMintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Mints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Mints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ugdvesting.ugdvesting.Query/Mints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Mints(ctx, req.(*QueryMintsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LastSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ugdvesting.ugdvesting.Query/LastSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastSnapshot(ctx, req.(*QueryLastSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ugdvesting.ugdvesting.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "VestingRecord",
+			Handler:    _Query_VestingRecord_Handler,
+		},
+		{
+			MethodName: "PendingVestings",
+			Handler:    _Query_PendingVestings_Handler,
+		},
+		{
+			MethodName: "ProcessedVestings",
+			Handler:    _Query_ProcessedVestings_Handler,
+		},
+		{
+			MethodName: "VestingBalance",
+			Handler:    _Query_VestingBalance_Handler,
+		},
+		{
+			MethodName: "VestingAmendments",
+			Handler:    _Query_VestingAmendments_Handler,
+		},
+		{
+			MethodName: "Mint",
+			Handler:    _Query_Mint_Handler,
+		},
+		{
+			MethodName: "Mints",
+			Handler:    _Query_Mints_Handler,
+		},
+		{
+			MethodName: "LastSnapshot",
+			Handler:    _Query_LastSnapshot_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ugdvesting/ugdvesting/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DisplayAmount) > 0 {
+		for iNdEx := len(m.DisplayAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DisplayAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingVestingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingVestingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingVestingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingVestingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingVestingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingVestingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProcessedVestingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProcessedVestingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProcessedVestingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProcessedVestingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProcessedVestingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProcessedVestingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Time != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintQuery(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DisplayNextUnlockAmount) > 0 {
+		for iNdEx := len(m.DisplayNextUnlockAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DisplayNextUnlockAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.DisplaySpendable) > 0 {
+		for iNdEx := len(m.DisplaySpendable) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DisplaySpendable[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.DisplayUnvested) > 0 {
+		for iNdEx := len(m.DisplayUnvested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DisplayUnvested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.DisplayVested) > 0 {
+		for iNdEx := len(m.DisplayVested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DisplayVested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.NextUnlockAmount) > 0 {
+		for iNdEx := len(m.NextUnlockAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NextUnlockAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.NextUnlockTime != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.NextUnlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextUnlockTime):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintQuery(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Spendable) > 0 {
+		for iNdEx := len(m.Spendable) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spendable[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Unvested) > 0 {
+		for iNdEx := len(m.Unvested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unvested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Vested) > 0 {
+		for iNdEx := len(m.Vested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingAmendmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingAmendmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingAmendmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingAmendmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingAmendmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingAmendmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amendments) > 0 {
+		for iNdEx := len(m.Amendments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amendments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalMinted.Size()
+		i -= size
+		if _, err := m.TotalMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Mints) > 0 {
+		for iNdEx := len(m.Mints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastSnapshotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastSnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastSnapshotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastSnapshotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastSnapshotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastSnapshotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.State != nil {
+		{
+			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVestingRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if len(m.DisplayAmount) > 0 {
+		for _, e := range m.DisplayAmount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingVestingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinBlock != 0 {
+		n += 1 + sovQuery(uint64(m.MinBlock))
+	}
+	if m.MaxBlock != 0 {
+		n += 1 + sovQuery(uint64(m.MaxBlock))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingVestingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProcessedVestingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProcessedVestingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Time != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Vested) > 0 {
+		for _, e := range m.Vested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Unvested) > 0 {
+		for _, e := range m.Unvested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Spendable) > 0 {
+		for _, e := range m.Spendable {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.NextUnlockTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextUnlockTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.NextUnlockAmount) > 0 {
+		for _, e := range m.NextUnlockAmount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DisplayVested) > 0 {
+		for _, e := range m.DisplayVested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DisplayUnvested) > 0 {
+		for _, e := range m.DisplayUnvested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DisplaySpendable) > 0 {
+		for _, e := range m.DisplaySpendable {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DisplayNextUnlockAmount) > 0 {
+		for _, e := range m.DisplayNextUnlockAmount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVestingAmendmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingAmendmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amendments) > 0 {
+		for _, e := range m.Amendments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMintsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Mints) > 0 {
+		for _, e := range m.Mints {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.TotalMinted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLastSnapshotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLastSnapshotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= VestingStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayAmount = append(m.DisplayAmount, DisplayCoin{})
+			if err := m.DisplayAmount[len(m.DisplayAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingVestingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingVestingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingVestingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBlock", wireType)
+			}
+			m.MinBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlock", wireType)
+			}
+			m.MaxBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingVestingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingVestingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingVestingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, VestingRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProcessedVestingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProcessedVestingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProcessedVestingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProcessedVestingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProcessedVestingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProcessedVestingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, VestingRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vested = append(m.Vested, types.Coin{})
+			if err := m.Vested[len(m.Vested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unvested = append(m.Unvested, types.Coin{})
+			if err := m.Unvested[len(m.Unvested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spendable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spendable = append(m.Spendable, types.Coin{})
+			if err := m.Spendable[len(m.Spendable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextUnlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextUnlockTime == nil {
+				m.NextUnlockTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.NextUnlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextUnlockAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextUnlockAmount = append(m.NextUnlockAmount, types.Coin{})
+			if err := m.NextUnlockAmount[len(m.NextUnlockAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayVested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayVested = append(m.DisplayVested, DisplayCoin{})
+			if err := m.DisplayVested[len(m.DisplayVested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayUnvested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayUnvested = append(m.DisplayUnvested, DisplayCoin{})
+			if err := m.DisplayUnvested[len(m.DisplayUnvested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplaySpendable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplaySpendable = append(m.DisplaySpendable, DisplayCoin{})
+			if err := m.DisplaySpendable[len(m.DisplaySpendable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayNextUnlockAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayNextUnlockAmount = append(m.DisplayNextUnlockAmount, DisplayCoin{})
+			if err := m.DisplayNextUnlockAmount[len(m.DisplayNextUnlockAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingAmendmentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingAmendmentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingAmendmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingAmendmentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingAmendmentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingAmendmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amendments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amendments = append(m.Amendments, VestingAmendment{})
+			if err := m.Amendments[len(m.Amendments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMintsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mints = append(m.Mints, MintRecord{})
+			if err := m.Mints[len(m.Mints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLastSnapshotRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -344,12 +4711,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastSnapshotRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastSnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -371,7 +4770,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLastSnapshotResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -394,15 +4793,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastSnapshotResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastSnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -429,7 +4828,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.State == nil {
+				m.State = &SnapshotState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
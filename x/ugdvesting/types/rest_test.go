@@ -1,11 +0,0 @@
-package types_test
-
-import (
-	"testing"
-
-	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
-)
-
-func TestHegdehogRequestGetVesting(t *testing.T) {
-	types.HegdehogRequestGetVestingByAddr("")
-}
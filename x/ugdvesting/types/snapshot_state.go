@@ -0,0 +1,45 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// CheckSnapshotOrder returns the rejection reason and error for a snapshot
+// whose document hashes to hash and that may not follow last, the last
+// accepted snapshot of its kind, nil before the first one. Signatures are not
+// checked, see Params.VerifySnapshotSignature.
+func CheckSnapshotOrder(last *SnapshotState, snapshot SnapshotHeader, hash []byte) (string, error) {
+	timestamp, err := time.Parse(time.RFC3339Nano, snapshot.Timestamp)
+	if err != nil {
+		return SnapshotRejectedInvalidTimestamp, errorsmod.Wrapf(ErrSnapshotRejected, "invalid timestamp %q", snapshot.Timestamp)
+	}
+
+	if last == nil {
+		// the first snapshot has nothing to chain to
+		return "", nil
+	}
+
+	if bytes.Equal(last.Hash, hash) {
+		return SnapshotRejectedReplayed, errorsmod.Wrap(ErrSnapshotRejected, "snapshot was already accepted")
+	}
+
+	lastTimestamp, err := time.Parse(time.RFC3339Nano, last.Timestamp)
+	if err != nil {
+		return SnapshotRejectedInvalidTimestamp, fmt.Errorf("stored snapshot timestamp %q is invalid: %w", last.Timestamp, err)
+	}
+
+	if !timestamp.After(lastTimestamp) {
+		return SnapshotRejectedStale, errorsmod.Wrapf(ErrSnapshotRejected, "timestamp %s is not after the last accepted %s", snapshot.Timestamp, last.Timestamp)
+	}
+
+	previous, err := time.Parse(time.RFC3339Nano, snapshot.PreviousTimeStamp)
+	if err != nil || !previous.Equal(lastTimestamp) {
+		return SnapshotRejectedUnchained, errorsmod.Wrapf(ErrSnapshotRejected, "previous timestamp %q does not match the last accepted %s", snapshot.PreviousTimeStamp, last.Timestamp)
+	}
+
+	return "", nil
+}
@@ -5,8 +5,11 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -126,229 +129,3469 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "ugdvesting.ugdvesting.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ugdvesting.ugdvesting.MsgUpdateParamsResponse")
+// MsgSubmitVestingBatch is the Msg/SubmitVestingBatch request type.
+type MsgSubmitVestingBatch struct {
+	// relayer is the submitting address, it must be listed in Params.relayers.
+	Relayer string `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// snapshot is the raw vesting-storage document served by hedgehog. It is
+	// only accepted when it carries enough hedgehog signatures and chains to
+	// the last accepted snapshot.
+	Snapshot []byte `protobuf:"bytes,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
 }
 
-func init() { proto.RegisterFile("ugdvesting/ugdvesting/tx.proto", fileDescriptor_e568c7d16121e982) }
-
-var fileDescriptor_e568c7d16121e982 = []byte{
-	// 364 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2b, 0x4d, 0x4f, 0x29,
-	0x4b, 0x2d, 0x2e, 0xc9, 0xcc, 0x4b, 0xd7, 0x47, 0x62, 0x96, 0x54, 0xe8, 0x15, 0x14, 0xe5, 0x97,
-	0xe4, 0x0b, 0x89, 0x22, 0x04, 0xf5, 0x10, 0x4c, 0x29, 0xc1, 0xc4, 0xdc, 0xcc, 0xbc, 0x7c, 0x7d,
-	0x30, 0x09, 0x51, 0x29, 0x25, 0x9e, 0x9c, 0x5f, 0x9c, 0x9b, 0x5f, 0xac, 0x9f, 0x5b, 0x9c, 0xae,
-	0x5f, 0x66, 0x08, 0xa2, 0xa0, 0x12, 0x92, 0x10, 0x89, 0x78, 0x30, 0x4f, 0x1f, 0xc2, 0x81, 0x4a,
-	0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x43, 0xc4, 0x41, 0x2c, 0xa8, 0xa8, 0x12, 0x76, 0x37, 0x15, 0x24,
-	0x16, 0x25, 0xe6, 0x42, 0x75, 0x2a, 0x1d, 0x67, 0xe4, 0xe2, 0xf7, 0x2d, 0x4e, 0x0f, 0x2d, 0x48,
-	0x49, 0x2c, 0x49, 0x0d, 0x00, 0xcb, 0x08, 0x99, 0x71, 0x71, 0x26, 0x96, 0x96, 0x64, 0xe4, 0x17,
-	0x65, 0x96, 0x54, 0x4a, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x3a, 0x49, 0x5c, 0xda, 0xa2, 0x2b, 0x02,
-	0xb5, 0xd2, 0x31, 0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x38, 0xb8, 0xa4, 0x28, 0x33, 0x2f, 0x3d, 0x08,
-	0xa1, 0x54, 0xc8, 0x81, 0x8b, 0x0d, 0x62, 0xb6, 0x04, 0x93, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0xac,
-	0x1e, 0x56, 0x4f, 0xeb, 0x41, 0xac, 0x71, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d,
-	0x5a, 0x8c, 0x41, 0x50, 0x7d, 0x56, 0x56, 0x4d, 0xcf, 0x37, 0x68, 0x21, 0x4c, 0xec, 0x7a, 0xbe,
-	0x41, 0x4b, 0x1d, 0xc9, 0xe5, 0x15, 0xc8, 0xde, 0x40, 0x73, 0xb5, 0x92, 0x24, 0x97, 0x38, 0x9a,
-	0x50, 0x50, 0x6a, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa, 0x51, 0x09, 0x17, 0xb3, 0x6f, 0x71, 0xba,
-	0x50, 0x1a, 0x17, 0x0f, 0x8a, 0x3f, 0xd5, 0x70, 0xb8, 0x0f, 0xcd, 0x18, 0x29, 0x3d, 0xe2, 0xd4,
-	0xc1, 0xac, 0x93, 0x62, 0x6d, 0x00, 0x79, 0xca, 0x29, 0xfb, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f,
-	0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b,
-	0x8f, 0xe5, 0x18, 0xa2, 0x02, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5,
-	0x4b, 0xf3, 0x32, 0xd3, 0x8b, 0x32, 0x53, 0x74, 0x0b, 0x8a, 0xf2, 0xb3, 0x52, 0x93, 0x4b, 0xa0,
-	0xf1, 0xaa, 0x5b, 0x9c, 0x92, 0xad, 0x0b, 0x93, 0xca, 0x48, 0x4d, 0x49, 0x4f, 0xcd, 0xc8, 0x4f,
-	0xd7, 0xc5, 0x1a, 0x0e, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0xe8, 0x34, 0x06, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x63, 0xd2, 0x08, 0xb4, 0x88, 0x02, 0x00, 0x00,
+func (m *MsgSubmitVestingBatch) Reset()         { *m = MsgSubmitVestingBatch{} }
+func (m *MsgSubmitVestingBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitVestingBatch) ProtoMessage()    {}
+func (*MsgSubmitVestingBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e568c7d16121e982, []int{2}
+}
+func (m *MsgSubmitVestingBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitVestingBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitVestingBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitVestingBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitVestingBatch.Merge(m, src)
+}
+func (m *MsgSubmitVestingBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitVestingBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitVestingBatch.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgSubmitVestingBatch proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgSubmitVestingBatch) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSubmitVestingBatch) GetSnapshot() []byte {
+	if m != nil {
+		return m.Snapshot
+	}
+	return nil
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgSubmitVestingBatchResponse defines the response structure for executing a
+// MsgSubmitVestingBatch message.
+type MsgSubmitVestingBatchResponse struct {
+	// accepted is the number of entries of the snapshot stored, entries of
+	// already processed addresses are skipped.
+	Accepted uint32 `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// rejected is the reason the snapshot was rejected, one of the reasons of
+	// EventSnapshotRejected, empty when it was accepted. A rejected snapshot
+	// does not fail the transaction, so its EventSnapshotRejected is kept.
+	Rejected string `protobuf:"bytes,2,opt,name=rejected,proto3" json:"rejected,omitempty"`
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/ugdvesting.ugdvesting.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitVestingBatchResponse) Reset()         { *m = MsgSubmitVestingBatchResponse{} }
+func (m *MsgSubmitVestingBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitVestingBatchResponse) ProtoMessage()    {}
+func (*MsgSubmitVestingBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e568c7d16121e982, []int{3}
+}
+func (m *MsgSubmitVestingBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitVestingBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitVestingBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (m *MsgSubmitVestingBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitVestingBatchResponse.Merge(m, src)
+}
+func (m *MsgSubmitVestingBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitVestingBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitVestingBatchResponse.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgSubmitVestingBatchResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitVestingBatchResponse) GetAccepted() uint32 {
+	if m != nil {
+		return m.Accepted
+	}
+	return 0
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgSubmitVestingBatchResponse) GetRejected() string {
+	if m != nil {
+		return m.Rejected
+	}
+	return ""
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+// MsgAddHedgehogKey is the Msg/AddHedgehogKey request type.
+type MsgAddHedgehogKey struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// key is the signer to add, its id must not be in use.
+	Key HedgehogKey `protobuf:"bytes,2,opt,name=key,proto3" json:"key"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+func (m *MsgAddHedgehogKey) Reset()         { *m = MsgAddHedgehogKey{} }
+func (m *MsgAddHedgehogKey) String() string { return proto.CompactTextString(m) }
+func (*MsgAddHedgehogKey) ProtoMessage()    {}
+func (*MsgAddHedgehogKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e568c7d16121e982, []int{4}
+}
+func (m *MsgAddHedgehogKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddHedgehogKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddHedgehogKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ugdvesting.ugdvesting.Msg/UpdateParams",
+}
+func (m *MsgAddHedgehogKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddHedgehogKey.Merge(m, src)
+}
+func (m *MsgAddHedgehogKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddHedgehogKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddHedgehogKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddHedgehogKey proto.InternalMessageInfo
+
+func (m *MsgAddHedgehogKey) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	return ""
+}
+
+func (m *MsgAddHedgehogKey) GetKey() HedgehogKey {
+	if m != nil {
+		return m.Key
 	}
-	return interceptor(ctx, in, info, handler)
+	return HedgehogKey{}
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ugdvesting.ugdvesting.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ugdvesting/ugdvesting/tx.proto",
+// MsgAddHedgehogKeyResponse defines the response structure for executing a
+// MsgAddHedgehogKey message.
+type MsgAddHedgehogKeyResponse struct {
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAddHedgehogKeyResponse) Reset()         { *m = MsgAddHedgehogKeyResponse{} }
+func (m *MsgAddHedgehogKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddHedgehogKeyResponse) ProtoMessage()    {}
+func (*MsgAddHedgehogKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e568c7d16121e982, []int{5}
+}
+func (m *MsgAddHedgehogKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddHedgehogKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddHedgehogKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgAddHedgehogKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddHedgehogKeyResponse.Merge(m, src)
+}
+func (m *MsgAddHedgehogKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddHedgehogKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddHedgehogKeyResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgAddHedgehogKeyResponse proto.InternalMessageInfo
+
+// MsgRotateHedgehogKey is the Msg/RotateHedgehogKey request type.
+type MsgRotateHedgehogKey struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// key replaces the signer with the same id.
+	Key HedgehogKey `protobuf:"bytes,2,opt,name=key,proto3" json:"key"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRotateHedgehogKey) Reset()         { *m = MsgRotateHedgehogKey{} }
+func (m *MsgRotateHedgehogKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateHedgehogKey) ProtoMessage()    {}
+func (*MsgRotateHedgehogKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e568c7d16121e982, []int{6}
+}
+func (m *MsgRotateHedgehogKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateHedgehogKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateHedgehogKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRotateHedgehogKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateHedgehogKey.Merge(m, src)
+}
+func (m *MsgRotateHedgehogKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateHedgehogKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateHedgehogKey.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRotateHedgehogKey proto.InternalMessageInfo
+
+func (m *MsgRotateHedgehogKey) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRotateHedgehogKey) GetKey() HedgehogKey {
+	if m != nil {
+		return m.Key
+	}
+	return HedgehogKey{}
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgRotateHedgehogKeyResponse defines the response structure for executing a
+// MsgRotateHedgehogKey message.
+type MsgRotateHedgehogKeyResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgRotateHedgehogKeyResponse) Reset()         { *m = MsgRotateHedgehogKeyResponse{} }
+func (m *MsgRotateHedgehogKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateHedgehogKeyResponse) ProtoMessage()    {}
+func (*MsgRotateHedgehogKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e568c7d16121e982, []int{7}
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRotateHedgehogKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateHedgehogKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateHedgehogKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgRotateHedgehogKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateHedgehogKeyResponse.Merge(m, src)
+}
+func (m *MsgRotateHedgehogKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateHedgehogKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateHedgehogKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateHedgehogKeyResponse proto.InternalMessageInfo
+
+// MsgRetireHedgehogKey is the Msg/RetireHedgehogKey request type.
+type MsgRetireHedgehogKey struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// id is the signer to remove.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgRetireHedgehogKey) Reset()         { *m = MsgRetireHedgehogKey{} }
+func (m *MsgRetireHedgehogKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRetireHedgehogKey) ProtoMessage()    {}
+func (*MsgRetireHedgehogKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e568c7d16121e982, []int{8}
+}
+func (m *MsgRetireHedgehogKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetireHedgehogKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetireHedgehogKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetireHedgehogKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetireHedgehogKey.Merge(m, src)
+}
+func (m *MsgRetireHedgehogKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetireHedgehogKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetireHedgehogKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetireHedgehogKey proto.InternalMessageInfo
+
+func (m *MsgRetireHedgehogKey) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRetireHedgehogKey) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// MsgRetireHedgehogKeyResponse defines the response structure for executing a
+// MsgRetireHedgehogKey message.
+type MsgRetireHedgehogKeyResponse struct {
+}
+
+func (m *MsgRetireHedgehogKeyResponse) Reset()         { *m = MsgRetireHedgehogKeyResponse{} }
+func (m *MsgRetireHedgehogKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRetireHedgehogKeyResponse) ProtoMessage()    {}
+func (*MsgRetireHedgehogKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e568c7d16121e982, []int{9}
+}
+func (m *MsgRetireHedgehogKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetireHedgehogKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetireHedgehogKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetireHedgehogKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetireHedgehogKeyResponse.Merge(m, src)
+}
+func (m *MsgRetireHedgehogKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetireHedgehogKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetireHedgehogKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetireHedgehogKeyResponse proto.InternalMessageInfo
+
+// MsgCreateVestingSchedule is the Msg/CreateVestingSchedule request type.
+type MsgCreateVestingSchedule struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// schedule is stored as pending and activated at its block like the
+	// records hedgehog serves. It replaces the pending record of the same
+	// address, if any.
+	Schedule VestingData `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule"`
+}
+
+func (m *MsgCreateVestingSchedule) Reset()         { *m = MsgCreateVestingSchedule{} }
+func (m *MsgCreateVestingSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateVestingSchedule) ProtoMessage()    {}
+func (*MsgCreateVestingSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e568c7d16121e982, []int{10}
+}
+func (m *MsgCreateVestingSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateVestingSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateVestingSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateVestingSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateVestingSchedule.Merge(m, src)
+}
+func (m *MsgCreateVestingSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateVestingSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateVestingSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateVestingSchedule proto.InternalMessageInfo
+
+func (m *MsgCreateVestingSchedule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgCreateVestingSchedule) GetSchedule() VestingData {
+	if m != nil {
+		return m.Schedule
+	}
+	return VestingData{}
+}
+
+// MsgCreateVestingScheduleResponse defines the response structure for
+// executing a MsgCreateVestingSchedule message.
+type MsgCreateVestingScheduleResponse struct {
+	// block is the height the schedule is activated at.
+	Block int64 `protobuf:"varint,1,opt,name=block,proto3" json:"block,omitempty"`
+}
+
+func (m *MsgCreateVestingScheduleResponse) Reset()         { *m = MsgCreateVestingScheduleResponse{} }
+func (m *MsgCreateVestingScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateVestingScheduleResponse) ProtoMessage()    {}
+func (*MsgCreateVestingScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e568c7d16121e982, []int{11}
+}
+func (m *MsgCreateVestingScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateVestingScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateVestingScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateVestingScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateVestingScheduleResponse.Merge(m, src)
+}
+func (m *MsgCreateVestingScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateVestingScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateVestingScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateVestingScheduleResponse proto.InternalMessageInfo
+
+func (m *MsgCreateVestingScheduleResponse) GetBlock() int64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+// MsgClawbackVesting is the Msg/ClawbackVesting request type. It ends the
+// schedule of an account converted by the module and sends its unvested
+// coins to the destination. The vested coins stay with the account.
+type MsgClawbackVesting struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// address is the vesting account to claw back.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// destination receives the unvested coins.
+	Destination ClawbackDestination `protobuf:"varint,3,opt,name=destination,proto3,enum=ugdvesting.ugdvesting.ClawbackDestination" json:"destination,omitempty"`
+}
+
+func (m *MsgClawbackVesting) Reset()         { *m = MsgClawbackVesting{} }
+func (m *MsgClawbackVesting) String() string { return proto.CompactTextString(m) }
+func (*MsgClawbackVesting) ProtoMessage()    {}
+func (*MsgClawbackVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e568c7d16121e982, []int{12}
+}
+func (m *MsgClawbackVesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawbackVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawbackVesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawbackVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawbackVesting.Merge(m, src)
+}
+func (m *MsgClawbackVesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawbackVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawbackVesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawbackVesting proto.InternalMessageInfo
+
+func (m *MsgClawbackVesting) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgClawbackVesting) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgClawbackVesting) GetDestination() ClawbackDestination {
+	if m != nil {
+		return m.Destination
+	}
+	return ClawbackDestination_CLAWBACK_DESTINATION_UNSPECIFIED
+}
+
+// MsgClawbackVestingResponse defines the response structure for executing a
+// MsgClawbackVesting message.
+type MsgClawbackVestingResponse struct {
+	// amount are the unvested coins sent to the destination.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// delegated are the unvested coins the account delegated, they are not
+	// clawed back and stay on its schedule.
+	Delegated github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=delegated,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"delegated"`
+}
+
+func (m *MsgClawbackVestingResponse) Reset()         { *m = MsgClawbackVestingResponse{} }
+func (m *MsgClawbackVestingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClawbackVestingResponse) ProtoMessage()    {}
+func (*MsgClawbackVestingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e568c7d16121e982, []int{13}
+}
+func (m *MsgClawbackVestingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawbackVestingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawbackVestingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawbackVestingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawbackVestingResponse.Merge(m, src)
+}
+func (m *MsgClawbackVestingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawbackVestingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawbackVestingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawbackVestingResponse proto.InternalMessageInfo
+
+func (m *MsgClawbackVestingResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *MsgClawbackVestingResponse) GetDelegated() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Delegated
+	}
+	return nil
+}
+
+// MsgAmendPendingVesting is the Msg/AmendPendingVesting request type. The
+// terms replace those of the pending record of address, its additional
+// amounts and funder are kept.
+type MsgAmendPendingVesting struct {
+	// signer is the module authority or one of Params.amendment_operators.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// address is the account of the pending record.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is in base units of the vested denom.
+	Amount   cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Start    int64                 `protobuf:"varint,4,opt,name=start,proto3" json:"start,omitempty"`
+	Duration int64                 `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	Parts    int32                 `protobuf:"varint,6,opt,name=parts,proto3" json:"parts,omitempty"`
+	Percent  int32                 `protobuf:"varint,7,opt,name=percent,proto3" json:"percent,omitempty"`
+	Cliff    int32                 `protobuf:"varint,8,opt,name=cliff,proto3" json:"cliff,omitempty"`
+	Block    int64                 `protobuf:"varint,9,opt,name=block,proto3" json:"block,omitempty"`
+	// reason is recorded in the amendment history.
+	Reason string `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
+	// expected_hash is the hash of the record the terms were based on, see
+	// VestingData.Hash. The amendment is rejected when the record changed
+	// since, so that a concurrent change is not overwritten. Empty skips the
+	// check.
+	ExpectedHash []byte `protobuf:"bytes,11,opt,name=expected_hash,json=expectedHash,proto3" json:"expected_hash,omitempty"`
+}
+
+func (m *MsgAmendPendingVesting) Reset()         { *m = MsgAmendPendingVesting{} }
+func (m *MsgAmendPendingVesting) String() string { return proto.CompactTextString(m) }
+func (*MsgAmendPendingVesting) ProtoMessage()    {}
+func (*MsgAmendPendingVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e568c7d16121e982, []int{14}
+}
+func (m *MsgAmendPendingVesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAmendPendingVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAmendPendingVesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAmendPendingVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAmendPendingVesting.Merge(m, src)
+}
+func (m *MsgAmendPendingVesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAmendPendingVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAmendPendingVesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAmendPendingVesting proto.InternalMessageInfo
+
+func (m *MsgAmendPendingVesting) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgAmendPendingVesting) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgAmendPendingVesting) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *MsgAmendPendingVesting) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *MsgAmendPendingVesting) GetParts() int32 {
+	if m != nil {
+		return m.Parts
+	}
+	return 0
+}
+
+func (m *MsgAmendPendingVesting) GetPercent() int32 {
+	if m != nil {
+		return m.Percent
+	}
+	return 0
+}
+
+func (m *MsgAmendPendingVesting) GetCliff() int32 {
+	if m != nil {
+		return m.Cliff
+	}
+	return 0
+}
+
+func (m *MsgAmendPendingVesting) GetBlock() int64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+func (m *MsgAmendPendingVesting) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *MsgAmendPendingVesting) GetExpectedHash() []byte {
+	if m != nil {
+		return m.ExpectedHash
+	}
+	return nil
+}
+
+// MsgAmendPendingVestingResponse defines the response structure for executing
+// a MsgAmendPendingVesting message.
+type MsgAmendPendingVestingResponse struct {
+	// version is the version of the amendment in the history of address.
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// block is the height the amended record is activated at.
+	Block int64 `protobuf:"varint,2,opt,name=block,proto3" json:"block,omitempty"`
+}
+
+func (m *MsgAmendPendingVestingResponse) Reset()         { *m = MsgAmendPendingVestingResponse{} }
+func (m *MsgAmendPendingVestingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAmendPendingVestingResponse) ProtoMessage()    {}
+func (*MsgAmendPendingVestingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e568c7d16121e982, []int{15}
+}
+func (m *MsgAmendPendingVestingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAmendPendingVestingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAmendPendingVestingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAmendPendingVestingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAmendPendingVestingResponse.Merge(m, src)
+}
+func (m *MsgAmendPendingVestingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAmendPendingVestingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAmendPendingVestingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAmendPendingVestingResponse proto.InternalMessageInfo
+
+func (m *MsgAmendPendingVestingResponse) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *MsgAmendPendingVestingResponse) GetBlock() int64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "ugdvesting.ugdvesting.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ugdvesting.ugdvesting.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSubmitVestingBatch)(nil), "ugdvesting.ugdvesting.MsgSubmitVestingBatch")
+	proto.RegisterType((*MsgSubmitVestingBatchResponse)(nil), "ugdvesting.ugdvesting.MsgSubmitVestingBatchResponse")
+	proto.RegisterType((*MsgAddHedgehogKey)(nil), "ugdvesting.ugdvesting.MsgAddHedgehogKey")
+	proto.RegisterType((*MsgAddHedgehogKeyResponse)(nil), "ugdvesting.ugdvesting.MsgAddHedgehogKeyResponse")
+	proto.RegisterType((*MsgRotateHedgehogKey)(nil), "ugdvesting.ugdvesting.MsgRotateHedgehogKey")
+	proto.RegisterType((*MsgRotateHedgehogKeyResponse)(nil), "ugdvesting.ugdvesting.MsgRotateHedgehogKeyResponse")
+	proto.RegisterType((*MsgRetireHedgehogKey)(nil), "ugdvesting.ugdvesting.MsgRetireHedgehogKey")
+	proto.RegisterType((*MsgRetireHedgehogKeyResponse)(nil), "ugdvesting.ugdvesting.MsgRetireHedgehogKeyResponse")
+	proto.RegisterType((*MsgCreateVestingSchedule)(nil), "ugdvesting.ugdvesting.MsgCreateVestingSchedule")
+	proto.RegisterType((*MsgCreateVestingScheduleResponse)(nil), "ugdvesting.ugdvesting.MsgCreateVestingScheduleResponse")
+	proto.RegisterType((*MsgClawbackVesting)(nil), "ugdvesting.ugdvesting.MsgClawbackVesting")
+	proto.RegisterType((*MsgClawbackVestingResponse)(nil), "ugdvesting.ugdvesting.MsgClawbackVestingResponse")
+	proto.RegisterType((*MsgAmendPendingVesting)(nil), "ugdvesting.ugdvesting.MsgAmendPendingVesting")
+	proto.RegisterType((*MsgAmendPendingVestingResponse)(nil), "ugdvesting.ugdvesting.MsgAmendPendingVestingResponse")
+}
+
+func init() { proto.RegisterFile("ugdvesting/ugdvesting/tx.proto", fileDescriptor_e568c7d16121e982) }
+
+var fileDescriptor_e568c7d16121e982 = []byte{
+	// 1169 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xda, 0x8d, 0x1d, 0xbf, 0xb4, 0xe9, 0x37, 0xfb, 0x4d, 0xda, 0xcd, 0x42, 0x1d, 0x6b,
+	0x2b, 0x81, 0x9b, 0xd6, 0xeb, 0xc4, 0xa1, 0x80, 0x2c, 0xa0, 0x34, 0xe9, 0xa1, 0x01, 0x2c, 0x45,
+	0x1b, 0x01, 0x12, 0x97, 0x6a, 0xbc, 0x3b, 0xd9, 0x5d, 0x62, 0xef, 0x58, 0x3b, 0xe3, 0x90, 0x88,
+	0x0b, 0x70, 0xe0, 0x80, 0x84, 0xc4, 0x7f, 0xc0, 0x15, 0x21, 0x21, 0xe5, 0xd0, 0x23, 0x77, 0x2a,
+	0x0e, 0x28, 0xea, 0x09, 0x71, 0x28, 0x28, 0x39, 0xe4, 0xdf, 0x40, 0xb3, 0x3b, 0xbb, 0xfe, 0xb1,
+	0x6b, 0x27, 0x8e, 0x40, 0xe2, 0x12, 0xef, 0x7b, 0xef, 0xf3, 0xe6, 0xbd, 0xcf, 0x7b, 0x33, 0xf3,
+	0x26, 0x50, 0xec, 0xda, 0xd6, 0x3e, 0xa6, 0xcc, 0xf5, 0xec, 0x6a, 0xdf, 0x27, 0x3b, 0xd0, 0x3b,
+	0x3e, 0x61, 0x44, 0x5e, 0xec, 0x29, 0xf5, 0xde, 0xa7, 0x3a, 0x8f, 0xda, 0xae, 0x47, 0xaa, 0xc1,
+	0xdf, 0x10, 0xa9, 0x16, 0x4d, 0x42, 0xdb, 0x84, 0x56, 0x9b, 0x88, 0xe2, 0xea, 0xfe, 0x5a, 0x13,
+	0x33, 0xb4, 0x56, 0x35, 0x89, 0xeb, 0x09, 0xfb, 0x4d, 0x61, 0x6f, 0x53, 0xbb, 0xba, 0xbf, 0xc6,
+	0x7f, 0x84, 0x61, 0x29, 0x34, 0x3c, 0x09, 0xa4, 0x6a, 0x28, 0x08, 0xd3, 0x82, 0x4d, 0x6c, 0x12,
+	0xea, 0xf9, 0x97, 0xd0, 0x6a, 0xe9, 0x39, 0x77, 0x90, 0x8f, 0xda, 0x91, 0xe7, 0xed, 0x74, 0x4c,
+	0x44, 0x25, 0x00, 0x69, 0xbf, 0x48, 0x70, 0xbd, 0x41, 0xed, 0x0f, 0x3b, 0x16, 0x62, 0x78, 0x3b,
+	0x70, 0x97, 0x5f, 0x87, 0x02, 0xea, 0x32, 0x87, 0xf8, 0x2e, 0x3b, 0x54, 0xa4, 0x92, 0x54, 0x2e,
+	0x6c, 0x28, 0xcf, 0x9f, 0x56, 0x16, 0x44, 0x5e, 0x0f, 0x2d, 0xcb, 0xc7, 0x94, 0xee, 0x30, 0xdf,
+	0xf5, 0x6c, 0xa3, 0x07, 0x95, 0xdf, 0x85, 0x5c, 0x98, 0x80, 0x92, 0x29, 0x49, 0xe5, 0xd9, 0xda,
+	0x2d, 0x3d, 0xb5, 0x72, 0x7a, 0x18, 0x66, 0xa3, 0xf0, 0xec, 0xc5, 0xf2, 0xd4, 0x0f, 0x67, 0x47,
+	0x2b, 0x92, 0x21, 0xfc, 0xea, 0xf5, 0xaf, 0xce, 0x8e, 0x56, 0x7a, 0x2b, 0x7e, 0x73, 0x76, 0xb4,
+	0xf2, 0x6a, 0x5f, 0xea, 0x07, 0xfd, 0x3c, 0x86, 0xb2, 0xd6, 0x96, 0xe0, 0xe6, 0x90, 0xca, 0xc0,
+	0xb4, 0x43, 0x3c, 0x8a, 0xb5, 0x9f, 0x24, 0x58, 0x6c, 0x50, 0x7b, 0xa7, 0xdb, 0x6c, 0xbb, 0xec,
+	0xa3, 0xd0, 0x7f, 0x03, 0x31, 0xd3, 0x91, 0x6b, 0x90, 0xf7, 0x71, 0x0b, 0x1d, 0x62, 0xff, 0x5c,
+	0xa2, 0x11, 0x50, 0x56, 0x61, 0x86, 0x7a, 0xa8, 0x43, 0x1d, 0xc2, 0x94, 0x6c, 0x49, 0x2a, 0x5f,
+	0x35, 0x62, 0xb9, 0xfe, 0x0e, 0x27, 0x10, 0x21, 0x79, 0xfa, 0x95, 0x31, 0xe9, 0x27, 0xf3, 0x79,
+	0xef, 0xca, 0x4c, 0xe6, 0x7f, 0x59, 0xed, 0x63, 0xb8, 0x95, 0x6a, 0x8e, 0x08, 0xf1, 0x14, 0x90,
+	0x69, 0xe2, 0x0e, 0xc3, 0x56, 0x90, 0xf7, 0x35, 0x23, 0x96, 0xb9, 0xcd, 0xc7, 0x9f, 0x62, 0x93,
+	0xdb, 0x78, 0x1f, 0x0a, 0x46, 0x2c, 0x6b, 0xbf, 0x4a, 0x30, 0xdf, 0xa0, 0xf6, 0x43, 0xcb, 0x7a,
+	0x8c, 0x2d, 0x1b, 0x3b, 0xc4, 0x7e, 0x1f, 0x1f, 0x5e, 0xba, 0xdf, 0x0f, 0x20, 0xbb, 0x87, 0x0f,
+	0x45, 0xb3, 0xb5, 0x11, 0xcd, 0xee, 0x0b, 0xd4, 0xdf, 0x71, 0xee, 0x59, 0x7f, 0x2b, 0xd9, 0xee,
+	0x3b, 0x63, 0xea, 0x35, 0x98, 0xb6, 0xf6, 0x12, 0x2c, 0x25, 0x94, 0x71, 0xcb, 0x8f, 0x25, 0x58,
+	0x68, 0x50, 0xdb, 0x20, 0x0c, 0x31, 0xfc, 0x9f, 0x20, 0xfb, 0x20, 0x49, 0xf6, 0xde, 0x18, 0xb2,
+	0x89, 0xcc, 0xb5, 0x22, 0xbc, 0x9c, 0xa6, 0x8f, 0x29, 0x7f, 0x2f, 0x28, 0x63, 0xe6, 0xfa, 0xff,
+	0x08, 0xe5, 0x39, 0xc8, 0xb8, 0xd1, 0x1e, 0xca, 0xb8, 0xd6, 0xc4, 0x0c, 0x86, 0x13, 0x89, 0x18,
+	0x0c, 0xeb, 0x63, 0x06, 0x27, 0x12, 0x28, 0x0d, 0x6a, 0x6f, 0xfa, 0x18, 0x31, 0x2c, 0x36, 0xfe,
+	0x8e, 0xe9, 0x60, 0xab, 0xdb, 0xc2, 0x97, 0x66, 0xb1, 0x05, 0x33, 0x54, 0xac, 0x71, 0x4e, 0xf7,
+	0x44, 0xc4, 0x47, 0x88, 0xa1, 0xfe, 0xee, 0xc5, 0xee, 0xf5, 0xcd, 0x64, 0x01, 0x56, 0xc7, 0x14,
+	0x20, 0x95, 0x87, 0xf6, 0x26, 0x94, 0x46, 0xd9, 0xe2, 0xf3, 0xbd, 0x00, 0xd3, 0xcd, 0x16, 0x31,
+	0xf7, 0x02, 0x9e, 0x59, 0x23, 0x14, 0xb4, 0x6f, 0x33, 0x20, 0x73, 0xd7, 0x16, 0xfa, 0xac, 0x89,
+	0xcc, 0x3d, 0xe1, 0x7c, 0xe9, 0xc2, 0xd4, 0x20, 0x8f, 0x42, 0x9b, 0x92, 0x39, 0xc7, 0x2b, 0x02,
+	0xca, 0x1f, 0xc0, 0xac, 0x15, 0x84, 0x45, 0xcc, 0x25, 0x5e, 0x70, 0xfd, 0xcd, 0xd5, 0x56, 0x46,
+	0xd4, 0x33, 0x4a, 0xf4, 0x51, 0xcf, 0xc3, 0xe8, 0x77, 0xaf, 0xbf, 0x9d, 0xac, 0xe7, 0xca, 0xb8,
+	0x7a, 0x0e, 0x12, 0xd7, 0xbe, 0xce, 0x80, 0x9a, 0x54, 0xc7, 0x45, 0x74, 0x20, 0x87, 0xda, 0xa4,
+	0xeb, 0x31, 0x45, 0x2a, 0x65, 0xcb, 0xb3, 0xb5, 0x25, 0x5d, 0x70, 0xe3, 0xe3, 0x59, 0x17, 0xe3,
+	0x59, 0xdf, 0x24, 0xae, 0xb7, 0x71, 0x9f, 0x77, 0xfb, 0xc7, 0x3f, 0x97, 0xcb, 0xb6, 0xcb, 0x9c,
+	0x6e, 0x53, 0x37, 0x49, 0x5b, 0x4c, 0x61, 0xf1, 0x53, 0xa1, 0xd6, 0x5e, 0x95, 0x1d, 0x76, 0x30,
+	0x0d, 0x1c, 0xa8, 0x18, 0x5b, 0xe1, 0xfa, 0xb2, 0x07, 0x05, 0x0b, 0xb7, 0xb0, 0x8d, 0xf8, 0x9d,
+	0x9b, 0xfd, 0x97, 0x82, 0xf5, 0x42, 0x84, 0x53, 0xc2, 0x98, 0xe9, 0x7a, 0x4d, 0xe2, 0x59, 0xd8,
+	0xd2, 0x7e, 0xcb, 0xc2, 0x0d, 0x7e, 0x15, 0xb6, 0xb1, 0x67, 0x6d, 0x63, 0xcf, 0x72, 0x3d, 0x3b,
+	0xda, 0x1c, 0xab, 0x90, 0xa3, 0xae, 0xed, 0x5d, 0x60, 0xbe, 0x09, 0xdc, 0xa5, 0xb6, 0xc5, 0x66,
+	0x5c, 0xea, 0x6c, 0xe0, 0x72, 0x97, 0x53, 0xfc, 0xe3, 0xc5, 0xf2, 0x62, 0xe8, 0x46, 0xad, 0x3d,
+	0xdd, 0x25, 0xd5, 0x36, 0x62, 0x8e, 0xbe, 0xe5, 0xb1, 0xe7, 0x4f, 0x2b, 0x20, 0xd6, 0xdb, 0xf2,
+	0x58, 0x5c, 0xc5, 0x05, 0x98, 0xa6, 0x0c, 0xf9, 0x4c, 0xb9, 0x12, 0x6e, 0xfa, 0x40, 0xe0, 0xe3,
+	0xcc, 0xea, 0xfa, 0xe1, 0x76, 0x9b, 0x0e, 0x0c, 0xb1, 0xcc, 0x3d, 0x3a, 0xc8, 0x67, 0x54, 0xc9,
+	0x95, 0xa4, 0xf2, 0xb4, 0x11, 0x0a, 0xb2, 0x02, 0xf9, 0x0e, 0xf6, 0x4d, 0xec, 0x31, 0x25, 0x1f,
+	0xe8, 0x23, 0x91, 0xe3, 0xcd, 0x96, 0xbb, 0xbb, 0xab, 0xcc, 0x84, 0xf8, 0x40, 0xe8, 0x1d, 0xb6,
+	0x42, 0xdf, 0x61, 0x93, 0x6f, 0x40, 0xce, 0xc7, 0x88, 0x12, 0x4f, 0x81, 0xe0, 0x02, 0x14, 0x92,
+	0x7c, 0x1b, 0xae, 0xe1, 0x83, 0x4e, 0x30, 0x4e, 0x9f, 0x38, 0x88, 0x3a, 0xca, 0x6c, 0xf0, 0x04,
+	0xb8, 0x1a, 0x29, 0x1f, 0x23, 0xea, 0x84, 0xcf, 0x00, 0x51, 0x50, 0xbe, 0xab, 0xf5, 0x71, 0x53,
+	0x2d, 0xd9, 0x35, 0x6d, 0x1b, 0x8a, 0xe9, 0x96, 0x78, 0x73, 0x2b, 0x90, 0xdf, 0xc7, 0x3e, 0xe5,
+	0x55, 0xe1, 0x8d, 0xbd, 0x62, 0x44, 0x62, 0x8f, 0x4e, 0xa6, 0x8f, 0x4e, 0xed, 0xe7, 0x3c, 0x64,
+	0x1b, 0xd4, 0x96, 0x77, 0xe1, 0xea, 0xc0, 0x5b, 0xef, 0x95, 0x11, 0x67, 0x77, 0xe8, 0x29, 0xa5,
+	0xea, 0x17, 0xc3, 0xc5, 0xf9, 0x1d, 0x80, 0x9c, 0xf2, 0xdc, 0xba, 0x37, 0x7a, 0x95, 0x24, 0x5a,
+	0x7d, 0x6d, 0x12, 0x74, 0x1c, 0xb9, 0x05, 0x73, 0x43, 0xef, 0x9b, 0xf2, 0xe8, 0x75, 0x06, 0x91,
+	0xea, 0xea, 0x45, 0x91, 0x71, 0xb4, 0x2e, 0xcc, 0x27, 0xdf, 0x18, 0x77, 0x47, 0x2f, 0x93, 0x00,
+	0xab, 0xeb, 0x13, 0x80, 0x07, 0xc2, 0x26, 0xe6, 0xfc, 0xb8, 0xb0, 0xc3, 0x60, 0x75, 0x7d, 0x02,
+	0x70, 0x1c, 0xf6, 0x4b, 0x09, 0x16, 0xd3, 0xa7, 0x73, 0x75, 0xf4, 0x72, 0xa9, 0x0e, 0xea, 0x1b,
+	0x13, 0x3a, 0xc4, 0x39, 0x10, 0xb8, 0x3e, 0x3c, 0x01, 0xef, 0x8c, 0x59, 0x6b, 0x10, 0xaa, 0xae,
+	0x5d, 0x18, 0x1a, 0x07, 0xfc, 0x1c, 0xfe, 0x9f, 0x76, 0xb3, 0x56, 0xc6, 0xec, 0x95, 0x24, 0x5c,
+	0xbd, 0x3f, 0x11, 0x3c, 0x0a, 0xae, 0x4e, 0x7f, 0xc1, 0x2f, 0xff, 0x0d, 0xfb, 0xd9, 0x49, 0x51,
+	0x3a, 0x3e, 0x29, 0x4a, 0x7f, 0x9d, 0x14, 0xa5, 0xef, 0x4e, 0x8b, 0x53, 0xc7, 0xa7, 0xc5, 0xa9,
+	0xdf, 0x4f, 0x8b, 0x53, 0x9f, 0x34, 0xfa, 0xa6, 0x48, 0xd7, 0x73, 0x6d, 0xdf, 0xb5, 0x2a, 0x1d,
+	0x9f, 0xf0, 0x07, 0x7f, 0x34, 0x4e, 0x22, 0xb5, 0x23, 0x3a, 0x5a, 0x49, 0xbd, 0x8a, 0x82, 0x81,
+	0xd3, 0xcc, 0x05, 0xff, 0x16, 0xae, 0xff, 0x3d, 0x00, 0xc9, 0x0a, 0x40, 0x9d, 0x15, 0x0f, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SubmitVestingBatch defines an operation for an authorized relayer to
+	// submit a signed hedgehog vesting snapshot.
+	SubmitVestingBatch(ctx context.Context, in *MsgSubmitVestingBatch, opts ...grpc.CallOption) (*MsgSubmitVestingBatchResponse, error)
+	// AddHedgehogKey defines a (governance) operation for adding a key to the
+	// hedgehog signer set.
+	AddHedgehogKey(ctx context.Context, in *MsgAddHedgehogKey, opts ...grpc.CallOption) (*MsgAddHedgehogKeyResponse, error)
+	// RotateHedgehogKey defines a (governance) operation for replacing the
+	// public key of a hedgehog signer.
+	RotateHedgehogKey(ctx context.Context, in *MsgRotateHedgehogKey, opts ...grpc.CallOption) (*MsgRotateHedgehogKeyResponse, error)
+	// RetireHedgehogKey defines a (governance) operation for removing a key
+	// from the hedgehog signer set.
+	RetireHedgehogKey(ctx context.Context, in *MsgRetireHedgehogKey, opts ...grpc.CallOption) (*MsgRetireHedgehogKeyResponse, error)
+	// CreateVestingSchedule defines a (governance) operation for registering a
+	// vesting schedule without hedgehog.
+	CreateVestingSchedule(ctx context.Context, in *MsgCreateVestingSchedule, opts ...grpc.CallOption) (*MsgCreateVestingScheduleResponse, error)
+	// ClawbackVesting defines a (governance) operation for ending the vesting
+	// schedule of an account and taking back its unvested coins.
+	ClawbackVesting(ctx context.Context, in *MsgClawbackVesting, opts ...grpc.CallOption) (*MsgClawbackVestingResponse, error)
+	// AmendPendingVesting defines an operation for the authority or an
+	// amendment operator to change the terms of a pending vesting record.
+	AmendPendingVesting(ctx context.Context, in *MsgAmendPendingVesting, opts ...grpc.CallOption) (*MsgAmendPendingVestingResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/ugdvesting.ugdvesting.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitVestingBatch(ctx context.Context, in *MsgSubmitVestingBatch, opts ...grpc.CallOption) (*MsgSubmitVestingBatchResponse, error) {
+	out := new(MsgSubmitVestingBatchResponse)
+	err := c.cc.Invoke(ctx, "/ugdvesting.ugdvesting.Msg/SubmitVestingBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddHedgehogKey(ctx context.Context, in *MsgAddHedgehogKey, opts ...grpc.CallOption) (*MsgAddHedgehogKeyResponse, error) {
+	out := new(MsgAddHedgehogKeyResponse)
+	err := c.cc.Invoke(ctx, "/ugdvesting.ugdvesting.Msg/AddHedgehogKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RotateHedgehogKey(ctx context.Context, in *MsgRotateHedgehogKey, opts ...grpc.CallOption) (*MsgRotateHedgehogKeyResponse, error) {
+	out := new(MsgRotateHedgehogKeyResponse)
+	err := c.cc.Invoke(ctx, "/ugdvesting.ugdvesting.Msg/RotateHedgehogKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RetireHedgehogKey(ctx context.Context, in *MsgRetireHedgehogKey, opts ...grpc.CallOption) (*MsgRetireHedgehogKeyResponse, error) {
+	out := new(MsgRetireHedgehogKeyResponse)
+	err := c.cc.Invoke(ctx, "/ugdvesting.ugdvesting.Msg/RetireHedgehogKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateVestingSchedule(ctx context.Context, in *MsgCreateVestingSchedule, opts ...grpc.CallOption) (*MsgCreateVestingScheduleResponse, error) {
+	out := new(MsgCreateVestingScheduleResponse)
+	err := c.cc.Invoke(ctx, "/ugdvesting.ugdvesting.Msg/CreateVestingSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClawbackVesting(ctx context.Context, in *MsgClawbackVesting, opts ...grpc.CallOption) (*MsgClawbackVestingResponse, error) {
+	out := new(MsgClawbackVestingResponse)
+	err := c.cc.Invoke(ctx, "/ugdvesting.ugdvesting.Msg/ClawbackVesting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AmendPendingVesting(ctx context.Context, in *MsgAmendPendingVesting, opts ...grpc.CallOption) (*MsgAmendPendingVestingResponse, error) {
+	out := new(MsgAmendPendingVestingResponse)
+	err := c.cc.Invoke(ctx, "/ugdvesting.ugdvesting.Msg/AmendPendingVesting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SubmitVestingBatch defines an operation for an authorized relayer to
+	// submit a signed hedgehog vesting snapshot.
+	SubmitVestingBatch(context.Context, *MsgSubmitVestingBatch) (*MsgSubmitVestingBatchResponse, error)
+	// AddHedgehogKey defines a (governance) operation for adding a key to the
+	// hedgehog signer set.
+	AddHedgehogKey(context.Context, *MsgAddHedgehogKey) (*MsgAddHedgehogKeyResponse, error)
+	// RotateHedgehogKey defines a (governance) operation for replacing the
+	// public key of a hedgehog signer.
+	RotateHedgehogKey(context.Context, *MsgRotateHedgehogKey) (*MsgRotateHedgehogKeyResponse, error)
+	// RetireHedgehogKey defines a (governance) operation for removing a key
+	// from the hedgehog signer set.
+	RetireHedgehogKey(context.Context, *MsgRetireHedgehogKey) (*MsgRetireHedgehogKeyResponse, error)
+	// CreateVestingSchedule defines a (governance) operation for registering a
+	// vesting schedule without hedgehog.
+	CreateVestingSchedule(context.Context, *MsgCreateVestingSchedule) (*MsgCreateVestingScheduleResponse, error)
+	// ClawbackVesting defines a (governance) operation for ending the vesting
+	// schedule of an account and taking back its unvested coins.
+	ClawbackVesting(context.Context, *MsgClawbackVesting) (*MsgClawbackVestingResponse, error)
+	// AmendPendingVesting defines an operation for the authority or an
+	// amendment operator to change the terms of a pending vesting record.
+	AmendPendingVesting(context.Context, *MsgAmendPendingVesting) (*MsgAmendPendingVestingResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) SubmitVestingBatch(ctx context.Context, req *MsgSubmitVestingBatch) (*MsgSubmitVestingBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitVestingBatch not implemented")
+}
+func (*UnimplementedMsgServer) AddHedgehogKey(ctx context.Context, req *MsgAddHedgehogKey) (*MsgAddHedgehogKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddHedgehogKey not implemented")
+}
+func (*UnimplementedMsgServer) RotateHedgehogKey(ctx context.Context, req *MsgRotateHedgehogKey) (*MsgRotateHedgehogKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateHedgehogKey not implemented")
+}
+func (*UnimplementedMsgServer) RetireHedgehogKey(ctx context.Context, req *MsgRetireHedgehogKey) (*MsgRetireHedgehogKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetireHedgehogKey not implemented")
+}
+func (*UnimplementedMsgServer) CreateVestingSchedule(ctx context.Context, req *MsgCreateVestingSchedule) (*MsgCreateVestingScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVestingSchedule not implemented")
+}
+func (*UnimplementedMsgServer) ClawbackVesting(ctx context.Context, req *MsgClawbackVesting) (*MsgClawbackVestingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClawbackVesting not implemented")
+}
+func (*UnimplementedMsgServer) AmendPendingVesting(ctx context.Context, req *MsgAmendPendingVesting) (*MsgAmendPendingVestingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendPendingVesting not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ugdvesting.ugdvesting.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitVestingBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitVestingBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitVestingBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ugdvesting.ugdvesting.Msg/SubmitVestingBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitVestingBatch(ctx, req.(*MsgSubmitVestingBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddHedgehogKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddHedgehogKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddHedgehogKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ugdvesting.ugdvesting.Msg/AddHedgehogKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddHedgehogKey(ctx, req.(*MsgAddHedgehogKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotateHedgehogKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateHedgehogKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateHedgehogKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ugdvesting.ugdvesting.Msg/RotateHedgehogKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateHedgehogKey(ctx, req.(*MsgRotateHedgehogKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RetireHedgehogKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetireHedgehogKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetireHedgehogKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ugdvesting.ugdvesting.Msg/RetireHedgehogKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetireHedgehogKey(ctx, req.(*MsgRetireHedgehogKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateVestingSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateVestingSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateVestingSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ugdvesting.ugdvesting.Msg/CreateVestingSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateVestingSchedule(ctx, req.(*MsgCreateVestingSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClawbackVesting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClawbackVesting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClawbackVesting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ugdvesting.ugdvesting.Msg/ClawbackVesting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClawbackVesting(ctx, req.(*MsgClawbackVesting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AmendPendingVesting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAmendPendingVesting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AmendPendingVesting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ugdvesting.ugdvesting.Msg/AmendPendingVesting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AmendPendingVesting(ctx, req.(*MsgAmendPendingVesting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ugdvesting.ugdvesting.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "SubmitVestingBatch",
+			Handler:    _Msg_SubmitVestingBatch_Handler,
+		},
+		{
+			MethodName: "AddHedgehogKey",
+			Handler:    _Msg_AddHedgehogKey_Handler,
+		},
+		{
+			MethodName: "RotateHedgehogKey",
+			Handler:    _Msg_RotateHedgehogKey_Handler,
+		},
+		{
+			MethodName: "RetireHedgehogKey",
+			Handler:    _Msg_RetireHedgehogKey_Handler,
+		},
+		{
+			MethodName: "CreateVestingSchedule",
+			Handler:    _Msg_CreateVestingSchedule_Handler,
+		},
+		{
+			MethodName: "ClawbackVesting",
+			Handler:    _Msg_ClawbackVesting_Handler,
+		},
+		{
+			MethodName: "AmendPendingVesting",
+			Handler:    _Msg_AmendPendingVesting_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ugdvesting/ugdvesting/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitVestingBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitVestingBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitVestingBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Snapshot) > 0 {
+		i -= len(m.Snapshot)
+		copy(dAtA[i:], m.Snapshot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Snapshot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitVestingBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitVestingBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitVestingBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rejected) > 0 {
+		i -= len(m.Rejected)
+		copy(dAtA[i:], m.Rejected)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Rejected)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Accepted != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Accepted))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddHedgehogKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddHedgehogKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddHedgehogKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddHedgehogKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddHedgehogKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddHedgehogKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateHedgehogKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateHedgehogKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateHedgehogKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateHedgehogKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateHedgehogKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateHedgehogKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetireHedgehogKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetireHedgehogKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetireHedgehogKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetireHedgehogKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetireHedgehogKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetireHedgehogKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateVestingSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateVestingSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateVestingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateVestingScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateVestingScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateVestingScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Block != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawbackVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawbackVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawbackVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Destination != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawbackVestingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawbackVestingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawbackVestingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegated) > 0 {
+		for iNdEx := len(m.Delegated) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegated[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAmendPendingVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAmendPendingVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAmendPendingVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExpectedHash) > 0 {
+		i -= len(m.ExpectedHash)
+		copy(dAtA[i:], m.ExpectedHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectedHash)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Block != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Cliff != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Cliff))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Percent != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Percent))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Parts != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Parts))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Duration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Start != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAmendPendingVestingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAmendPendingVestingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAmendPendingVestingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Block != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitVestingBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Snapshot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitVestingBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Accepted != 0 {
+		n += 1 + sovTx(uint64(m.Accepted))
+	}
+	l = len(m.Rejected)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddHedgehogKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Key.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddHedgehogKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRotateHedgehogKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Key.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRotateHedgehogKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRetireHedgehogKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRetireHedgehogKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateVestingSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Schedule.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateVestingScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != 0 {
+		n += 1 + sovTx(uint64(m.Block))
+	}
+	return n
+}
+
+func (m *MsgClawbackVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Destination != 0 {
+		n += 1 + sovTx(uint64(m.Destination))
+	}
+	return n
+}
+
+func (m *MsgClawbackVestingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Delegated) > 0 {
+		for _, e := range m.Delegated {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAmendPendingVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Start != 0 {
+		n += 1 + sovTx(uint64(m.Start))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovTx(uint64(m.Duration))
+	}
+	if m.Parts != 0 {
+		n += 1 + sovTx(uint64(m.Parts))
+	}
+	if m.Percent != 0 {
+		n += 1 + sovTx(uint64(m.Percent))
+	}
+	if m.Cliff != 0 {
+		n += 1 + sovTx(uint64(m.Cliff))
+	}
+	if m.Block != 0 {
+		n += 1 + sovTx(uint64(m.Block))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExpectedHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAmendPendingVestingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovTx(uint64(m.Version))
+	}
+	if m.Block != 0 {
+		n += 1 + sovTx(uint64(m.Block))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitVestingBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitVestingBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitVestingBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Snapshot = append(m.Snapshot[:0], dAtA[iNdEx:postIndex]...)
+			if m.Snapshot == nil {
+				m.Snapshot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitVestingBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitVestingBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitVestingBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			m.Accepted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Accepted |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rejected", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rejected = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddHedgehogKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddHedgehogKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddHedgehogKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddHedgehogKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddHedgehogKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddHedgehogKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateHedgehogKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateHedgehogKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateHedgehogKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateHedgehogKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateHedgehogKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateHedgehogKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRetireHedgehogKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetireHedgehogKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetireHedgehogKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRetireHedgehogKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetireHedgehogKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetireHedgehogKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateVestingSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateVestingSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateVestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateVestingScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateVestingScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateVestingScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClawbackVesting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClawbackVesting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClawbackVesting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= ClawbackDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClawbackVestingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClawbackVestingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClawbackVestingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegated = append(m.Delegated, types.Coin{})
+			if err := m.Delegated[len(m.Delegated)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgAmendPendingVesting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -371,15 +3614,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAmendPendingVesting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAmendPendingVesting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -407,13 +3650,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -423,25 +3666,238 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parts", wireType)
+			}
+			m.Parts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Parts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percent", wireType)
+			}
+			m.Percent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Percent |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cliff", wireType)
+			}
+			m.Cliff = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cliff |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedHash = append(m.ExpectedHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExpectedHash == nil {
+				m.ExpectedHash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -463,7 +3919,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAmendPendingVestingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -486,12 +3942,50 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAmendPendingVestingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAmendPendingVestingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"crypto/sha256"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Status returns the processing status of the record.
+func (d VestingData) Status() VestingStatus {
+	switch {
+	case d.FailureReason != "":
+		return VestingStatus_VESTING_STATUS_FAILED
+	case !d.Processed:
+		return VestingStatus_VESTING_STATUS_PENDING
+	case d.ClawedBack:
+		return VestingStatus_VESTING_STATUS_CLAWED_BACK
+	default:
+		return VestingStatus_VESTING_STATUS_CONVERTED
+	}
+}
+
+// Hash returns the SHA-256 hash of the encoded record, which changes with
+// any of its fields.
+func (d VestingData) Hash() []byte {
+	bz, err := d.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	hash := sha256.Sum256(bz)
+	return hash[:]
+}
+
+// Validate performs a stateless sanity check of a vesting record.
+func (d VestingData) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(d.Address); err != nil {
+		return errorsmod.Wrapf(ErrInvalidVestingData, "invalid address %q: %s", d.Address, err)
+	}
+	if d.Amount.IsNil() {
+		return errorsmod.Wrap(ErrInvalidVestingData, "missing amount")
+	}
+	if d.Amount.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidVestingData, "negative amount %s", d.Amount)
+	}
+	if d.Duration <= 0 {
+		return errorsmod.Wrapf(ErrInvalidVestingData, "duration must be positive, got %d", d.Duration)
+	}
+	if d.Parts <= 0 || d.Parts > MaxScheduleParts {
+		return errorsmod.Wrapf(ErrInvalidVestingData, "parts must be between 1 and %d, got %d", MaxScheduleParts, d.Parts)
+	}
+	if d.Percent < 0 || d.Percent > 100 {
+		return errorsmod.Wrapf(ErrInvalidVestingData, "percent must be between 0 and 100, got %d", d.Percent)
+	}
+	if d.Cliff < 0 || d.Cliff > MaxScheduleParts {
+		return errorsmod.Wrapf(ErrInvalidVestingData, "cliff must be between 0 and %d, got %d", MaxScheduleParts, d.Cliff)
+	}
+	if !d.AdditionalAmounts.IsValid() && !d.AdditionalAmounts.Empty() {
+		return errorsmod.Wrapf(ErrInvalidVestingData, "invalid additional amounts %s", d.AdditionalAmounts)
+	}
+	if d.Funder != "" {
+		if _, err := sdk.AccAddressFromBech32(d.Funder); err != nil {
+			return errorsmod.Wrapf(ErrInvalidVestingData, "invalid funder %q: %s", d.Funder, err)
+		}
+	}
+	if d.Processed && d.FailureReason != "" {
+		return errorsmod.Wrap(ErrInvalidVestingData, "a processed record cannot have a failure reason")
+	}
+	if d.ClawedBack && !d.Processed {
+		return errorsmod.Wrap(ErrInvalidVestingData, "only a converted record can be clawed back")
+	}
+	if d.Block <= 0 {
+		return errorsmod.Wrapf(ErrInvalidVestingData, "activation block must be positive, got %d", d.Block)
+	}
+	return nil
+}
+
+// VestedCoins returns the coins the schedule vests, Amount being in denom.
+func (d VestingData) VestedCoins(denom string) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(denom, d.Amount)).Add(d.AdditionalAmounts...)
+}
@@ -4,7 +4,12 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -22,16 +27,87 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// VestingStatus is the processing status of a vesting record.
+type VestingStatus int32
+
+const (
+	VestingStatus_VESTING_STATUS_UNSPECIFIED VestingStatus = 0
+	VestingStatus_VESTING_STATUS_PENDING     VestingStatus = 1
+	VestingStatus_VESTING_STATUS_CONVERTED   VestingStatus = 2
+	VestingStatus_VESTING_STATUS_FAILED      VestingStatus = 3
+	VestingStatus_VESTING_STATUS_CLAWED_BACK VestingStatus = 4
+)
+
+var VestingStatus_name = map[int32]string{
+	0: "VESTING_STATUS_UNSPECIFIED",
+	1: "VESTING_STATUS_PENDING",
+	2: "VESTING_STATUS_CONVERTED",
+	3: "VESTING_STATUS_FAILED",
+	4: "VESTING_STATUS_CLAWED_BACK",
+}
+
+var VestingStatus_value = map[string]int32{
+	"VESTING_STATUS_UNSPECIFIED": 0,
+	"VESTING_STATUS_PENDING":     1,
+	"VESTING_STATUS_CONVERTED":   2,
+	"VESTING_STATUS_FAILED":      3,
+	"VESTING_STATUS_CLAWED_BACK": 4,
+}
+
+func (x VestingStatus) String() string {
+	return proto.EnumName(VestingStatus_name, int32(x))
+}
+
+func (VestingStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f88023dcf62d3348, []int{0}
+}
+
+// ClawbackDestination is where clawed back coins are sent to.
+type ClawbackDestination int32
+
+const (
+	ClawbackDestination_CLAWBACK_DESTINATION_UNSPECIFIED    ClawbackDestination = 0
+	ClawbackDestination_CLAWBACK_DESTINATION_COMMUNITY_POOL ClawbackDestination = 1
+	ClawbackDestination_CLAWBACK_DESTINATION_FUNDER         ClawbackDestination = 2
+)
+
+var ClawbackDestination_name = map[int32]string{
+	0: "CLAWBACK_DESTINATION_UNSPECIFIED",
+	1: "CLAWBACK_DESTINATION_COMMUNITY_POOL",
+	2: "CLAWBACK_DESTINATION_FUNDER",
+}
+
+var ClawbackDestination_value = map[string]int32{
+	"CLAWBACK_DESTINATION_UNSPECIFIED":    0,
+	"CLAWBACK_DESTINATION_COMMUNITY_POOL": 1,
+	"CLAWBACK_DESTINATION_FUNDER":         2,
+}
+
+func (x ClawbackDestination) String() string {
+	return proto.EnumName(ClawbackDestination_name, int32(x))
+}
+
+func (ClawbackDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f88023dcf62d3348, []int{1}
+}
+
 type VestingData struct {
-	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Amount    int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Start     int64  `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
-	Duration  int64  `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
-	Parts     int32  `protobuf:"varint,5,opt,name=parts,proto3" json:"parts,omitempty"`
-	Block     int64  `protobuf:"varint,6,opt,name=block,proto3" json:"block,omitempty"`
-	Percent   int32  `protobuf:"varint,7,opt,name=percent,proto3" json:"percent,omitempty"`
-	Processed bool   `protobuf:"varint,8,opt,name=processed,proto3" json:"processed,omitempty"`
-	Cliff     int32  `protobuf:"varint,9,opt,name=cliff,proto3" json:"cliff,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Amount to vest in base units of the vested denom
+	Amount        cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Start         int64                 `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	Duration      int64                 `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	Parts         int32                 `protobuf:"varint,5,opt,name=parts,proto3" json:"parts,omitempty"`
+	Block         int64                 `protobuf:"varint,6,opt,name=block,proto3" json:"block,omitempty"`
+	Percent       int32                 `protobuf:"varint,7,opt,name=percent,proto3" json:"percent,omitempty"`
+	Processed     bool                  `protobuf:"varint,8,opt,name=processed,proto3" json:"processed,omitempty"`
+	Cliff         int32                 `protobuf:"varint,9,opt,name=cliff,proto3" json:"cliff,omitempty"`
+	FailureReason string                `protobuf:"bytes,10,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	// Amounts of Params.additional_denoms vested by the same schedule as amount
+	AdditionalAmounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=additional_amounts,json=additionalAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"additional_amounts"`
+	Funder            string                                   `protobuf:"bytes,12,opt,name=funder,proto3" json:"funder,omitempty"`
+	ClawedBack        bool                                     `protobuf:"varint,13,opt,name=clawed_back,json=clawedBack,proto3" json:"clawed_back,omitempty"`
+	Pinned            bool                                     `protobuf:"varint,14,opt,name=pinned,proto3" json:"pinned,omitempty"`
 }
 
 func (m *VestingData) Reset()         { *m = VestingData{} }
@@ -74,13 +150,6 @@ func (m *VestingData) GetAddress() string {
 	return ""
 }
 
-func (m *VestingData) GetAmount() int64 {
-	if m != nil {
-		return m.Amount
-	}
-	return 0
-}
-
 func (m *VestingData) GetStart() int64 {
 	if m != nil {
 		return m.Start
@@ -130,8 +199,320 @@ func (m *VestingData) GetCliff() int32 {
 	return 0
 }
 
+func (m *VestingData) GetFailureReason() string {
+	if m != nil {
+		return m.FailureReason
+	}
+	return ""
+}
+
+func (m *VestingData) GetAdditionalAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AdditionalAmounts
+	}
+	return nil
+}
+
+func (m *VestingData) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
+}
+
+func (m *VestingData) GetClawedBack() bool {
+	if m != nil {
+		return m.ClawedBack
+	}
+	return false
+}
+
+func (m *VestingData) GetPinned() bool {
+	if m != nil {
+		return m.Pinned
+	}
+	return false
+}
+
+// VestingRecord is a stored vesting record together with its status.
+type VestingRecord struct {
+	Data   VestingData   `protobuf:"bytes,1,opt,name=data,proto3" json:"data"`
+	Status VestingStatus `protobuf:"varint,2,opt,name=status,proto3,enum=ugdvesting.ugdvesting.VestingStatus" json:"status,omitempty"`
+	// display_amount are the coins the record vests in display units
+	DisplayAmount []DisplayCoin `protobuf:"bytes,3,rep,name=display_amount,json=displayAmount,proto3" json:"display_amount"`
+}
+
+func (m *VestingRecord) Reset()         { *m = VestingRecord{} }
+func (m *VestingRecord) String() string { return proto.CompactTextString(m) }
+func (*VestingRecord) ProtoMessage()    {}
+func (*VestingRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f88023dcf62d3348, []int{1}
+}
+func (m *VestingRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingRecord.Merge(m, src)
+}
+func (m *VestingRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingRecord proto.InternalMessageInfo
+
+func (m *VestingRecord) GetData() VestingData {
+	if m != nil {
+		return m.Data
+	}
+	return VestingData{}
+}
+
+func (m *VestingRecord) GetStatus() VestingStatus {
+	if m != nil {
+		return m.Status
+	}
+	return VestingStatus_VESTING_STATUS_UNSPECIFIED
+}
+
+func (m *VestingRecord) GetDisplayAmount() []DisplayCoin {
+	if m != nil {
+		return m.DisplayAmount
+	}
+	return nil
+}
+
+// DisplayCoin is an amount in the display unit of a denom, as declared by its
+// bank metadata. Denoms without metadata are displayed in their base unit.
+type DisplayCoin struct {
+	Denom  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *DisplayCoin) Reset()         { *m = DisplayCoin{} }
+func (m *DisplayCoin) String() string { return proto.CompactTextString(m) }
+func (*DisplayCoin) ProtoMessage()    {}
+func (*DisplayCoin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f88023dcf62d3348, []int{2}
+}
+func (m *DisplayCoin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DisplayCoin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DisplayCoin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DisplayCoin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisplayCoin.Merge(m, src)
+}
+func (m *DisplayCoin) XXX_Size() int {
+	return m.Size()
+}
+func (m *DisplayCoin) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisplayCoin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisplayCoin proto.InternalMessageInfo
+
+func (m *DisplayCoin) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DisplayCoin) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+// VestingAmendment is an entry of the amendment history of a pending record.
+type VestingAmendment struct {
+	Address  string      `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Version  uint64      `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Signer   string      `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+	Height   int64       `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Previous VestingData `protobuf:"bytes,5,opt,name=previous,proto3" json:"previous"`
+	Amended  VestingData `protobuf:"bytes,6,opt,name=amended,proto3" json:"amended"`
+	Reason   string      `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *VestingAmendment) Reset()         { *m = VestingAmendment{} }
+func (m *VestingAmendment) String() string { return proto.CompactTextString(m) }
+func (*VestingAmendment) ProtoMessage()    {}
+func (*VestingAmendment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f88023dcf62d3348, []int{3}
+}
+func (m *VestingAmendment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingAmendment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingAmendment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingAmendment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingAmendment.Merge(m, src)
+}
+func (m *VestingAmendment) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingAmendment) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingAmendment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingAmendment proto.InternalMessageInfo
+
+func (m *VestingAmendment) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *VestingAmendment) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *VestingAmendment) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *VestingAmendment) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *VestingAmendment) GetPrevious() VestingData {
+	if m != nil {
+		return m.Previous
+	}
+	return VestingData{}
+}
+
+func (m *VestingAmendment) GetAmended() VestingData {
+	if m != nil {
+		return m.Amended
+	}
+	return VestingData{}
+}
+
+func (m *VestingAmendment) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// SnapshotState records the last hedgehog vesting snapshot the module
+// accepted, later snapshots must chain to it.
+type SnapshotState struct {
+	Timestamp string `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Hash      []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Height    int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *SnapshotState) Reset()         { *m = SnapshotState{} }
+func (m *SnapshotState) String() string { return proto.CompactTextString(m) }
+func (*SnapshotState) ProtoMessage()    {}
+func (*SnapshotState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f88023dcf62d3348, []int{4}
+}
+func (m *SnapshotState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotState.Merge(m, src)
+}
+func (m *SnapshotState) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotState) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotState proto.InternalMessageInfo
+
+func (m *SnapshotState) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
+}
+
+func (m *SnapshotState) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *SnapshotState) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("ugdvesting.ugdvesting.VestingStatus", VestingStatus_name, VestingStatus_value)
+	proto.RegisterEnum("ugdvesting.ugdvesting.ClawbackDestination", ClawbackDestination_name, ClawbackDestination_value)
 	proto.RegisterType((*VestingData)(nil), "ugdvesting.ugdvesting.VestingData")
+	proto.RegisterType((*VestingRecord)(nil), "ugdvesting.ugdvesting.VestingRecord")
+	proto.RegisterType((*DisplayCoin)(nil), "ugdvesting.ugdvesting.DisplayCoin")
+	proto.RegisterType((*VestingAmendment)(nil), "ugdvesting.ugdvesting.VestingAmendment")
+	proto.RegisterType((*SnapshotState)(nil), "ugdvesting.ugdvesting.SnapshotState")
 }
 
 func init() {
@@ -139,26 +520,64 @@ func init() {
 }
 
 var fileDescriptor_f88023dcf62d3348 = []byte{
-	// 294 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xbf, 0x4e, 0xf3, 0x30,
-	0x14, 0xc5, 0xeb, 0xaf, 0x5f, 0xff, 0x99, 0xcd, 0x02, 0x64, 0x21, 0x64, 0x45, 0xb0, 0x64, 0x49,
-	0x3b, 0xf0, 0x06, 0x88, 0x17, 0x20, 0x03, 0x03, 0x9b, 0x6b, 0xbb, 0xae, 0x69, 0x13, 0x5b, 0xf6,
-	0x0d, 0x82, 0xb7, 0xe0, 0xb1, 0x18, 0x3b, 0x32, 0xa2, 0x64, 0xe4, 0x25, 0x50, 0x9c, 0x86, 0x74,
-	0xf2, 0xf9, 0x9d, 0x63, 0x5f, 0x5f, 0x1d, 0x7c, 0x5b, 0x69, 0xf9, 0xaa, 0x02, 0x98, 0x52, 0xaf,
-	0x4e, 0xe4, 0xf1, 0x5c, 0x3a, 0x6f, 0xc1, 0x92, 0x8b, 0x21, 0x59, 0x0e, 0xf2, 0xe6, 0x07, 0xe1,
-	0xb3, 0xa7, 0x4e, 0x3f, 0x70, 0xe0, 0x84, 0xe2, 0x19, 0x97, 0xd2, 0xab, 0x10, 0x28, 0x4a, 0x50,
-	0xba, 0xc8, 0x7b, 0x24, 0x97, 0x78, 0xca, 0x0b, 0x5b, 0x95, 0x40, 0xff, 0x25, 0x28, 0x1d, 0xe7,
-	0x47, 0x22, 0xe7, 0x78, 0x12, 0x80, 0x7b, 0xa0, 0xe3, 0x68, 0x77, 0x40, 0xae, 0xf0, 0x5c, 0x56,
-	0x9e, 0x83, 0xb1, 0x25, 0xfd, 0x1f, 0x83, 0x3f, 0x6e, 0x5f, 0x38, 0xee, 0x21, 0xd0, 0x49, 0x82,
-	0xd2, 0x49, 0xde, 0x41, 0xeb, 0xae, 0xf7, 0x56, 0xec, 0xe8, 0xb4, 0x9b, 0x13, 0xa1, 0xdd, 0xc7,
-	0x29, 0x2f, 0x54, 0x09, 0x74, 0x16, 0x6f, 0xf7, 0x48, 0xae, 0xf1, 0xc2, 0x79, 0x2b, 0x54, 0x08,
-	0x4a, 0xd2, 0x79, 0x82, 0xd2, 0x79, 0x3e, 0x18, 0xed, 0x34, 0xb1, 0x37, 0x9b, 0x0d, 0x5d, 0x74,
-	0x7f, 0x44, 0xb8, 0xdf, 0x7d, 0xd6, 0x0c, 0x1d, 0x6a, 0x86, 0xbe, 0x6b, 0x86, 0x3e, 0x1a, 0x36,
-	0x3a, 0x34, 0x6c, 0xf4, 0xd5, 0xb0, 0xd1, 0xf3, 0xa3, 0x36, 0xb0, 0xad, 0xd6, 0x4b, 0x61, 0x8b,
-	0x55, 0x55, 0x1a, 0xed, 0x8d, 0xcc, 0x9c, 0xb7, 0x2f, 0x4a, 0xc0, 0x4a, 0xd8, 0x50, 0xd8, 0x90,
-	0x05, 0xb9, 0xcb, 0xfa, 0x68, 0xab, 0xa4, 0x56, 0x5b, 0xab, 0xb3, 0xbe, 0xec, 0xb7, 0xd3, 0xe6,
-	0xe1, 0xdd, 0xa9, 0xb0, 0x9e, 0xc6, 0xe2, 0xef, 0x7e, 0x03, 0x00, 0x00, 0xff, 0xff, 0x6d, 0xc2,
-	0x27, 0x10, 0x9f, 0x01, 0x00, 0x00,
+	// 904 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4f, 0x6f, 0x1a, 0x47,
+	0x14, 0x67, 0x0d, 0x06, 0x7b, 0x08, 0x16, 0x9d, 0xc6, 0xd1, 0x98, 0x46, 0x80, 0x48, 0xaa, 0xa2,
+	0x54, 0x5e, 0x1a, 0xf7, 0xd8, 0x5c, 0x80, 0xc5, 0x11, 0xaa, 0x0d, 0xd6, 0x82, 0x5d, 0xa5, 0x17,
+	0x34, 0xec, 0x8c, 0x77, 0xb7, 0xb0, 0x3b, 0xab, 0x9d, 0x59, 0xa7, 0xe9, 0xbd, 0xb7, 0x1e, 0x7a,
+	0xec, 0x07, 0xe8, 0xa9, 0xe7, 0x7e, 0x88, 0x1c, 0xa3, 0x9e, 0xaa, 0xaa, 0x4a, 0x2b, 0xfb, 0x8b,
+	0x54, 0xf3, 0x87, 0x80, 0x2c, 0x37, 0x52, 0x4e, 0x3b, 0xbf, 0xf7, 0x7f, 0xde, 0xfb, 0xbd, 0x59,
+	0xf0, 0x28, 0xf3, 0xc9, 0x15, 0xe5, 0x22, 0x8c, 0xfd, 0xce, 0xc6, 0xd1, 0x7c, 0xed, 0x24, 0x65,
+	0x82, 0xc1, 0xfd, 0xb5, 0xc6, 0x5e, 0x1f, 0x6b, 0x75, 0x8f, 0xf1, 0x88, 0xf1, 0xce, 0x1c, 0x73,
+	0xda, 0xb9, 0x7a, 0x3a, 0xa7, 0x02, 0x3f, 0xed, 0x78, 0x2c, 0x8c, 0xb5, 0x5b, 0xed, 0x40, 0xeb,
+	0x67, 0x0a, 0x75, 0x34, 0x30, 0xaa, 0xfb, 0x3e, 0xf3, 0x99, 0x96, 0xcb, 0x93, 0x96, 0xb6, 0x7e,
+	0x2a, 0x80, 0xf2, 0x85, 0x0e, 0xee, 0x60, 0x81, 0x21, 0x02, 0x25, 0x4c, 0x48, 0x4a, 0x39, 0x47,
+	0x56, 0xd3, 0x6a, 0xef, 0xba, 0x2b, 0x08, 0xfb, 0xa0, 0x88, 0x23, 0x96, 0xc5, 0x02, 0x6d, 0x49,
+	0x45, 0xef, 0xf3, 0xd7, 0x6f, 0x1b, 0xb9, 0xbf, 0xde, 0x36, 0xf6, 0x75, 0x16, 0x4e, 0x16, 0x76,
+	0xc8, 0x3a, 0x11, 0x16, 0x81, 0x3d, 0x8c, 0xc5, 0x1f, 0xbf, 0x1f, 0x02, 0x93, 0x7e, 0x18, 0x0b,
+	0xd7, 0xb8, 0xc2, 0xfb, 0x60, 0x9b, 0x0b, 0x9c, 0x0a, 0x94, 0x6f, 0x5a, 0xed, 0xbc, 0xab, 0x01,
+	0xac, 0x81, 0x1d, 0x92, 0xa5, 0x58, 0x84, 0x2c, 0x46, 0x05, 0xa5, 0x78, 0x87, 0xa5, 0x47, 0x82,
+	0x53, 0xc1, 0xd1, 0x76, 0xd3, 0x6a, 0x6f, 0xbb, 0x1a, 0x48, 0xe9, 0x7c, 0xc9, 0xbc, 0x05, 0x2a,
+	0xea, 0x38, 0x0a, 0xc8, 0xe2, 0x13, 0x9a, 0x7a, 0x34, 0x16, 0xa8, 0xa4, 0xac, 0x57, 0x10, 0x3e,
+	0x04, 0xbb, 0x49, 0xca, 0x3c, 0xca, 0x39, 0x25, 0x68, 0xa7, 0x69, 0xb5, 0x77, 0xdc, 0xb5, 0x40,
+	0x46, 0xf3, 0x96, 0xe1, 0xe5, 0x25, 0xda, 0xd5, 0x39, 0x14, 0x80, 0x9f, 0x82, 0xbd, 0x4b, 0x1c,
+	0x2e, 0xb3, 0x94, 0xce, 0x52, 0x8a, 0x39, 0x8b, 0x11, 0x50, 0x1d, 0xa9, 0x18, 0xa9, 0xab, 0x84,
+	0xf0, 0x07, 0x00, 0x31, 0x21, 0xa1, 0x2c, 0x16, 0x2f, 0x67, 0xfa, 0x9e, 0x1c, 0x95, 0x9b, 0xf9,
+	0x76, 0xf9, 0xe8, 0xc0, 0x36, 0x3d, 0x90, 0xf3, 0xb2, 0xcd, 0xbc, 0xec, 0x3e, 0x0b, 0xe3, 0xde,
+	0x17, 0xb2, 0x7d, 0xbf, 0xfd, 0xd3, 0x68, 0xfb, 0xa1, 0x08, 0xb2, 0xb9, 0xed, 0xb1, 0xc8, 0xcc,
+	0xcb, 0x7c, 0x0e, 0x39, 0x59, 0x74, 0xc4, 0xab, 0x84, 0x72, 0xe5, 0xc0, 0xdd, 0x8f, 0xd6, 0x69,
+	0xba, 0x3a, 0x0b, 0x7c, 0x00, 0x8a, 0x97, 0x59, 0x4c, 0x68, 0x8a, 0xee, 0xa9, 0xd2, 0x0c, 0x82,
+	0x0d, 0x50, 0xf6, 0x96, 0xf8, 0x25, 0x25, 0xb3, 0x39, 0xf6, 0x16, 0xa8, 0xa2, 0x2e, 0x0c, 0xb4,
+	0xa8, 0x87, 0xbd, 0x85, 0x74, 0x4c, 0xc2, 0x38, 0xa6, 0x04, 0xed, 0x29, 0x9d, 0x41, 0xad, 0xbf,
+	0x2d, 0x50, 0x31, 0x74, 0x70, 0xa9, 0xc7, 0x52, 0x02, 0x9f, 0x81, 0x02, 0xc1, 0x02, 0x2b, 0x36,
+	0x94, 0x8f, 0x5a, 0xf6, 0x9d, 0xbc, 0xb4, 0x37, 0x28, 0xd4, 0x2b, 0xc8, 0x9b, 0xb9, 0xca, 0x0b,
+	0x3e, 0x03, 0x45, 0x2e, 0xb0, 0xc8, 0xb8, 0x22, 0xcd, 0xde, 0xd1, 0xe3, 0xf7, 0xfb, 0x4f, 0x94,
+	0xad, 0x6b, 0x7c, 0xe0, 0x18, 0xec, 0x91, 0x90, 0x27, 0x4b, 0xfc, 0xca, 0xf4, 0x15, 0xe5, 0x9b,
+	0xf9, 0xf7, 0x54, 0xe1, 0x68, 0x63, 0xd5, 0x5f, 0x5d, 0x45, 0xc5, 0xf8, 0xeb, 0x86, 0xb5, 0xbe,
+	0x02, 0xe5, 0x0d, 0x1b, 0x39, 0x77, 0x42, 0x63, 0x16, 0x19, 0xaa, 0x6b, 0x20, 0x7b, 0xb3, 0x49,
+	0xf4, 0x15, 0x77, 0x5b, 0xbf, 0x6c, 0x81, 0xaa, 0xa9, 0xb3, 0x1b, 0xd1, 0x98, 0x44, 0x92, 0x58,
+	0xff, 0xbf, 0x2f, 0x08, 0x94, 0xae, 0x68, 0xca, 0x25, 0xa7, 0x65, 0x9c, 0x82, 0xbb, 0x82, 0x32,
+	0x01, 0x0f, 0xfd, 0x98, 0xa6, 0x6a, 0x0b, 0x76, 0x5d, 0x83, 0xa4, 0x3c, 0xa0, 0xa1, 0x1f, 0x08,
+	0xb3, 0x04, 0x06, 0x41, 0x07, 0xec, 0x24, 0x29, 0xbd, 0x0a, 0x59, 0xa6, 0xb7, 0xe0, 0x43, 0xc6,
+	0xf0, 0xce, 0x13, 0xf6, 0x40, 0x09, 0xcb, 0xb2, 0x29, 0x41, 0xc5, 0x0f, 0x0c, 0xb2, 0x72, 0x94,
+	0x15, 0x9a, 0x55, 0x28, 0xe9, 0xca, 0x35, 0x6a, 0xbd, 0x00, 0x95, 0x49, 0x8c, 0x13, 0x1e, 0x30,
+	0x21, 0x47, 0x48, 0xe5, 0xbe, 0x89, 0x30, 0xa2, 0x5c, 0xe0, 0x28, 0x31, 0x8d, 0x59, 0x0b, 0x20,
+	0x04, 0x85, 0x00, 0xf3, 0x40, 0xf5, 0xe5, 0x9e, 0xab, 0xce, 0x1b, 0x97, 0xcf, 0x6f, 0x5e, 0xfe,
+	0xc9, 0xaf, 0x6b, 0x46, 0x6a, 0x76, 0xc0, 0x3a, 0xa8, 0x5d, 0x0c, 0x26, 0xd3, 0xe1, 0xe8, 0xf9,
+	0x6c, 0x32, 0xed, 0x4e, 0xcf, 0x27, 0xb3, 0xf3, 0xd1, 0xe4, 0x6c, 0xd0, 0x1f, 0x1e, 0x0f, 0x07,
+	0x4e, 0x35, 0x07, 0x6b, 0xe0, 0xc1, 0x2d, 0xfd, 0xd9, 0x60, 0xe4, 0x0c, 0x47, 0xcf, 0xab, 0x16,
+	0x7c, 0x08, 0xd0, 0x2d, 0x5d, 0x7f, 0x3c, 0xba, 0x18, 0xb8, 0xd3, 0x81, 0x53, 0xdd, 0x82, 0x07,
+	0x60, 0xff, 0x96, 0xf6, 0xb8, 0x3b, 0x3c, 0x19, 0x38, 0xd5, 0xfc, 0x1d, 0x49, 0xfb, 0x27, 0xdd,
+	0x6f, 0x06, 0xce, 0xac, 0xd7, 0xed, 0x7f, 0x5d, 0x2d, 0x3c, 0xf9, 0xd1, 0x02, 0x1f, 0xf7, 0x97,
+	0xf8, 0xa5, 0xdc, 0x37, 0x47, 0x95, 0xab, 0x9f, 0xaf, 0xc7, 0xa0, 0x29, 0x0d, 0xa5, 0xd5, 0xcc,
+	0x51, 0x01, 0xba, 0xd3, 0xe1, 0x78, 0x74, 0xab, 0xe4, 0xcf, 0xc0, 0xa3, 0x3b, 0xad, 0xfa, 0xe3,
+	0xd3, 0xd3, 0xf3, 0xd1, 0x70, 0xfa, 0x62, 0x76, 0x36, 0x1e, 0x9f, 0x54, 0x2d, 0xd8, 0x00, 0x9f,
+	0xdc, 0x69, 0x78, 0x7c, 0x3e, 0x72, 0x06, 0x6e, 0x75, 0xab, 0xe7, 0xbf, 0xbe, 0xae, 0x5b, 0x6f,
+	0xae, 0xeb, 0xd6, 0xbf, 0xd7, 0x75, 0xeb, 0xe7, 0x9b, 0x7a, 0xee, 0xcd, 0x4d, 0x3d, 0xf7, 0xe7,
+	0x4d, 0x3d, 0xf7, 0xed, 0xe9, 0xc6, 0x43, 0x93, 0xc5, 0xa1, 0x9f, 0x86, 0xe4, 0x30, 0x49, 0xd9,
+	0x77, 0xd4, 0x13, 0xab, 0x17, 0x67, 0x25, 0x0e, 0x28, 0xf1, 0x69, 0xc0, 0xfc, 0xc3, 0xd5, 0xbf,
+	0xe9, 0xfb, 0xcd, 0x1f, 0x95, 0x7a, 0x93, 0xe6, 0x45, 0xf5, 0xff, 0xf8, 0xf2, 0xbf, 0x01, 0x00,
+	0xa2, 0xb0, 0xeb, 0x2a, 0xce, 0x06, 0x00, 0x00,
 }
 
 func (m *VestingData) Marshal() (dAtA []byte, err error) {
@@ -181,6 +600,54 @@ func (m *VestingData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Pinned {
+		i--
+		if m.Pinned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.ClawedBack {
+		i--
+		if m.ClawedBack {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.AdditionalAmounts) > 0 {
+		for iNdEx := len(m.AdditionalAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.FailureReason) > 0 {
+		i -= len(m.FailureReason)
+		copy(dAtA[i:], m.FailureReason)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.FailureReason)))
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.Cliff != 0 {
 		i = encodeVarintVesting(dAtA, i, uint64(m.Cliff))
 		i--
@@ -221,11 +688,16 @@ func (m *VestingData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.Amount != 0 {
-		i = encodeVarintVesting(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x10
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVesting(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -233,64 +705,1014 @@ func (m *VestingData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DisplayAmount) > 0 {
+		for iNdEx := len(m.DisplayAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DisplayAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVesting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DisplayCoin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DisplayCoin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DisplayCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingAmendment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingAmendment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingAmendment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.Amended.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVesting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Previous.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVesting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Version != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Timestamp) > 0 {
+		i -= len(m.Timestamp)
+		copy(dAtA[i:], m.Timestamp)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Timestamp)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVesting(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVesting(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *VestingData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovVesting(uint64(l))
+	if m.Start != 0 {
+		n += 1 + sovVesting(uint64(m.Start))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovVesting(uint64(m.Duration))
+	}
+	if m.Parts != 0 {
+		n += 1 + sovVesting(uint64(m.Parts))
+	}
+	if m.Block != 0 {
+		n += 1 + sovVesting(uint64(m.Block))
+	}
+	if m.Percent != 0 {
+		n += 1 + sovVesting(uint64(m.Percent))
+	}
+	if m.Processed {
+		n += 2
+	}
+	if m.Cliff != 0 {
+		n += 1 + sovVesting(uint64(m.Cliff))
+	}
+	l = len(m.FailureReason)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if len(m.AdditionalAmounts) > 0 {
+		for _, e := range m.AdditionalAmounts {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if m.ClawedBack {
+		n += 2
+	}
+	if m.Pinned {
+		n += 2
+	}
+	return n
+}
+
+func (m *VestingRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Data.Size()
+	n += 1 + l + sovVesting(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovVesting(uint64(m.Status))
+	}
+	if len(m.DisplayAmount) > 0 {
+		for _, e := range m.DisplayAmount {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DisplayCoin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	return n
+}
+
+func (m *VestingAmendment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovVesting(uint64(m.Version))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovVesting(uint64(m.Height))
+	}
+	l = m.Previous.Size()
+	n += 1 + l + sovVesting(uint64(l))
+	l = m.Amended.Size()
+	n += 1 + l + sovVesting(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	return n
+}
+
+func (m *SnapshotState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Timestamp)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovVesting(uint64(m.Height))
+	}
+	return n
+}
+
+func sovVesting(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVesting(x uint64) (n int) {
+	return sovVesting(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *VestingData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parts", wireType)
+			}
+			m.Parts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Parts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percent", wireType)
+			}
+			m.Percent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Percent |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Processed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Processed = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cliff", wireType)
+			}
+			m.Cliff = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cliff |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalAmounts = append(m.AdditionalAmounts, types.Coin{})
+			if err := m.AdditionalAmounts[len(m.AdditionalAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawedBack", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClawedBack = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pinned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pinned = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VestingRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= VestingStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayAmount = append(m.DisplayAmount, DisplayCoin{})
+			if err := m.DisplayAmount[len(m.DisplayAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DisplayCoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DisplayCoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DisplayCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func encodeVarintVesting(dAtA []byte, offset int, v uint64) int {
-	offset -= sovVesting(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *VestingData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovVesting(uint64(l))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovVesting(uint64(m.Amount))
-	}
-	if m.Start != 0 {
-		n += 1 + sovVesting(uint64(m.Start))
-	}
-	if m.Duration != 0 {
-		n += 1 + sovVesting(uint64(m.Duration))
-	}
-	if m.Parts != 0 {
-		n += 1 + sovVesting(uint64(m.Parts))
-	}
-	if m.Block != 0 {
-		n += 1 + sovVesting(uint64(m.Block))
-	}
-	if m.Percent != 0 {
-		n += 1 + sovVesting(uint64(m.Percent))
-	}
-	if m.Processed {
-		n += 2
-	}
-	if m.Cliff != 0 {
-		n += 1 + sovVesting(uint64(m.Cliff))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovVesting(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozVesting(x uint64) (n int) {
-	return sovVesting(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *VestingData) Unmarshal(dAtA []byte) error {
+func (m *VestingAmendment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -313,10 +1735,10 @@ func (m *VestingData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VestingData: wiretype end group for non-group")
+			return fmt.Errorf("proto: VestingAmendment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VestingData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VestingAmendment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -353,9 +1775,9 @@ func (m *VestingData) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.Amount = 0
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVesting
@@ -365,16 +1787,16 @@ func (m *VestingData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= int64(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			m.Start = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVesting
@@ -384,16 +1806,29 @@ func (m *VestingData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Start |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.Duration = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVesting
@@ -403,16 +1838,16 @@ func (m *VestingData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Duration |= int64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parts", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Previous", wireType)
 			}
-			m.Parts = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVesting
@@ -422,16 +1857,30 @@ func (m *VestingData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Parts |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Previous.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amended", wireType)
 			}
-			m.Block = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVesting
@@ -441,16 +1890,30 @@ func (m *VestingData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Block |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amended.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Percent", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.Percent = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVesting
@@ -460,16 +1923,79 @@ func (m *VestingData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Percent |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Processed", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVesting
@@ -479,17 +2005,63 @@ func (m *VestingData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Processed = bool(v != 0)
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timestamp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cliff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.Cliff = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVesting
@@ -499,7 +2071,7 @@ func (m *VestingData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Cliff |= int32(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
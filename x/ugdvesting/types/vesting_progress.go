@@ -0,0 +1,104 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// VestingProgress is the state of a vesting schedule at a given time.
+type VestingProgress struct {
+	Vested   sdk.Coins
+	Unvested sdk.Coins
+	// NextUnlockTime is the time the next coins vest at, zero once
+	// everything vested. NextUnlockAmount are the coins vesting then.
+	NextUnlockTime   time.Time
+	NextUnlockAmount sdk.Coins
+}
+
+// PeriodsProgress returns the progress of the periods starting at the Unix
+// time start at the time at. Periods vest at their end, like the periods of
+// a PeriodicVestingAccount.
+func PeriodsProgress(start int64, periods vestingtypes.Periods, at time.Time) (VestingProgress, error) {
+	progress := VestingProgress{Vested: sdk.NewCoins(), Unvested: sdk.NewCoins()}
+
+	end := start
+	for _, period := range periods {
+		if period.Length <= 0 {
+			return VestingProgress{}, errorsmod.Wrapf(ErrInvalidSchedule, "period length must be positive, got %d", period.Length)
+		}
+		if !period.Amount.IsValid() {
+			return VestingProgress{}, errorsmod.Wrapf(ErrInvalidSchedule, "invalid period amount %s", period.Amount)
+		}
+		end += period.Length
+
+		if at.Unix() >= end {
+			progress.Vested = progress.Vested.Add(period.Amount...)
+			continue
+		}
+		if progress.NextUnlockTime.IsZero() {
+			progress.NextUnlockTime = time.Unix(end, 0).UTC()
+			progress.NextUnlockAmount = period.Amount
+		}
+		progress.Unvested = progress.Unvested.Add(period.Amount...)
+	}
+
+	return progress, nil
+}
+
+// UnvestedCoins returns the coins of vested that are still locked at the time
+// at under the schedule of the record, the schedule an account converted
+// from the record follows.
+func (d VestingData) UnvestedCoins(vested sdk.Coins, at time.Time) (sdk.Coins, error) {
+	periods, start, err := BuildPeriods(d, vested, time.Unix(d.Start, 0))
+	if err != nil {
+		return nil, err
+	}
+	progress, err := PeriodsProgress(start.Unix(), periods, at)
+	if err != nil {
+		return nil, err
+	}
+	return progress.Unvested, nil
+}
+
+// AccountVestingProgress returns the progress of the schedule of a vesting
+// account at the time at. Periodic, delayed and continuous vesting accounts
+// are supported. Continuous accounts vest linearly, their next unlock is the
+// end of vesting with everything still unvested. Accounts that do not vest
+// have nothing vested or unvested.
+func AccountVestingProgress(account sdk.AccountI, at time.Time) (VestingProgress, error) {
+	switch acc := account.(type) {
+	case *vestingtypes.PeriodicVestingAccount:
+		if err := acc.Validate(); err != nil {
+			return VestingProgress{}, errorsmod.Wrap(ErrInvalidSchedule, err.Error())
+		}
+		return PeriodsProgress(acc.StartTime, acc.VestingPeriods, at)
+
+	case *vestingtypes.DelayedVestingAccount, *vestingtypes.ContinuousVestingAccount:
+		vestingAcc := account.(interface {
+			vestingexported.VestingAccount
+			Validate() error
+		})
+		if err := vestingAcc.Validate(); err != nil {
+			return VestingProgress{}, errorsmod.Wrap(ErrInvalidSchedule, err.Error())
+		}
+		progress := VestingProgress{
+			Vested:   sdk.NewCoins(vestingAcc.GetVestedCoins(at)...),
+			Unvested: sdk.NewCoins(vestingAcc.GetVestingCoins(at)...),
+		}
+		if !progress.Unvested.IsZero() {
+			progress.NextUnlockTime = time.Unix(vestingAcc.GetEndTime(), 0).UTC()
+			progress.NextUnlockAmount = progress.Unvested
+		}
+		return progress, nil
+
+	case vestingexported.VestingAccount:
+		return VestingProgress{}, errorsmod.Wrapf(ErrInvalidSchedule, "unsupported vesting account type %T", account)
+
+	default:
+		return VestingProgress{Vested: sdk.NewCoins(), Unvested: sdk.NewCoins()}, nil
+	}
+}
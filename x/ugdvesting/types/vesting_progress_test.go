@@ -0,0 +1,193 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/testutil/sample"
+	"github.com/unigrid-project/cosmos-unigrid-hedgehog-vesting/x/ugdvesting/types"
+)
+
+func ugd(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin("uugd", amount))
+}
+
+func TestPeriodsProgress(t *testing.T) {
+	periods := vestingtypes.Periods{
+		{Length: 10, Amount: ugd(100)},
+		{Length: 20, Amount: ugd(200)},
+	}
+
+	tests := []struct {
+		name     string
+		at       int64
+		vested   sdk.Coins
+		unvested sdk.Coins
+		next     int64
+		amount   sdk.Coins
+	}{
+		{name: "before start", at: 900, vested: sdk.NewCoins(), unvested: ugd(300), next: 1010, amount: ugd(100)},
+		{name: "first period ends", at: 1010, vested: ugd(100), unvested: ugd(200), next: 1030, amount: ugd(200)},
+		{name: "within second period", at: 1029, vested: ugd(100), unvested: ugd(200), next: 1030, amount: ugd(200)},
+		{name: "done", at: 1030, vested: ugd(300), unvested: sdk.NewCoins()},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			progress, err := types.PeriodsProgress(1000, periods, time.Unix(tc.at, 0))
+			require.NoError(t, err)
+			require.Equal(t, tc.vested, progress.Vested)
+			require.Equal(t, tc.unvested, progress.Unvested)
+			if tc.next == 0 {
+				require.True(t, progress.NextUnlockTime.IsZero())
+				require.Empty(t, progress.NextUnlockAmount)
+				return
+			}
+			require.Equal(t, tc.next, progress.NextUnlockTime.Unix())
+			require.Equal(t, tc.amount, progress.NextUnlockAmount)
+		})
+	}
+
+	_, err := types.PeriodsProgress(1000, vestingtypes.Periods{{Length: 0, Amount: ugd(1)}}, time.Unix(1000, 0))
+	require.ErrorIs(t, err, types.ErrInvalidSchedule)
+}
+
+func TestVestingDataUnvestedCoins(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		data     types.VestingData
+		at       time.Time
+		unvested sdk.Coins
+	}{
+		{
+			name:     "not started",
+			data:     types.VestingData{Duration: 60, Parts: 10},
+			at:       start.Add(-10 * time.Minute),
+			unvested: ugd(1000),
+		},
+		{
+			// without TGE the first part is released two periods after start
+			name:     "three parts released",
+			data:     types.VestingData{Duration: 60, Parts: 10},
+			at:       start.Add(4 * time.Minute),
+			unvested: ugd(700),
+		},
+		{
+			name:     "done",
+			data:     types.VestingData{Duration: 60, Parts: 10},
+			at:       start.Add(11 * time.Minute),
+			unvested: sdk.NewCoins(),
+		},
+		{
+			name:     "before TGE",
+			data:     types.VestingData{Duration: 60, Parts: 9, Percent: 10},
+			at:       start.Add(59 * time.Second),
+			unvested: ugd(1000),
+		},
+		{
+			name:     "TGE released",
+			data:     types.VestingData{Duration: 60, Parts: 9, Percent: 10},
+			at:       start.Add(time.Minute),
+			unvested: ugd(900),
+		},
+		{
+			name:     "within cliff",
+			data:     types.VestingData{Duration: 60, Parts: 9, Percent: 10, Cliff: 4},
+			at:       start.Add(3 * time.Minute),
+			unvested: ugd(850),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.data.Start = start.Unix()
+			unvested, err := tc.data.UnvestedCoins(ugd(1000), tc.at)
+			require.NoError(t, err)
+			require.Equal(t, tc.unvested, unvested)
+		})
+	}
+
+	_, err := types.VestingData{Start: start.Unix(), Duration: 60}.UnvestedCoins(ugd(1000), start)
+	require.ErrorIs(t, err, types.ErrInvalidSchedule)
+}
+
+func TestAccountVestingProgress(t *testing.T) {
+	base := authtypes.NewBaseAccountWithAddress(sdk.MustAccAddressFromBech32(sample.AccAddress()))
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+	at := time.Unix(start+50, 0)
+
+	periodic, err := vestingtypes.NewPeriodicVestingAccount(base, ugd(300), start, vestingtypes.Periods{
+		{Length: 10, Amount: ugd(100)},
+		{Length: 100, Amount: ugd(200)},
+	})
+	require.NoError(t, err)
+	delayed, err := vestingtypes.NewDelayedVestingAccount(base, ugd(300), start+100)
+	require.NoError(t, err)
+	continuous, err := vestingtypes.NewContinuousVestingAccount(base, ugd(300), start, start+100)
+	require.NoError(t, err)
+	locked, err := vestingtypes.NewPermanentLockedAccount(base, ugd(300))
+	require.NoError(t, err)
+	invalid := &vestingtypes.PeriodicVestingAccount{
+		BaseVestingAccount: &vestingtypes.BaseVestingAccount{BaseAccount: base, OriginalVesting: ugd(300), EndTime: start + 10},
+		StartTime:          start,
+		VestingPeriods:     vestingtypes.Periods{{Length: 10, Amount: ugd(100)}},
+	}
+
+	tests := []struct {
+		name     string
+		account  sdk.AccountI
+		progress types.VestingProgress
+		err      bool
+	}{
+		{
+			name:    "periodic",
+			account: periodic,
+			progress: types.VestingProgress{
+				Vested: ugd(100), Unvested: ugd(200),
+				NextUnlockTime: time.Unix(start+110, 0).UTC(), NextUnlockAmount: ugd(200),
+			},
+		},
+		{
+			name:    "delayed",
+			account: delayed,
+			progress: types.VestingProgress{
+				Vested: sdk.NewCoins(), Unvested: ugd(300),
+				NextUnlockTime: time.Unix(start+100, 0).UTC(), NextUnlockAmount: ugd(300),
+			},
+		},
+		{
+			name:    "continuous",
+			account: continuous,
+			progress: types.VestingProgress{
+				Vested: ugd(150), Unvested: ugd(150),
+				NextUnlockTime: time.Unix(start+100, 0).UTC(), NextUnlockAmount: ugd(150),
+			},
+		},
+		{
+			name:     "base account",
+			account:  base,
+			progress: types.VestingProgress{Vested: sdk.NewCoins(), Unvested: sdk.NewCoins()},
+		},
+		{name: "permanent locked", account: locked, err: true},
+		{name: "invalid periods", account: invalid, err: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			progress, err := types.AccountVestingProgress(tc.account, at)
+			if tc.err {
+				require.ErrorIs(t, err, types.ErrInvalidSchedule)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.progress, progress)
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package types
+
+import "context"
+
+// VestingSource provides the hedgehog vesting-storage document validators
+// vote on. Implementations return nil when there is nothing to serve. path is
+// the endpoint path configured in Params, sources that do not read from
+// hedgehog ignore it.
+type VestingSource interface {
+	FetchVestingSnapshot(ctx context.Context, path string) ([]byte, error)
+}
+
+// MintSource provides the hedgehog mint-storage document validators vote on.
+// Mint snapshots are only fetched from a VestingSource that implements it.
+type MintSource interface {
+	FetchMintSnapshot(ctx context.Context, path string) ([]byte, error)
+}